@@ -82,7 +82,12 @@ func NewOutletFactory(
 // Inputs and all harvesters use the same pipeline client instance.
 // This guarantees ordering between events as required by the registrar for
 // file.State updates
-func (f *OutletFactory) Create(p beat.Pipeline, cfg *common.Config, dynFields *common.MapStrPointer) (Outleter, error) {
+func (f *OutletFactory) Create(
+	p beat.Pipeline,
+	cfg *common.Config,
+	dynFields *common.MapStrPointer,
+	opts ...ClientOption,
+) (Outleter, error) {
 	config := inputOutletConfig{}
 	if err := cfg.Unpack(&config); err != nil {
 		return nil, err
@@ -124,7 +129,7 @@ func (f *OutletFactory) Create(p beat.Pipeline, cfg *common.Config, dynFields *c
 		fields.Put("input.type", config.Type)
 	}
 
-	client, err := p.ConnectWith(beat.ClientConfig{
+	clientConfig := beat.ClientConfig{
 		PublishMode: beat.GuaranteedSend,
 		Processing: beat.ProcessingConfig{
 			EventMetadata: config.EventMetadata,
@@ -134,7 +139,12 @@ func (f *OutletFactory) Create(p beat.Pipeline, cfg *common.Config, dynFields *c
 			Processor:     processors,
 		},
 		Events: f.eventer,
-	})
+	}
+	for _, opt := range opts {
+		opt(&clientConfig)
+	}
+
+	client, err := p.ConnectWith(clientConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +156,15 @@ func (f *OutletFactory) Create(p beat.Pipeline, cfg *common.Config, dynFields *c
 	return outlet, nil
 }
 
+// WithACKEvents configures the pipeline client to report the private data of
+// the events published via the outlet, once the events have been ACKed by the
+// outputs.
+func WithACKEvents(fn func([]interface{})) ClientOption {
+	return func(cfg *beat.ClientConfig) {
+		cfg.ACKEvents = fn
+	}
+}
+
 func (*clientEventer) Closing()   {}
 func (*clientEventer) Closed()    {}
 func (*clientEventer) Published() {}
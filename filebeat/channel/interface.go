@@ -24,10 +24,13 @@ import (
 )
 
 // Factory is used to create a new Outlet instance
-type Factory func(beat.Pipeline, *common.Config, *common.MapStrPointer) (Outleter, error)
+type Factory func(beat.Pipeline, *common.Config, *common.MapStrPointer, ...ClientOption) (Outleter, error)
 
 // Connector creates an Outlet connecting the event publishing with some internal pipeline.
-type Connector func(*common.Config, *common.MapStrPointer) (Outleter, error)
+type Connector func(*common.Config, *common.MapStrPointer, ...ClientOption) (Outleter, error)
+
+// ClientOption adjusts the configuration of the pipeline client used by an Outlet.
+type ClientOption func(*beat.ClientConfig)
 
 // Outleter is the outlet for an input
 type Outleter interface {
@@ -35,8 +35,8 @@ type subOutlet struct {
 
 // ConnectTo creates a new Connector, combining a beat.Pipeline with an outlet Factory.
 func ConnectTo(pipeline beat.Pipeline, factory Factory) Connector {
-	return func(cfg *common.Config, m *common.MapStrPointer) (Outleter, error) {
-		return factory(pipeline, cfg, m)
+	return func(cfg *common.Config, m *common.MapStrPointer, opts ...ClientOption) (Outleter, error) {
+		return factory(pipeline, cfg, m, opts...)
 	}
 }
 
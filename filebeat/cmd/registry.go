@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/filebeat/config"
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/filebeat/registrar"
+	"github.com/elastic/beats/libbeat/cmd/instance"
+	"github.com/elastic/beats/libbeat/common/cli"
+)
+
+func genRegistryCmd() *cobra.Command {
+	registryCmd := cobra.Command{
+		Use:   "registry",
+		Short: "Inspect and edit the registry while Filebeat is not running",
+	}
+	registryCmd.AddCommand(genRegistryListCmd())
+	registryCmd.AddCommand(genRegistryShowCmd())
+	registryCmd.AddCommand(genRegistryResetCmd())
+	registryCmd.AddCommand(genRegistryDeleteCmd())
+
+	return &registryCmd
+}
+
+func genRegistryListCmd() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the registry states",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return withRegistryStates(cmd, false, func(_ *registrar.Editor, states []file.State) error {
+				w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+				fmt.Fprintln(w, "SOURCE\tOFFSET\tTYPE\tINPUT\tUPDATED")
+				for _, st := range states {
+					fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n",
+						st.Source, st.Offset, st.Type, st.InputID, st.Timestamp.Format(time.RFC3339))
+				}
+				return w.Flush()
+			})
+		}),
+	}
+	addRegistryFilterFlags(listCmd)
+	return listCmd
+}
+
+func genRegistryShowCmd() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the registry states as JSON",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return withRegistryStates(cmd, false, func(_ *registrar.Editor, states []file.State) error {
+				if states == nil {
+					states = []file.State{}
+				}
+				out, err := json.MarshalIndent(states, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(out))
+				return nil
+			})
+		}),
+	}
+	addRegistryFilterFlags(showCmd)
+	return showCmd
+}
+
+func genRegistryResetCmd() *cobra.Command {
+	resetCmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Reset the offset of registry states, such that the files are read again",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			offset, _ := cmd.Flags().GetInt64("offset")
+			if offset < 0 {
+				return errors.New("offset must not be negative")
+			}
+
+			return withRegistryStates(cmd, true, func(editor *registrar.Editor, states []file.State) error {
+				for _, st := range states {
+					st.Offset = offset
+					st.EOF = false
+					editor.Update(st)
+				}
+				if err := editor.Save(); err != nil {
+					return errors.Wrap(err, "failed to write the registry")
+				}
+				fmt.Printf("Reset %d states to offset %d\n", len(states), offset)
+				return nil
+			})
+		}),
+	}
+	addRegistryFilterFlags(resetCmd)
+	resetCmd.Flags().Int64("offset", 0, "New offset of the states")
+	return resetCmd
+}
+
+func genRegistryDeleteCmd() *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Remove states from the registry, such that the files are read again from the beginning",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return withRegistryStates(cmd, true, func(editor *registrar.Editor, states []file.State) error {
+				for _, st := range states {
+					editor.Remove(st)
+				}
+				if err := editor.Save(); err != nil {
+					return errors.Wrap(err, "failed to write the registry")
+				}
+				fmt.Printf("Removed %d states\n", len(states))
+				return nil
+			})
+		}),
+	}
+	addRegistryFilterFlags(deleteCmd)
+	return deleteCmd
+}
+
+func addRegistryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("path", "", "Only select states with a source path matching the glob pattern")
+	cmd.Flags().String("input", "", "Only select states of the input with the given id")
+	cmd.Flags().Bool("all", false, "Select all states, required to modify states without a filter")
+}
+
+// withRegistryStates opens the registry and calls fn with the states selected
+// by the filter flags. Commands modifying the registry require a filter or
+// the --all flag.
+func withRegistryStates(cmd *cobra.Command, modify bool, fn func(*registrar.Editor, []file.State) error) error {
+	path, _ := cmd.Flags().GetString("path")
+	input, _ := cmd.Flags().GetString("input")
+	all, _ := cmd.Flags().GetBool("all")
+
+	filter := registrar.StateFilter{Path: path, InputID: input}
+	if modify && !all && filter == (registrar.StateFilter{}) {
+		return errors.New("either --path, --input or --all is required")
+	}
+
+	editor, err := openRegistryEditor(!modify)
+	if err != nil {
+		return err
+	}
+	defer editor.Close()
+
+	states, err := editor.Find(filter)
+	if err != nil {
+		return err
+	}
+	return fn(editor, states)
+}
+
+func openRegistryEditor(readOnly bool) (*registrar.Editor, error) {
+	b, err := instance.NewBeat(Name, "", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "error initializing beat")
+	}
+	if err = b.Init(); err != nil {
+		return nil, errors.Wrap(err, "error initializing beat")
+	}
+
+	beatConfig, err := b.BeatConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading configuration")
+	}
+
+	cfg := config.DefaultConfig
+	if err := beatConfig.Unpack(&cfg); err != nil {
+		return nil, errors.Wrap(err, "error reading configuration")
+	}
+
+	editor, err := registrar.OpenEditor(cfg.Registry, readOnly)
+	if err == registrar.ErrLocked {
+		return nil, errors.New("the registry is locked by a running Filebeat, stop Filebeat before using the registry command")
+	}
+	return editor, err
+}
@@ -47,4 +47,5 @@ func init() {
 	RootCmd.SetupCmd.Flags().AddGoFlag(flag.CommandLine.Lookup("modules"))
 	RootCmd.AddCommand(cmd.GenModulesCmd(Name, "", buildModulesManager))
 	RootCmd.AddCommand(genGenerateCmd())
+	RootCmd.AddCommand(genRegistryCmd())
 }
@@ -51,18 +51,22 @@ type Config struct {
 }
 
 type Registry struct {
-	Path         string        `config:"path"`
-	Permissions  os.FileMode   `config:"file_permissions"`
-	FlushTimeout time.Duration `config:"flush"`
-	MigrateFile  string        `config:"migrate_file"`
+	Path           string        `config:"path"`
+	Permissions    os.FileMode   `config:"file_permissions"`
+	FlushTimeout   time.Duration `config:"flush"`
+	MigrateFile    string        `config:"migrate_file"`
+	Backend        string        `config:"backend"`
+	CheckpointSize int           `config:"checkpoint_size" validate:"min=1"`
 }
 
 var (
 	DefaultConfig = Config{
 		Registry: Registry{
-			Path:        "registry",
-			Permissions: 0600,
-			MigrateFile: "",
+			Path:           "registry",
+			Permissions:    0600,
+			MigrateFile:    "",
+			Backend:        "json",
+			CheckpointSize: 10000,
 		},
 		ShutdownTimeout:    0,
 		OverwritePipelines: false,
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvXt3GzeSOPp/PgWucs6VvUtRD8uOoz2zezW2k+iO7Wgte7Ozmz0i2A2SiNBAB0CLZu653/13UIVXP0hJjuixz2r+mFjNbqBQKFQV6vkt+eX03duztz/+X+SlIlJZwkpuiV1wQ2ZcMFJyzQorViPCLVlSQ+ZMMk0tK8l0ReyCkVcvLkit1W+ssKNvviVTalhJlITn10wbriQ5HB+MD8bffEvOBaOGkWtuuCULa2tzsr8/53bRTMeFqvaZoMbyYp8VhlhFTDOfM2NJsaByzuCRG3bGmSjN+Jtv9sgVW50QVphvCLHcCnbiXviGkJKZQvPaciXhEfnBf0P81yffELJHJK3YCdn9fyyvmLG0qne/IYQQwa6ZOCGF0gz+1uz3hmtWnhCrG3xkVzU7ISW1+Gdrvt2X1LJ9NyZZLpgENLFrJi1Rms+5dOgbfwPfEfLe4ZobeKmM37GPVtPCoXmmVZVGGLmJeUGFWBHNas0Mk5bLOUzkR0zTDW6YUY0uWJz/bJZ9gL+RBTVEqgCtIBE9IySNayoaBkBHYGpVN8JN44f1k824Nha+74ClWcH4dYKq5jUTXCa43nmc436RmdKECoEjmDHuE/tIq9pt+u7RweGzvYOne0dP3h88Pzl4evLkePz86ZP/2s22WdApE2Zwg3E31dRRMTzAf17i8yu2WipdDmz0i8ZYVbkX9hEnNeXaxDW8oJJMGWnckbCK0LIkFbOUcDlTuqJuEPfcr4lcLFQjSjiGhZKWckkkM27rEBwgX/e/UyFwDwyhmhFjlUMUNQHSCMCrgKBJqYorpieEypJMrp6biUdHB5P+O1rXghcUVzlTam9Ktf+JyesTd+DLpnA/Z/itmDF0zjYg2LKPdgCLPyhNhJp7PAA5+LH85nts4E/uTf/ziKja8or/EcnOkck1Z0t3JLgkFN52D5iOSHHTGaubwjYObULNDVlyu1CNJVQmqm/BMCLKLpj23IMUuLOFkgW1TGaEb5UDoiKULJqKyj3NaEmnghHTVBXVK6KyA5efwqoRltcirt0Q9pEbd+IXbJUmrKZcspJwaRVRMr7dPRE/MSEU+UVpUWZbZOl80wHICZ3PpdLskk7VNTshhwdHx/2de82Ndevx35lI6ZbOCaPFIqyyfVj/eyfRz86I7DB5fbTzP/lRpXMmkVI8Vz+ND+ZaNfUJORqgo/cLhl/GXfKnyPNWSujUbTJywZldusPj+Kd18m0WaF+uHM6pO4RCuGM3IiWz+A+liZoapq/d9iC5KkdmC+V2Smli6RUzpGLUNJpV7gU/bHytezgN4bIQTcnIXxl1bADWakhFV4QKo4hupPvaz6vNGAQaLHT8T36pfkizcDxyyhI7Bsp28FMuTKA9RJJupHTnRCGCHGzZ+sJ5Xy6Yzpn3gtY1cxToFgsnNS4VGLtDgPTUOFPKSmXdnofFnpAznK5wioCa4aLh3LqDOErwjR0pEK+ITBm14+z8np6/AZXEC872gvyO07red0vhBRuTRBs58y0VC6gDrgt6BuEzpBZuiBOvxC60auYL8nvDGje+WRnLKkMEv2Lkb3R2RUfkHSs50ketVcGM4XIeNsW/bppi4Zj0azU3lpoFwXWQC0C3RxkeRCByRGHUVtLpYPWCVUxTcckD1/HnmX20TJaJF/VO9dpz3T1Lr8IchJfuiMw400g+3HhEPuIz4EDApszjSNdBp3GSTFegHQQFjhZaGSf8jaXanadpY8kEt5uXE9gPtxMeGRnTeE6PZ08PDmYtRHSXH9nZn1r6B8l/d+rN3dcdxa0jUSRs+G4Jcn3KCJAxL9cur2wtz/3/NhbotRY4XzlH6O2gIRTfQnaIImjOrxmoLVT6z/Bt//OCiXrWCHeI3KH2K4wD26UiP/gDTbg0lsrCqzEdfmTcxMCUHJF4cUqSOGU11dSrIH75hkjGSrx/LBe8WPSniie7UJWbzKnX2brPZk7xDZwHloosKTxSM8skEWxmCatqu+pv5Uyp1i66jdrGLr5f1Ru2L3A7NwExlq4MoWLp/hNx61RBswikidvqtXH81knzcUKNjDw7YjW9iyTup5iy9AqIMD5rbXzasS4BtDa/osXCXQn6KM7HCXj2l80toPo//DW2jewOTM/cHXdPF0eZGlMI3tFjXqQnGxSZU/+lI7iSzUDho7hzXHLLqVXAlCiRzC6VvnKajmSgULlTF2BDBUWzOdUlCC4nl5Q0o+x9FFpTjjd9rpzmOxNq6W5oTqdrqc3vX5z7UfFUJDB7sLkH7vUMMuAihsmorrh3Lv7+ltS0uGL2kXk8hllQ0661sqpQojcV3midWGlNGvQsDdd15i5FQRMIWLKaSkMBmDG5UBWLsrkxqONYpiuyE67pSu8krV6zGdMtUGRngQbVDP+z10FxZ6cs6mCgg2YIQBCIA0vOwzanKXL4UZv2RBQmcCenMY1DiB81KX9cOvB+ayRuAOiCqN0FIwoZGC0hWCrbG9NxddywPThk4foaL7043n6YKJopgFmjnHA3YcMqKi0vQEtnH60XKewjKgsj5ODfRNYeBItV5Jq79fI/WNLs3UqZBm3fcNtQvx9nM7JSjY5zzKgQgfq4DHLNsrnSq5F7NXBEY7kQhEmn23rCRduI45olM9bRh8OpQ9iMCxGVLlrXWtWaU8vE6g5aHS1LzYzZlkIH5I4qvCcuP6FnvpHPVFM+b1RjxArJGb6JHHvp0GJUxcAmRIS7AVJJzs5HhJJSVW4DlCaUNJJ/JEY5OhkT8veEWS8jwGiR1IIFI5ouA0yB8Cdj/2CCKGuLOOluAEmClQ0aLfAKOhnzeuJAmYwRrIm7xtVMll7HQAVByQQE3Cf8joVdma4sMzfIFKGiro9Xi/ZnrX34q/sBrxXRsuf3w92bHT/A60BXvhw+P24BhovagrTz5xfHH7fmnDM1LrhdXW5JM33B7Qqm6q3+jZJWMyr64ChpuWTSbgumt5mWHCfrwfdWabsgpxXTvKADQDbS6tUlN+qyUOVWUIdTkLOLn4mbogfhi9O1YG1rNz1Igxv6gkpa9jElVJHr9OvAmTN1WSse+VLbKqXknNumRF4tqIU/ehDs/n9kRyi5c0L2vnsyfnZ4/PzJwYjsCGp3Tsjx0/HTg6ffHz4n//9uD8g+vu6PTX8wTO8FXpz9hOpeQM+IeOUbJbCakbmmshFUc7vKmeqKFI65g86RMc8XgWfGqw1SONcoTQsmLdNe85oJpTSRTTVlegSq/IInvcbEQRE8QerFynD3j2BaK8KxNhkIb5XN3AdgOOSS0MaqClj4nKmw2v4FYKqMVXKvLHp7o9mcK7nNk/YOZth00Pb+/cU6uLZ01DxMgyft3xs2ZW1E8foGGOILbeI8O48COnBEEBY5ZaEVQEnmZG+0aZ+dXx+7B2fn18+S4tGRtRUttoCbN6cv1kGdT44q7R1EfWuSc/z6kwT7URsOpe2nAqG03bTExjA9ZhXlYkvcyzEvAhMEjA8AMGuEGDgH9wrEriFuGpgWWBa9plzQqegfj1MxZdqSV1way7xC1YIXtPbx1iytfWvjzFvWYeJoEIFb4n4tqHU65gBeEc4tIjbXhHCyPhALahZbE42IKTcPcfO4c1UorZm7l7bM+jO8gbgXnUyRSq5yJyGq6RnT+mCYN1lOYBW8xJsD/OFWN4mupELJGe4VFa05na5RUJluzCS4fjtczs+wBU73c4fpNl3SigwQYOhDtSXpdLFwjAnVDHDzcNkHJDuSFI5ky46mGpwymtHCg/VWNIz4IEgeZWDCMBQB09BM0+gGTg4uvA2jdThc6sBGTNY6tGbkDbOaF2hoNrkhm0ry6sURmrEdhcyYLRbMgJaVjU64Nd6HmIB01NV2fbd8mNxEA2kbBD+ubqR3TmpWKRvNqUQ11vCSZTN1IUOYKPHes7CgsOkyfeo1xLaXHgdNA4Gb0E8eBKEblpsEqkfYXewlBdxftseZd98nBOFc4B7Vcyr5H3joeRld3v6UrUjJZzOmc5sJ6MEcHL2E4vHcs0xSaQmT11wrWbWVqERbp79cxMl5OSI/KjUXDOmf/PzuR3JWolMaTKa9A9/XnJ89e/bdd989f/78+++/b6MTJSQX7n7/RzKL3DdWT7N5iJvHYQVtMUDTcFTSIeoxh8bsMWrs3mFHpfWehO2Rw1nwIJ29DNwLYA2HsAso3zs8enL89Nl3z78/oNOiZLODYYi3KLIjzLmvrw91poDDw77L6t4gehP4QOa92ohGezSuWMmbqq0la3XNyxiksE1VBzlAmHAcDmcegEWXZkToH41mIzIv6lE8yEqTks+5pUIVjMq+pFua1rLwlrilRflL4icet1wcI6P32A8iufVwg3Mrvth2YHjPQi8+LgvZqVnBZzzcESMUaJ73PihvpVezfJAs2JIZFuZdMFFnCiTIKwxfjUMbLwnlyiHI8ordQUBtRcfzSnBaPC/bZ5hXdL5VnpKfDZgsmkYRoCU1ZNpwYZ04HwDN0vmWIEuU5eGi8zYAWQTo5tmzSNANsaBdZguT+rDK1rxb3I205mT8idwESXZb7ARHJxWVdO60N+AnkQ56nAQjUDM2knnRckbysvN4AyvJXt3sbkXtOXsbrKlo8tlvR2IOjJl5WG/yrSL38b7VL9H313Jd3soBmNRYDN6+JwdgHBYcgf+7HYD5pgRjoY/S7xyiz+YFzI/BgyvwwRV4PyA9uAJvj7MHV+CDK/BrcgVmQuxr8we2QCdbdgreQdhvxTO4drEP7sEH9+CDe5A8uAe/Nvcg5n93MsA3GQ7eMEv38t0JpkWfYY5T3ubiflPSwUDm+J9Ly8qy6kH38hG9ChZjiFVjMmGFGfuXJpjEE8BIFA4eO0eUVWMspjLBYRC9eG5CfnE37d8bplcQoY45XJGMuCx5wQzZ2/M36oquAkCQxC/4fGHFkGMsWw187+sOONCEE5xcWjbXPm6clr85UIPILBasoh38k1Zyrekri1CIIKccrVXLiv0qPticZ5qsyAUkJfkQdxwQzhGVK3LFZbJYfMAUgwrTovA9sFxjRqVDnmDohnVoDtmlwKMKaphJqZhhWbD33BomZsn7SiWOfgfz05bUY0AmDB6uCGgmZB7AtiK6RWv5gPQcgCDPX18PRsxhH1xsyMbOaey6kwP06vqWucy4v0NekpDOMOwoESoogehQ0bxo0UokyVNIj28nGTnyCTzFEZTbsix9GCx/C9xHmrKBA5N+ndL4gbGE1GbIreEVc5fV4H1yT91AcYyUEa1m2SL8eGEoGjJsCSSRhkALHz6RUqJQdydThplPXgX3Y9JgqrWK0FwlHqHxciCvasrskjE3U8ifkKWPkYh+SJzMpyRhjnQhlBPy5DTsxM3oxsuSH7JSmrkbN5iTBIyI+SrwZ55oDgANIzp7zQ+bUrVbWM+pJaG8YpXSK+KYHOTD+OHKDPGJ4K4bIZlGDz9PufD+ZeOUIFZiJvxdgj1uYQr65CAPHJ0UtMaSED4Lsu0Y8Emx0djhs8/SAeRZpZcxOQOXJOxe0i4WVJIJvhCyjiYpwzJuhDvrE0DIHi3LyYhMPMnvAckzeDTjgu0VmjlCm2CqTqjLEkeMCdiB4vzKuJunAstOX0g6pWuvpsY4ZO5hNlZbXHjQt7Edr/Aw+Bm6yI9CbsHnC59+NswDgUOCAJ31diWOCbsD2W6dzUGCmIzCnhomjU8DS4YqGsGMcKWRg3ZEQ2bgL1S7ww31D2YNxJxF1UfNnCo0IktGakHBLODjDQiNQwpfbIMWBast5ED7EASUaUF1GpEaqyw1hqFXqqDNsO0Mdhr8d4k1xE1Gyrphj2MBpO4+eiLHQXpRbMPVkRxPgoJBcc2aUaDZkGqOuaorzOnrlQzyRIIKpDuq3LH1wtteUpGnmPmXPUrb6mGNY0aOOlCTKdaK6bKKM0kqZWyWiwgGVEdES5XqKRl0p03ZgJaMRzr8WSQvVdGuKlRQUYBL0lt3BF1FWQV48pLOF4ICFd4LnRSo0hIdsC3waaimoo0NUpeVhHdS/gMklZI8JeKSbIjdXdBkw465P0MImFXkirGaNDUSK3yUV6NqYxVS0AHSNh4dy0Q1r6BilO9s8g8O3LZLaqlhN5nVPomT5fYQP00nQ79Q0h1ltOdP/DsT8shxdsMs2ffi2DD72NFzsIxjZQmnPBDTTBP4cP2pVNkIZoDVtY5dzidRM3A72GhHa2IVikhxmSbNL/xIIuknnMZtqocWXu6zGGOpbcc4lY2+jV9nwKfa+ZLLurGX4UdJpTKsUCm7XDU2f4GaN1wIPvhOrVnBDezb4eBmvvRTt8SJQ1Y2bbuMBHIEkNeAOvybOZ1RM3Il1VLmxdQSldrhUx+ONMwu8e6Oo2dhSfHOIW9jj1zHvBOoPb7dZdkwqKOC+NwJvOvc9eS4uqBOdmFhoU680hZNgj9RsyCPaqYXtDZQXgjK7sy4nDNday7tY7efmi69zLDKbQCIVqviAkpWKWmsdsuH+xJYJbhdDRjsQ8Dn0L9O//ri5We78p69dKuJ0TCZOtuBebDyzBW/FQF9ssLtxh8uhOZl+JxfQ7x0V7VbehWsG+GXkWSg2STcQnE3fxXMbH0bNMWONg5PJ2nMiWNszOnhVFBdTb5MBQ+AbBs5gG9vW9556YDe4Y0Fd7DQUH6Lar2ZjdaVf0rHSlr9hVcr83s7QiSoattY+ju6BLtQLBmoZuDx1pGaPngVaQMvWaPESuXkTMk+MuT5pSous9DjkhtHKSXKe3AwgDrJqC4WrEwEO20s4bGIk3aCnF0HXXZyibrWpI/JC1aTw+/JwfOTo2cnhwcYMPzi1Q8nB//3t4dHx/9ywYrGLQD/InbhVH68U2h8djj2rx4e+H+kk6l0RUxTOMVy1ghUQ+qaleED/K/RxV8OD6CI7CEpjf3L0fhwfDQ+MrX9y+HRk7abVDW2UNuLynDsy0+xjoO1Sqome4G7xBRoY0qH2bRlbGvkrFBSKFqTbDX4oudOHoW+vOeMctFoNsiT4oi34k2350lx3NvzJoS5tXeam6tLkx3Kdcd0JhQdNMO+4+aKwAhYi48rR5xtte0RG8/HxHjCJUYJANE8TqaYD4b5yxM4VuH64q96qK8tmO5G20bYL6XS1S3ob+0idt+C3Yb/wUoY9oYFjaJpzWnks7iIA7eXhwcHA3XdKsolxtp4z+ZKNbBnFQZjUglWSF+bCC7L1Bg+lyYDyLTvj26IJcV8Z8Mc9ci0DMSa9x1RIULlpY7iatg1ywKX7hrncOE/71jp4t6F4Tuy/pcFxlAllS9cwtMXnuwrRiUw0Wums8t6VM8dDsFb4xjybjIINXXQNzLbG1ya6RUjYFX1U3EWUhCl4caCpRnRFhxznYO0+10Hh+5W8KfVf7xb3HgB8AbJ/ArQYlruKpAMO2vuAO4Gs8WUs91MoqZ7VlYitbWk3V2TDAt5hVDiZbH3aHiY20qq0IyWK89hSjajjbDkYmWcrE/WiozRnKFtBCClAvP4ltzkVo/TxHvjpDglEMoJGCKlkuAQOHvpJ9951WhVs/3TylimS1rtPM6O63Sq2TX6KMLrF+93HoPzQ5KffjqpqkTcnIrw1t7B05ODg53HnWO7rRqH7xiSC0gbr1Q36GCLa/E15em1gmzMmImQ6oZDpIdTQ8d5jeEZ93qwd8v9EP7eWJgPquJ3XDjEMNu/j4B3zJCp4wptY6r3MrlfwfEefCNgSQG2mIruuel89e+gu1FjVMFTcV/QyEJVvlapODNyjHnfG2kC30DfDmyo00SUYb6eN/oHYMqzoJeSN2jUc2j97x/O3vxPqP1tkovK5/NC+T7wYaNiE7SIfiYGnc0YGlLd6531BKrJiuZ7u9NdPNq3THxZxwNf01C2HkCsmKUYDQvekA77Kplb/paY10sYfE2OGyZfi44mAnP3w1Luj5/CLsdZuupFTPMQakkYNSsHomVAQtMVIjR+PBCkUXvZHmNmtxZcd645lGTHUDrHOn88e/l4PWITzW0bljxftw8Hl72AjXtMGVYla/eWCEAEb1jOp0jbtrC1tGEHVIYPB4oqLBWd8pI95ej48FkbxvtlDN54BBpOpUo+413moJZya2nKKB3cBLtgHdH9HMCa2m2ZV8+pXQSltk+jhv9xGzyv0+RhaW4Mt9OQTEUeRZuIcncXWpZBd5u4sSDUDbzik8cd9ZLqObOXW0TFe5gBkA0ah1lVgsurTnzzFtPqAV1gFwXv0YiUXIOS4SHpYKTZGkt976M2gZt+AG6q01U7C8R6dNFhtUjIeeTUnKlcQfvR/7lBP/uRqTwur6DaXdJS1RSarL8hoyQvEENlriO1W/RkSSgtRc8rZSXTPJrTLCsWYIZPRf8dZGfnWZgM+iP1nmnqWvDomLyVcvPl5N198Tl3X2C+3ReWa/fF59k95Nh9mTl2X2J+3ReQW9e/LAT5FR+sl2DvY2JPFvZbMW9VTXHm8I6PH4fWCUywaxoPp9fKMo/vpxQs+aKSmD535lKMT1CmFb39U/h7o5kolNVpmYl8XX1SqKpuLEYK+xpQsSfUiwsMjQ2NnYYNlnlPp2RWwQ5OqbxPO08ghFmDWghqymB8cB4Z7NYKeI2hwH7EBdXlkmo2Itdc24aKUL7JjMhLqPOR1dABIxT5WzNlWjILDX5KdqfqGLpYcMuKzH91r3lRdYiLC60Ysvl65/zj82eXz9pFGB5qITzUQrg7SA+1EG6Pswc97aEWwvZrITj5uSVIdn/yY+c1D/OQEZs1yws+16V3S5NJgGzidIfKnV/NbKOxwGuvhOLuRq3uXpvkoZ6Tl2U6NRGPIXzJd3zBfOMRuMi9Nz3qr07F5XIOwQg+9nxjaVTUlH30MroEHWYn0GAPMNXFwqfVuQANiNfD9Qq2U5/iJ7+Vw3Nuiz7fbqRNMKb5FHegyowiM0r8ACW/MLDDM0kI6vq9oQJM43FMXygMCzBgxp0DwFvnUqISJIDDXhsnSTQpWcFLyIV1uiuQUWLsyr3f2XhlxjNacbHakmj6+YLg+ORRsPVpVi6oHZGSTTmVIzLTjE1NOSJLLku1TO7/VBsP3uzB3YhtleLo6by+FAZo+cHnExLNQxLvsApKC4eDN+o3es26K7hyKv9nWwPOFsGGO5emS2KsHiptejw+Hh/sHR4e7fkUsC70W1Ro1uA/RCpn2F+H8P/sQhuuzZ8L4jCfp3unGykzIs20kbbZROtUL3mP1gcLKWwP+NvSyOHB+PB4fNiCdlvBLqGhZ4f9/qC0r/cdahD7rrLe89Cqru6GgLbEk1g3eQLl4a+rUaYAQ5B1puvGy/oob9qaVRbPPR5JVscRh2T2QFmTh+JCbep6KC70UFzoobjQl11caGFty4r/0/v35/D3XTqPuI9iOOw4lIIhk0aLSQhMZRg4nbXFBCC1CPD6tra3t+eHD6aqXI0H6tjeFJBxYy3bi1Z8RhtMArN20fv8+XfrQfTBNFs6w+/9dQQ3YyOUPzEhFFkqLcphaLeAy/fKUtGJeOlg9JEDFg77glGnB/SVq8PjJ8MIrphdqK3l9LVQilN1cp2RyDELACrDTFmeHmAVEWrJNKR3OxYayk2NyQXzObGqaKoQ5xXHNr46y85ZCKt3Wt6rFxc7ffPYnNkRqaFMTN3YQTRBk2e9tYCtd374lD2TY663m473mJP9/alQ87F/Oi5Utd+B3dRKGvbZzzlOe9uDngP5eU/6JjjXH/UA7+c+6x7aTzvsHmhjqW3MgKn3TjF4bfThmMPG3eODtkdsu7c5gGvd9fhwnLcqCVWkvPB+7f+8UXajeYm2ivcoyNjMk3BuI4Rh8du4Lv4ckpocVNHh4et/9XISsQVAK6V5SbWcjMgESqG5f/CB9E+mdWs520yjDclprZQtt5iQVku7JQnglGdvZOrvDCsvCW7R025JA4VfooZaU92qcniGJk5NU5HBiR826GhIFbkxFBrWh7IwbsQ8/y7shR8lT/vsZH36xY56CwppvXHMBb1mMc3IuE3FsOMiVEnEaEI0AjBZKOx2oIlkSyK4ZAbawV1nFxJ3lRGMSshRa4P8Z7OSiVE+6Xh3F0S+E+u5HXgajF2gGPzp5GTwtIFP4s3Kn/1oOMfEmJwbvM0e3VCKL6TVtEM60HRSVY30+McIYHXNdOAgKX6E4C5k6Tk+JMPk7YnCG58UABJG79Tg6CYMhfI/dwnBqLG1xhaTSk7xljbn10xiMG4+q+dwtVZWFUq0CxBRPeVWU52s/MSnq/rUMSg0aPBQVLzQKqQsjYACqTAKJlvhyU8vm6tVzZLljBe/j8iMFmyq1NWI2CW3Fh0U3JBlXmfIsZpU/CmV7iTXTJZZjSSIjsZ2iDGS2InYMkYOxzIIeAr2S6djn51juLQZQVlwMyLZmEuuQ4bgF6iFU95u5XbfDVZ2UbtCrcpqKg3o3LAjU+XODdfMV2Vr5exPfL0p+NKn0ufF0sPzUL5nRCbhsPqfUHbxtBOmqfoIePLseQsBnoPY1eX2WlmeotUKCnhC8hgw7awS/dk51o/01EQNWTIhPJOL6wnHLwUmtPnfOCaYU2KVEnt0LpWxvHDaoyypbrXKjMPOhFrmm/GaUS0xFZ3aeAuac7topnD/cQQCBdP2I/L2eLnndLWBor8ni5//2bw9/umf3/z49M3f958vzvR/nv9eHP/Xv/9x8JfWVkTS2IJ6s/MyDB70tMCuraazGS/Gv8p3zK0HiyolcXryqyS/RuT8Sv6JcDlVjSx/lYT8E1GNzf7i0jItqcC/HAWlvxoJhPur/FX+smAyH7OidZ2VHfYNYJ3w2sOeeFXKA/XVZ0dRIGWKTT5m5FxumF1DIDTJLf6as+UYYVgzcUCN0qRmmlfMMo2AtIC+HUwJkBYE7r/gtfCT5SPHScc7XXLyuG/RzUzpJdUlKy//TJxB1lMjpqT745r95BXkWquPAxWovj8aH44Px+2SKJxKeomRSltiMGenb0/JeeAOb2Eq8iic3OVyOXYwjJWe76Nghoq1+4Gf7CFw/QfjjwtbiSxf/sLzEZBXoTpJ+Mp4/kMFVKoADgYaz1tmfxBqiUXT4F/eOBvHFWoebn2Nt84OramH8HZ24bY9IKgcTVdEgUMTSoirIH1NilYLcqkL7Y9goPuFz3gL7D/X5sQLXD/IJ4lc/+2A0E2/DIjd8GPSz7wAHha8R20jRaCabVxlX38XbhdJZkL4BGEfxyDRRkQARf1GC6dJOqQ52Zs03C9Pc4uukOgJD1BvA4UXjuCpibScMTHU2sFrSlPNB0b+hvPkxzC2BEgYFnTlmFNT1iNii3pEeH39bI8XVT0izBbjx18e5m3RQfyWQhDOUOj8fHEGGdcChegyDxUIZP3aYXHscHeMGMxuSbVhxYjUvAKEfnnodEBnpgFflKbVCOLn/NmmVA8ZP++XBalZwakIFDyKebAY8ta7UmMdiVhOt2SWFXYUxoePsJDIzSPuteWbV66yEq7t5NYYDEJJ0RirqpjhgYNCD3FwbPuldsqbKDnj8yY1GLGK6EbeHgHEqJl102UVztoZJzOu2ZIKYUZOw9UNRO8ghriS+7WGJcJQIf4w6JCZlmiYNErHulVLNm1BkU0C8d5CGUOGhnaIPD1/47Fh8j6pgRpyAw7FGs9r7DeeQeHgGDEiV6O8/huu00RSMKGsC5KDSQrzBhSHYip+TF9ShbzxttXfG9bgwOTV+9eQo6QkUE246/kC0O3mJJ6cgqVJMzANQu2qkkHVf48PaOn66sXFHYxOD3k1D3k1dwfpIa/m9jh7yKt5yKv5qvNqumk1Ufq27R+fZpTp9zgdHv6z9SltKaoPCQ4PCQ4PCQ4PCQ73n+BgmOZUbNdgHO7XfjIv72+ql3V/Lb9CD4GcrcZWLZvK1TPt8xrdxTBoTsEQnUZa1cyMh6JugqtA580EwsUTonBKA/+pjW/89XEF/1BCMAjTwUus+1e6gg7ERoQxWyhteZ/vE6lx5ThDHp4+7kCwuWPqPZBUxlhS2NKcSv5HUvaDmaf7/IY4kHyccL9nUvNigYQDF/t1HcmqmsogpZX2+mqL6DqRGnlgSOo4umCihmLbVGsq56EJj/VFbrNOPlRikA54DNoB+hGMtJ67lOT4B6Sk5KB+ttIwOX1E9SBx9RYpRRZ8ASz4BnJ6D3bWThOANaSjOtz99tGHX6Vm+JWrhV+xTvgVKYRfsTb4xauCmYc0tujwXO48e3TrFtlrmVvs5Tss6Qoqk7RL6Xbe5tzuaAeBjbE1MC/3M1r2QSWtuFpgwKGv6riGtLuZZZIYS1cmlDoOPXuxxzaNXbFAQaw5OmogKVGoKRVZ0fkAbjIo3a7U1fw2yQafFgOmNV35cAlAEtVzcKTldrI30D3S6xO4vForywoLzhNu+XUr37Gnd/o/94iJ2Zh7ZE/EfzYm3in2SGjq046iYB9Z0UDDgy2h4nQKPV8Yhuv6HQxYSbP3Tsh+Y/T+lMv9sLbPUaLSnzgvheJGuasFdJQgBRWCQXb4XNMq5joaXnFBB/r7doGvb0wIXRf5cR5PW6fodG/IO+WdhGFrCtVduqP/2f4m70Of03zXfR+Tvtn+6ODw2d7B072jJ+8Pnp8cPD15cjx+/vTJf3UaYCw0o+XtMrXXLfs9jEHOXvaF9tFxO6ALmPG2CQ4m6YShOHTB8xEmHyAFgvvSh2vUObmSF1RidPU0NbW0J3HIrNgAoWSq1dKASSDkbHggwhFdsimp6ZxlbUsVto5v78ZS6Ssu55cYdtTrVH2viWZ+LhLnClaFKNm6TGShKrZPBbaMSKlbyV/vRe277NFGUZua2zBsOh7qhc5owQW3TmbW/Fph71+tGmhcX3NWZO2ioD9K2GywW8ALptvYxEepG8ag43lF5crpRgV47N2N89WLi9BX6X0Ogh8aO9OBaQUvdtUIb6wQ8B9EFHSIclOEQlHK+4tArJpaSaetB/GOWSmSTDwWx5O4klPosquZjXYYh6Fk2WdmlKX1TBlpoMwQ9LSPRo2RD8McJSIIAWojUggOPbjCq1SWMWYpjwuFMhxwba9raOAqBDk7D9LeqgQ9rycjVHkoaCHSI83XFsAgwLNzYjW/5lSI1YhIRSpqLeSdsMi9uYXJqGbliExXMZYmn+qEjqfjYlxO7nL7v00TjGGfyqmIaWpn5wb3WMms63N+we6H5VzcLijHvzeQruOJx1dniDEihZLSBxDNon3MRzloNqe6xPARY7CXd3rfYE9yHkMcnRaIEaaF0llX4B+UJu9fnMfOPMA0I5gIW8G4+9sjiEsOpR4u/v7WR1c+MqFkflCXX5xnsIxhEqzYEmNiuzP5KrRi1cNH2L52aLo0ofkgcAUfA0NoYZvgS8UAO6YrshPH28GCxbOo7eVQyA7gJtT4gp+99h9cvv1Ep8BKfLnWAhmb6UyRr8MzpIvWBBS6ScEq/IgpQgfLbfzWyCJdL/Ck+6+HBkuoTaU40pDu9OI27qEfPaSS+jdf4PD7YQntziZ4G6Kl4/IVlZYXIebdJ0uxj9icyPOzdFFxN6hZI9xr19wtl//BMqujJAXTcD9L+UqBV+k4x4wKEXhVaJ9fUMvmSq+QWfk8NWO5EIRJaGkHr63JOHEIm3GnuvphaV1rVWtOLROru9yZkJNvSx1CGz42u8ONiaIDcx0Dg6mmfN6oxogVUjN8E1UdaPRvotIOHgPq2PiI0FAOD0vHQBE95ehkTMjfE2Z9GcW8QgieKnenj9kBSPeTsX/gU1fbapx0kiHlFZYNRonhdW/i5A+UoBkjWJMRKZkTWZBJGspLp3Z9IGd4t5Pjfad1/RXyuaD4ecqI884W38gZzk/frPG8HfaNi7oBsk8qNYPQ4PidxlEPkWwPkWwPkWwPkWwPkWxfdSTbJwaS7fYjyUIcWaIsvH523LTk7Pz62D04O79+lhSPjqz9bAFoQ9Fvfy557NxnjX2KYG/bxG6Rh7QWCAWFO9Yu8aF45UPxyofileSheOXXVrzSlxaB9zILWnh0Q7BTKEzStcfY/DelB/oJOV3IA7ekhhRKCGj4fENA04zL0hd5CtQJedlIlrESV5jbvRliBm5vLmD1glVMU7HFchuvwhw5e1JeAQzgP+IzEPfQA9w87tZa4mXWEgIsO4bQQitjiGbgrvLVayZ+QDh9pYIGS7av+j2nx7OnBweztkKzjeO022fNobpdIyUaUhHi/pK9VQJPoIgdQ1ct1Pk0/4peMUO4JbUyhk/RTxRJJw4NJJSlPiLNStYjqKE2E8Fmr90+1UxzJgvwTRnTMIN2QTeWZqVbgO/nlcz36EiP44bO8LzExP0UzABXrkDsaDfjcg6djn2PsN6Olk++Y0/ZdMYOKHtWHH//3VE5Zd/PDg6/O6aHz558N50+Pzr+bnZTiYL7byARKDzF0vrzPxBOm9+i4ocQYOtpH6QR+DxidQehlgbuU0sV0ZOuU2EsaCgRWIVOxBcUA/d7LJyONz7Z8lPyVoUI35EinjYQb3njE4HFzjx4bhtLbqzm08atPFScwr3VDbg9osRZKGPNMPmilT5Ypf1iCRZl8UvphAb4LG5IoVYz8kpQY3nhfUgZmmEJPvc3iGnUtxtjmW7ditB/8VdGrekPwY3DTslmtBEWagLV0Q0a8WWhRzNw5DgmnxGpSBgjdv8YKEOYr2EvTzrNogLsVowxvscMjN+h039MuPqdThd8GFybPrEc9eMBOdtikk6iA5fMFIawkjWcEgZJScFw6trQtYlx1KGOOGisODBpbfxQfcr899Z2bC/QfPc/QoBoe0OiT6Wl8/R3JfEwqHagrgh1pwaDt5nF9uYdnec6TUkj+fVLi42PxnllA3S9tNS/9GSD9odv3eyIC74dgAoNAfvtyqPtkTKP2w2+ttxT5B1uX6RHyPu2HjxCX4hHCPfDG47yQkI969FncwshSA9uoQe30P2A9OAWuj3OHtxCD26hr8othPXwvja3kIeabNstdHvpvh3f0MA6H3xDD76hB98QefANfW2+oUYjx/KGgQ/vXsOf660CH969Dvd434mSmKaGkpqY8OYmsgBOTTXs5Yd3r321PP9mDHdfMDLVjGLqhFpKwqVVxBQL5pgLXpZGkJ/lv1cksPnbWACGbnP3d2he+su5R7cWo1itf2e5XI69UWpcqJ22WRZyZgoKhgLAZ0VXGCTtg3idRoCl/QCvGFQuVilPlraXRnyeDZh8oSGCYSMfXZ+KSYN2OlexrYm/xXtDQE8bbC+hhdeZpvNqe52bdp20zSxrjRaEzqwvzTH5dpIh2qp6p2PsnHw7Cc1JfC8WVLg90B2escU087MZikpH/2AS4pXbT5+WA4HVjWFpt1aZ7QXLN8R1cQltAkHCT0ZkuWAQ3m9b7Vg0K5Q0VjdgcHTUg5HjwfjTNjzlasxAt7H29p8cHz/ZR/Pqv/3+l5a59Vur2mVph5sD3aewwmY3sEbfHwhIxMR8pLjavir9Vlkfkc7lQHHQUV4LpoynE4qihs0cYXoNNfn20AIS3oSa+wue+5Qbn078W2NsCuUPpWEdY1vbXCfmb8XP4rAU/J1LaiKgoxbjHfT8ftLGutHW/NzR843JdvK+9/zcDz/YBDPBYLelIJ1DQ5/W3BkP8gjaGd9w27hb+mt24+hNeXz8pJ8eevykNT+keW3rDDo+CxN4eo12C4AXf8ECA4NriCTv0Nehqx47/zdg5+wjFALO2jjks0CqCgrT2FNLKvctHMbMMI5VmzLY4VMbKjpRmG/a2PjWKJsMF4uhGnHE2E2pqm2CB0DHNyf+644DruVhJlNml4wliQ7JVEuFekJHZqGCtK29vYDR15M7MJKdDkvFNNjJyaDoRXjXsKSerrzlC2weaZDxkRyClkZsbs40fO/V7Z6rbLiQD7yKIgj6A7NrGuWyV87a7rMfskIY9BrtQAyswPmdxD3hzPijEO5y2EDHLqiEz3gZ0leD9h4Tbr1QhGMGvkmPpeouYVX/QBPIV2T9+AoMH/9om8eDueNGc8cXZ+n4Yo0chulLOg+3n4yzk/T0FvwdxwhcPsVluvu8ry4UqldEyeKBe++ud7600EItfRvSJZvGuBEIm8nqTWL5CKqdttBEUIN+cXuWjP0kPtdJ9rN1t4SfL0JgwOfqkpRRCKKuB9QFnVHNP+fd9YP0G3rdjh1KxDXgo/+DC0H3n44PyCNE47+QF+cfPErJzxfk8OjyEBtVhhppj8lpXQv2C5v+jdv9ZwdPx4fjw6eRnTz620/v37we4Tc/suJKPSY+mmn/8Gh8QN6oKRds//Dpq8Pj5x5P+88OuiViH4pOD0L9UHT6oej0n4P4f23R6e2C+h99rrtGNDgu+M2em+SETBm04PFaw1/xr9a4/wqfvwiGh0JVlZLwXQx5DNcEUCOFr/rhC0R/syZ+ESDrtE0YWvzGXgh+fa2RHWRjyyv2R4rWw4Gp4NGsWVO7OPE30c7LFZ9rivNZ3bD26LiW1rBq+hsrYgNs+OPyxpX8a5RXEbOwY6HPFKDTR4W2IYBe9i0Akoq0dpJX7qNOsUqoKFOW3Ff0cVo6xKn6mHqYJ9b2yveQDEeEr9vBDWAl0LKQ69ZG9qijv4mOiPL3Nu4fDDpIdv2BB2m0O7o/R4VQTZkO0gv3Z7BCQLQ49QljA5h4439FzbhofWrcFrEypGbQsryEFy7DkKEIm9L5UWutGT4Y11o50kwX88gP/C97HzfTUK54+k8cvfyo1FwwXLHfwW/JqUMmZiGJMj80MXCHWTqOgMFSb9iNwZc37nU2R8gqSQlxm6eJGUnx/TvPdAsC68x1WxrOZvPJPZfZMdw8mf9gnH1w27k8m+eC29XlLZjr5q9uO6untNtuXI/KbzsPRtvdao7Wq2v4QamKK6BSzxBehr8HDhf+Buk33aQK/5s72mahtL1E+XBCZlQYh0oqi4XSYb69yAzWiN0IFhmUHuu4vJcYeQDKMJoyVA1/Mrgda6aq6LwvW26czX2VH6U7ztr58naTfvp0gk6ZMI5lvv/55c9Ow1kSq0hFa8dnDfu3HiwtdYNsVjnIZtF75nBFEIRxoFwn7xLd/oR/DQxy5vSFjFq9EdZ9HnIOxxmBQp/1IfL0EuPVi4s8hYbHnBhWmPGqEmP/HqZVU+0DkZXcS192jKwI+mZKX781LUtoGGKqlGBU3hK9s4QR8L6lbe/Pq8x42nDRn7K/o1Fw7xw+f3l48P3O7cD5+YLADO3GJX7Xr5qpuwRjHorf+7/lzwYGTr9HBaetraRBSb7zmzlZ+uhGbtYCevM+d9Fdq3L4qN/pAGUYqJVvyjw4VTPANz91pnNVkg9nL/sTQbx8TYv7W1QasT+ZKnts9k9OFkxF/cmQRd3MCm83kee5Fa37M4FrAitE3td02ZDDc2oGqWiG2ftFaBp3DVpLVgu1grixe504jbtmYsg0njXi3pecDbxm6hsk/adOHIe9cdphtebPz4vjenae2lr0mloMjBvKoUcuHi9sQ1w3b5lxF5bLPt5WsQp1xXtdEsh6hfs3JdQVp3u0sarkplDXufr9/+Kv5KX/ZUXy90h2q7zxfj4wVC7zPBxxyHUGMP/eGI0MbdPgHaxHweqHuVbueh4AyGx/w3PyTTbHdXYkWiy8r24BJtDoQW2XGGc8VGh2SChJ2WBzcku1beqW+Q5UPaUrTFeL9i/wFtdU04pZBgV4pgwsVm7foFk4w+gmfOD+xGAmXgJohl1DdZqaamswgOfsfETyhgi8HIGHHHwULZCoLLE0P1ilhlDoa6jVWpVNYe+OyPc+NxTPrh+G8BmJa9s07SeTS2vaXRPN2Y+ymR/fMHXWXu+OM/vGeVlqLC4/owUTa5h0M4kDHCGo/86zf3j3mizc9QqqE8B0nloBkk1IL/LG/wMXgTWz/hIjmcP6sGwCkri/NNHGLpi0oYe9j3ANbE2oeeJir9WczLhAgNG1v8lUL8Lrgsu2Jb61TKHmY/faOIsxHUKtZr83XLMyqew3IBzm7pTNcqAAFqCZSB4VHEMbAVtD7WsAyDjDD6mxxgmZ7F9TvS/UfN+3jxNqPhn31+kjptvVE/70Yi9aJRJ6S1Zz7xEJ64YC6D7rbwBINZsZ1uYpWSDtp20DjunjAmulLbT0lAxZsiG060YxVjNa3ReGHOXiiGS5YBKw4A554gEYW+4P5K6xpWrsrjsN7t9M6902eFzWjc2tmAkc0ApuxAoMgLVjOvuV9gqL3YOgaYevAyqRKKF7R6rrE+cI9pAJFgVSmJLtw/dxcuPbMXh++AMXDNxqyCA8ubdWjf4qw35vmCzWJnfemULCgJkWAUwyD9SMdLEywDCw6cnq/qjUD0jYR6tpMoCiwOZKc7saBiX8em+ghAFjhC/MswkboGxwu7qEq+V98tBFU1E8LuARDBNt3pStgxEm6oARW81hn677BEC2nU9u+AHOORN0PlhIJR9sWOLAp2GGoa1eWFuPsfeHYWMvgS8Fk/OO1BzwULY+napyNc5Lvmx0UXTC+26+cCWDYlxz/5P1t7RuoHF/B1vgOSbVucXfUS2LbM8PhaHakfWu50Rh6kqVjbidp7/16ka0O1K/hKpFllb1rQYvNMuq6XVH/z8BAAD//6FQW9U="
+	return "eJzsff1z3Day4O/5K3BK1Y2zb0R9WP6Irrb2tLaTqNZ2tJb88nZfXnkwJDiDiAQYANR4cnX/+1U3GiA4HH1Y0Xidd1NbtbE4ZKPRaHQ3+gtfs59O3r09ffv9/2AvNVPaMVFIx9xcWlbKSrBCGpG7ajlm0rEFt2wmlDDciYJNl8zNBXv14pw1Rv8icjf+6ms25VYUTCt8fiWMlVqxg2w/28+++pqdVYJbwa6klY7NnWvs8d7eTLp5O81yXe+Jilsn8z2RW+Y0s+1sJqxj+ZyrmcBHALaUoips9tVXu+xSLI+ZyO1XjDnpKnEM437FWCFsbmTjpFb4iH1H3zD6+vgrxnaZ4rU4ZqP/7WQtrON1M/qKMcYqcSWqY5ZrI/BvI35tpRHFMXOm9Y/cshHHrODO/9kbb/SSO7EHMNliLhSSSVwJ5Zg2ciYVkC/7Cr9j7AJoLS2+VMTvxEdneA5kLo2uOwhj5paNzHlVLZkRjRFWKCfVDAciiN1waxfM6tbkIo5/Wib4+d/YnFumdMC2YpE8Y88aV7xqBZM2QabRTVvBxAgsDVZKYx1+n4wCaBmRC3nVYdXIRlRSdXi9I5r79WKlNoxXlYdgM79O4iOvG1j00eH+wdPd/Se7h48v9p8f7z85fnyUPX/y+J+jZJkrPhWVXbvAfjX1FLgYX/D//OCfX4rlQptizUK/aK3TNXDhnqdJw6WxcQ4vuGJTwVrYEk4zXhSsFo4zqUptag5AgKdpTux8rtuqwG2Ya+W4VEwJC0vn0UH2BbgnVcVwPMu4Ecw6DYTiNmAaEXgVCDQpdH4pzIRxVbDJ5XM7IXKsUJK+401TyRwRPGal1rtTbugnoa6OYcMXbQ4/J/SthbV8Jm4gsBMf3RoqfqcNq/SM6ICMQrBo8YkafpPAm/TzmOnGyVr+FtkO2ORKigVsCakYR7jwQJhIFBjOOtPmrgWyVXpm2UK6uW4d46rj+h4OY6bdXBiSHiz3K5trlXMnVML4TgOv1oyzeVtztWsEL/i0Esy2dc3Nkulkw0WcTktWt5WTTRXnbpn4KK2DLSeW3YD1VCpRMKmcZlrFt1d3xA+iqjT7SZuqSJbI8dlNGyBldDlT2ogPfKqvxDE72D88Gq7ca2kdzIe+s5HTHZ8xwfN5mGUPtdF/7nT8szNmO0JdHe78V7pV+Uwozykk1U/ig5nRbXPMDtfw0cVc+C/jKtEuItnKGZ/CIsOfVpduAZsH5KcD/VbSUnC1BJpzx3JdVSJ3dswK4fw/tGF6aoW5EjawqwY2m2tYKW2Y45fCslpw2xpRw74msPG11c1pmVR51RaC/VVwEAM4V8tqvmS8spqZVoFCpXGNzVCh4USzP9FUCaSdg4ycik4cI2cD/lxWNvAefgtwFewTEEJzgbgl8wv7fTEXJhXec940AjgQJjsX6VTRQAACKOLGUmuntIM1D5M9Zqd+uBwMAV36ScOWga1qxx1+GbACI0NkKjixkd+/J2dv0CSRds2EaMV50+zBVGQuMtbxRip8Cy3C+qDURTuDyRIUO4exQb0yNze6nc3Zr61ogWB2aZ2oLavkpWB/4+UlH7N3opAWOaAxOhfWSjUjyOF12+Zzxi17rWfWcTuHl0/O3rBzYCdDJPMbEZkc/+6slW53iGYuamF49UEGqUP7WXx0QhWdLBrs6mv39epeehXGYLKALVJKYTz7SEuEfCRLlEAopuw3ka+DTQOazNRoHQQDjudGW1D+1nED+2naOjZBcJksJrgeoP+IGInQeM6Pyif7+2WPEKvTj+Lsd039vZK/tuI+8yYmP0YW9YyN9FqgXp8Khmwsi2unV/SmB/+/iQmS1QLgexJhsIKWcdTtJA69CprJK7BpNehKv3L+bdJQc1E1ZVvBJoJNTTOMgN1Cs+9oQzOprOMqJzNmRR5ZGBiFEjAJqVPWqVPRcMPJBKHpW6aEKEA2KbaYy3w+HCru7FzXMBiY18m8T0swfIPkwal6kRQe6dIJxSpROibqxi2HS1lq3VtF4MRNrOLFsrlh+egZDsCs40vLeLWA/0Tagilo54E1ca7BGkd4qM2D0GUgt4PMjlTt3vUsTkNMRfcKqjBZ9hY+whwwQG/xa57P4UgwJHEKJ9CZDpsbIPW/0zG2T+wVnJ7CGXfX5IeJGZNXcsWOeVHJOxgyJ/QlMFwhSjT4QLXOBZNKOskd6OkSdqdwC20uWa6VEmiQgyoNuIHCBmk746YAZregl7Sy4+R9r7Sm0p/0pVa8YmWlF8yIHGy6yFUg0y5enBFUvys6NAe4wQN4PcEMpYgVKpor8M75P96yhueXwj2y32QoOb2l3RjtdK6rwVD+RAtqpTcowdQGj+sCDkXBEghUcoYry3GWGTvXtYiqvLXexnHC1GyHjgBOm52AqWZGlML0UFErE7TezKCfyQb1nDQV0QZDGzSAnQcUGKClZozblSFS/JH0GXvRGwB2Tmtb0LMEtTP+pAL0fmkV4udtQTCJ4kEmY2ugdQRW2g1gglT3C7aLVgcxROQTgrcXBopuChTWXk/ASdiKmisnc8AQDoZAY66Y+OiNhbGX4ARU2qhYnAb/Ucsr+ZsIXhM4UrNcGLT2rXQtp/U4LdlStyaOUfKKXAAMPiG95sRMm+UYXg0S0ToJ3gZlW7R+efSNgNQshHXAH0BTIH8pqyoaXbxpjG6M5E5Uy0+w6nhRGGFtX349nEGH7I5LFZiLBiThG+VMPZWzVre2Wnp2xm8IJGMLIIvVtQCfDpjAFg/Np2djxlmha1gAcNWwVsmPzILXwWWM/aOjLOkI6zrRzHAdDV8EnALjTzJ6MPH8GpkMbEyh4ARAUGGDtd5p4Z0tk0w2ExBtk8yjNYFjXCNUQTYGshcYsBEknieyUW9VpksnVtZkoFMqHW19f7Tof9Zbh78CPH+siJ49Wg84N4M8wG0z0C8Hz496iPlJ3YLZfTiF9q+Hn/XGnAmd5dItP2zIMn0h3RLpPpj9G62cEbwaoqPB/ymU2xRObxMrOQ42wO+tNm7OTmphZM7XINkqZ5YfpNUfcl1sAs0Xfgh2ev4jgyEGGL44uRatTa0mobR2QV9wxYshpSqdpzb9dejMhP7QaKncunFfazWTDhwqIKsr7vCPAQaj/8N2Kq12jtnus8fZ04Oj54/3x2yn4m7nmB09yZ7sP/n24Dn7v315AEgO6fVwYvq9FWY3yOLkJ2/uBfKMGRnfSCD4bWa4aitupAtWAAuOQyO83ysRni+CzIxHG8/h0vjzUS6UE4Ysr7LS2jDV1lNhwE/mz8LBrglSjhF6FWvmSwtRgehay8O27oxJxt5ql4QP4KgBQp+3TtcowmdCh9lmo9W1m2rrtNot8sHaGDGTWm1yp73DEW7aaLt/f3EdXhvaaoTT2p3291ZMRZ9QsrkFB9msG2V0ehYVdJCIqCxSzvJeAPCPaNP5tE/Pro5AGZ+eXT0NMEQI4wS0ap7fgtd9aPPm5MV1WKeDe5PW3oJAoup7g5z5r++l2A/7eGjj7ouENu6mKbZWmEzUXFb9AR5MeoHwYjhAoPgaBMq2qj5sUIQCEiPLYBicN4osfsVlBX6jAflPqqkwjr0CV4SQaogvWu3ZxjytQ29jSZ51HDg6RPCUuNdU3IGNuYau+PomdVNqCfnBhkjMuZ1vaPgRUQomCxHqOVj5uTZGwLm059YHCnJECHWK0mqZBgkZ+EhSr997K8hlOYGP0BUNJwf8Ayg6iaGkXKvSe8R51RsTbI2cq+7EzELod0XK0Qh9Kg32+H0o9OOK0G1XWSsKQMRhiNWQeR4Er/M5CCYADuhVeibVEJFkS3Lckj0/mm6LvhstPLjei+YzPphnjyII4bzSLcaupCoNj2HgLsDlT8PeO0yIgTzPbgholeyNcEbm4NoEX1jiyOaQCHPoY2vAIaVw+VxYtLIS6Ew6SzHEDkng6MB3dhjDlBAi9A7SPgoE17SKgpNG1NpFdyrTrbOyEAk5VjHzOHFG0bMwIQJMZ3P8lCzEfpQef0kAuXk3eFCEMocEkg5VItin+EvyHA4Ym5PMo4uOQH4s4BttZlzJ3/CUAjGuEPKmXbZkhSxLYVKfCfzgJAZ6Gfc20a4TiivHhLqSRqu6b0R1vHXy03kcXBZj9r3Ws0p4/mc/vvuenRbov/Uu08GGz0are+vp06fPnj17/vz5t99+2yen15CygvP9b51b5KGpepKMw2AcoIr3xeC5AnZBsokGwqG1u4Jbt3uwYtJSJGFz7HBKI7DTl0F6Ia7E2QNE5e7B4eOjJ0+fPf92n0/zQpT76zHeoMqOOKexviHWAaXwcBiyejCM3gQ5sGxuQCghozvMalHItu5h2hh9JQthNoRlz+mDey0MmIUgb5qAxRd2zPhvrRFjNsubMYFksDMLOZOOVzoXXA0mxxe2Ny1/et3QpOiQeM/tlqpjL+iF6ank3sMbglvxxX4AgyILg/y4JGWnEbksZTgjRiy8e55iUOSl12UKJIrWi7mwpK58QCExIFFf+fTVCNqSJlRL0FHg8v4EBSWLDdhSZAR3k5dFfw/Lms82KlPSvYGDRdeoRwiSgKatrByo8zWoOT7bEGYdZxFefNZHIMkAvXn0JBP0hlzQleFPcVBKq+yNu8HV6ObcOX/CsMSyGxr5nYfOaq74DKw3VN+RDwaSpIBYkEnESBJFSwXJy5XHN4iS5NWbw63IomnUDr2p3uWz18/EXAMzibDeFlv10odiq19i7C8lwt0CgASR0gkeLAAYwWIg8P/vAGC6KE73svT/VVHAdBtsQ4HbUOA2FLgNBW5DgdtQ4PWhwESJ/dHigT3UNx0U/ARlv5HI4LWT3YYHt+HBbXhwGx78w4UHff13DA76CvCbHAdvhOO76eoE1yJVmGd3PrjfVnSwpnL895BqlFbVo7/FH8qB7bSpoUI+YxOR24xemoBvl0c0CCbNBZmybq3zpUxodHUl1h3//wQn7V9bYZbg5qEarshGUhUSKjh2d+lEDYWLhBDQ01ZyNnfVusBYMhv8nvoOAGoVKE6pnJgZXCLLePELoBpUZj4XNQ9fR4jENzSFgbGIjQhSzjFGmx7vxAc3uJ16XmRIZ48p7h4g7iOuluxSqs5j8d6XGNQofug99Fz7ikogXiV8GBbITMFojFRj4Y3tSjHDtOAVCB2LqgwSyIIzBqFnoztz8YbM41eABh5B6fkUJgYCxiPYw2EjIu967bkGA6qkvgWNWMO+drKhGjvlsZg/H3gsPriZx2h910VJQjnD+kBJpYMRiBhBXkCPVyJLnkDN7UqREVedTAGGgiULvlRdes/fHB4mvNuVib3uyvhRsITSZkALHIZwWA3RJ3gKgCKMEFrDgbpJELwAiocKWyhrMy4kWlD6RFcS5W13NhXwRjTBCSYnmxsEFE9Ncoymr62rmgq3EAJGorQ+kJ6csvoIrB+MSpKgDtFA7gooeXYSVuJ2cvvDEoGswTuqWp9ZXiFEX6+C5+q00BzF+XpCJ68R2K5Uu0f1lFs6ktei1mbJQMhhPQyBKxLCE1ht2FVbQfkQRvilsCsvW8iREgV+9AkSiodmEw8tIUYXUNGH0FnOG9earidJPzAAJSepswMEcW8DksuairROMSSJq9dZF3Ou2MS/EKqOJtkg7QP3+gSFwy4vismYTYjld5HlBT6Csvjd3AgIRkx8qU7oyxIhxgLswHE0MwkLDkkn61JEwNbbbbi1IG53fTVWbzEC6ptYjldAnFiStUp82iSWzeVsTuVn62UgvImbQpeDVYkwcXWw2m1lcTy7TcYhDGGFslQG1jmqeEQz4tVBDtaRh2Qz9hM3kOMEeSSsbIHPOtNHl9DSYcwWgjUVR7cA5RswHkFW1GyD57loHJ92KQigETrTacwa32UJahoxKpXzdr3vDFca43edaIiL7DnrljWODZBW15GY3AMZZLGt744EMgkbBhFEMJ858mwoNUfpPF1CoZ4ZtgwiJkGFCZuvkODpyMn30jV5ipV/yaNuWQnXCDNK1DU9mWKvmFVRcapYDVktXS0iOlCBiRa666cEjWd8w46hley3dPgzD2RmodA+JJ7lvMoxJEnenYovo65COpGmo0ZQoGCC0ukSVXqqYzEPn4ZuKtDEiUQQOGdXSv4DJrVWsivEZQmI0cgy3a0Y/BlSwJxml0I0rG18eSp+lHaj6lMVLGGc6AodQWT6g3fOq3G6sl18cM1pG1zcVrhbuPxekiz1h9AwyVRgbXOtYCvDS5xN6J0JewSS3QrH9shksMJ9A/wcPOO+swTYasy20w59BpBqXbSVsCjqetsulZPeMoBwfWuA16plaCIlVTdoeuD3LNL95IeBRSVs8eWhiLGOO9snedtr23AHV2aIqa58KVXTug/hR8WVtiLXXXW5bl36ArdvZFXJte80RuQSZPExO1i7mC9p6LCgZE6rdNiUUUtSOKivkXT+bwE2oxHsUukFneC90u641K3f9WFLw88IBbo3IPQkLSnQWKjiDm6264R3h2qPgeD1VZGNQIEL4nNQeFdp6AmkOrT1C42FiqyH6gZdgj+AF/BRI8ycNxaOOr7tTinVTJjGSOW+gfWEumOvM5yGBUDV6jRBBJi1VtZBEz0AQl4J6ZbZKrN3CZ/r/nXy1xcvP9uR9/QlSORgrHYrlt2p8ww4Lvq4PdiioMEN8HtbqScZqfOKXXO8XZAJtprh10GKPNspt9DcjY6Cia/vBktxxRrHp5MO5gQEm5iM2YRX3NSTL9PAQyR7K+vldn9tH4TvevqOtAPKt5sb7qDFli5k/80E2qr+0yZ20hpOvF7aX/sZIsFU28TU3/EF+oVCMz4gA5giJnLTezKRbpAlfZJEIxb6kklViI/gL4DeEzr/QGwBjFlIC/Kq8PoeAwwgw6zgJp+LomNYaKIkYxMnA4pcXAVbdvLBG4mTISXPRcMOvmX7z48Pnx4f7OPBnb149d3x/v/8+uDw6H+di7yFVAP/F/RKE9z5M4Xxzw4yevVgn/4RkVqAj9i2ObhzIPCHZkjTiCJ84P9rTf7nA2ghu58dsMK6Px9mB9lhdmgb9+eDw8f9MKluXa5rsUnxRUNcJ8F6LVU7fwEcYvA0SF1UybHX07E9yLGUh9GHqa/Gv0jSiUhI7T1LLqvWiLUyKUK8k2y6u0yKcO8umzzOvbUz0l5+sMmmvG6blpXmbt36vJP2kiEEsEoaIzUwZ2+l2CORzTJmiXGZ1RWiCC3swizAWY8nER9YHdnuqIfzZ+CKz67B/QO4XfoTWMt/105i9Bb0GjS4KZi5fULj6FoDizz0sWRsH9byYH9/VbaAX4pL5cvuKbIJrW9An6BLBF0h4IX0s0dWZNxaOVM2Qch2qw58ByAWUNQEQR8B3KO6aXiqUewImlRS56Vs1COiFVfCdNbjHQ4HPcKd0+crXrq4dgF8j3wZ+wnm18VVWLS/XfcFsX0tOBxCFej25LAeT9xAQzij4gFsFI4ZDI63Tq/63mB9an4JvWHBTeiHkrSpc62stA6AE9lCYG5lI42erdAQTgV9At7D/Pcnl1sPAOSQTI8ABNMLLTgKdI6da84AcILZYMnZKNGo3Tmry+TuTwmcE533IOkQ6nuEBp94wLlvpFbgsVqShClEydvKsfOlBV0fgaaC5hTH0w11XsM6voW0qdfjpJO9cVBvLyGjHENEgiutMCBw+pIG33nVGt2IvZPaOmEKXu98k2zX6dSIKx+jCK+fX+x8A8vIFfvhh+O67phb8iq8tbv/5Hh/f+ebbPRZehy+Exhe8UEvMqpbsLAS8lBPeX6lsRozViJ0fcPBzQnMy7O0xzD4LdKw3Hfh7xuicifYenA1hMPAWTM4j2B0zLIpHNrJTU84U5QJuqxj4D3ERgC2F4txeoAUFaBEdxu3Vueya+6LFlnoyhcCV+Fvroo9ctL0w2m4oGCJaCuon7ePfOCQp8EuZW+8Uw/I+p/fnb75r9D723YhKqrnxfZ9soqR8WBFDCsxeFkK39xeVoP5ENBOxMQg5ifEi/I7Fr5cJwNf89C2HhYFxufAQNQheEV8FQKqpO8w2n32wEsEfk2NGxAJEOzjg2MP01IeDKURskgcJdmLKGhJxPIKukgKbpewzE4gC03xj+TjNUkajZr1pjOTxYYmcmYktmTHHQ91vY++P335zfWE7Xhu07goXt+wwFINEjYeDI9TgN1ltISMDUAiRMNSOZWiVW8Oqze66NEDUNG541WHaZLRmjDT0cHTPo4PKxjIeYQWTq0LyDFZEQ56EWpiH54quA9xgBF6R0yX9R2Gb7ibb2j0M+7mwagd8qiVv92FztdZ8jg1gAErjTVY7FH0iWg4u/CiCLbbBGBhqtsEEJl800fFcTMT7sMGSXGBIzAYAU0Vu6wrqS5tdouV9GAIILmACkClSozh4p4x6zBZoUi7MZF6QVmbKE3fozQ13VE7ScR6dL4iaj0jp5lTM6FTA+17oW+zz74XOlgfYCzl3Jhl2jWFd97fUFGSNojhQWP2PTqo1ZIilJ6hR0ZZIYyM7jQn8jlmnnVN/wGz07NggUMU29Np17Zw14ooPsW4+XLq7r74mrsvsN4uoPSF1NoFrr4FlX9dnd2QTtsauy+hxu5LrK/7AmrrhoeFoL/ig+s12EUs7CE1BuwEPkf0qkZb1ysIyh+HV4yoxBWPm9PpNDBxV72yMaPgIYqYNigF1lYuhXHBu5Ku4g/h7xvMkJPYVqfnJqK++hDfbFpMXI49oMJGhYoI+DZe7LTeYZne6dS5VeDDrrFB54ntX9yEZiFG/dbmByeXOOFcka4xFZggzrkp4PasMbuSxrWQiOz7OtkxewktH0zwHKNYg+jA39qpMEqAIQ8nzHDuvwtfQihTwgVcrVlhgQfZ1T82IS+Owh3peIN9/vH50w9Pj7a9ELa9ELa9ELa9ELa9EP4b9UIA/bkhTEY/EOwgM3s3QUIYkILlXQK6pWS3uWCTgBkUGtc17F8jXGuU7V3eGFoojm606h5mPmTS4bgybct0YiMdQ/oS3fji643HcPgISSTRfgUTV6oZJiNQ7vmNrVG9pUzZyz4kCJSdQP9bFESTVSo0t1BhfZ8LWDYmm/X9CjbTn+IHWsr1Y26KP9/eyJvg5CK29FyZcGTCie/xzh80ooKQxKSuX+G2JnCNR5jUKAxmEyrueB0rpbpCJXCRQbEBZEeoAsK4IpeFsGTjIhtFoE4Db60svLZZyWtZLftUezDV9OM58/DZo+DrM6KYcwfXDU0lV2NWGiGmthizhVSFXthvBsLIvznAu6021YpjYPNSKwxMbggxH0oRY6GId60cfcNz9uM5e6N/4Vf9MjFts0sw+T/bHPxoEW08c0Fyt3VmXWvTo+wo2989ODjcpRKwVeyHe23T9A+Zygn1ryP4f6xiG47NnwvjMB7xPfiwtB2zdtoq197E69wsVhqp6Niv4HMhT8PdyiMH+9nBUXZwSxjnYS/0XBG/cCPii14PYrpVliIPve7qEAHCa4knsW/yBG/Bu6q77B9q1JnYuiTbwZBNLm1NOounEY9OV0eI63T2aNtcaNtcaNtcaNtc6I/dXGjuXM+L/8PFxdkn3zwCH8V02Cy0gmGT1lTU2BZzCJ3uXYsJr7SmCvjStbZ39+eHD6a6WGZpQ9qbtkeSkBEqJ9NP+8Tt5Wf00WQ46ip5nz9/dj2KlExzByTvwwkXdBzxi3Ejlj+IqtJsoU1VrMd2A7S80JDNZG+i6CNAFjf7XPBCmDXG1cHR4/UEhq4turgDzvch7ahHUj9UIuIu4hUxeF7znWGmIi0PcJpVeiEMpM6jCA3tpjJ2LqgmVudtHfK8ImxL3Vl2TkNaPRwIXr0438lGq8SZCTdmDXQrYU3r1pIJL3k2G0vYekfgSc9K22PGwWqC7LHHe3vTSs8yeprlut5bwd02Wlnx2fe5H/auGz1F8vPu9JvwvH6rB3w/914nbO+32QlpqPts7RpX722o99Dsk8/DXO/cPdrvR8Q2e5pDvGiIIVHwtBYQCV2kSHm/1rO76W7vXuK95j0gokJ3q7srYZx8nxAPYtiMfgxFTYBVDHhQ/68Q+qcvmb/tXvRKmhfcqMmYTbAVGvxDrin/FMb0phNKqTYxo1Cc1ivZgsmEslq+2pIAd3nyBoEF87eEQjbbVNLhuV86KMGSqrNQG256XQ5P0cB2cClcyGqdENhgo3muSJ2hXCVtYQBiWn8X1oKgpGWf/WmEyY4HEwplvRHmnF+JWGYEzdigOhr4M3RJ9NmE3gkgVK79bQeGKbFg0HsF7NJaX8VtyGCyeQVlbW2zinJCnntVJTOrqeh4NIL6e1TrqR94GpxdaBj87uJkjLSB/4S9WdLeD4xLhTGpNHibPLpeImDDglBW00/pAMwhINMqor/PANZXwgQJ0uWPYNZngJOmZHRMmIx0rwSQAD2cNAjsasFQaP+Tje4sxZCt1sWgH0yoj05wKOz8gA0SuEpHJQnXGO10rqt+AyJuptIZbjovP6NyVeqXiI0G4bKSS8FqCdWUVLI0Rg7kldU4GLYjSl+2l8tGdJ4zmf86ZiXPxVTryzFzC+mcD1BIyxZhnULUtmv+1LXuZFdCFUmPJG3idYg0mUKAii1i5nBsg+B3wR40K2SnZz5d2oJBa6DQK4G5kCZUCH6BVjiX/avc1hhYA3XyKcbVyJ+kECxzhiuLNjfmO0417BtpBHVl66hzWrIJ9ZvCL6mUPm2WHp6H9j1jNgmblX7y9VmyWwnb1kMCPH76vEcAkiBu+WFjjr7RifdaYQNPmCTOLpkcOz2DBgVF4CZu2UJUFQk5Asni9usSE/ryj3YC9hx2Wle7fKa0dTKHVkWq4KZ3VWYEW1Z6kS7Ga8ENNFCDFA0XT0Ez6ebtFM8/wCDYMG0vEm9XFrtgqw3pfXA8//Hf7NujH/7tzfdP3vxj7/n81PzH2a/50T///tv+n3tLEVmjvw4PYt7svAzAg50WxLUzvCxlnv2s3gmYD1rJIUQOFb4/K/YzgWTsZ/YnJtVUt6r4WTH2J2gFkfwFHUWM4pX/TXxM/2oV9p36Wf2soKdzCrPmTZO0HaYLYEF57fo78ai5G7xD3WfHUSElhk0KM0ouADOyDNPHYfJXUiwyj8M1AwfSQA8HYWQtnDAekR7Sd8OpQ6SHAWCCUQsaLIUcB812VtmJaN/jm1KbBTeFKD7I5hbWuSHPILlTI5ak03ZNfiIDuTH64/A4e/AttEY5yA576Emu+AefqdTH7sEEzOnJ2xN2FqTDWxyKPQo7d7FYZIBDps1szytm8NTYvSBPdj1ywwfZx7mrq3j0Zeyc5Ajqq9CdJHxlSf7wCjtVoARDU+mtcN9BNSpIOIv/IudshAvdwchma8k7u25OA4L3qws3HQHxxtF0yTQGNKHVOPiMSZ2RXJGRowfYfg9OLvaTLOUDXnNCCpeA3Evl0rdrlG73yxq1G36MIIMCXq94D4/6s6alvWXa91ms0etn4XQRh8FRMyY+Zgz2xZhVyOK/8BwsSSAa6N74+hdoucVQSKBgxHoTJDwHhuc28nIixLzVDsnzgnc9HwT7mx8n3YbxSoCOwhVfQv1hWzRj5vJmzGRz9XRX5nUzZsLl2TdfHuVd3nyWFIRTH/D98fwUK64r5noHG/gtsPVroGIGtDvyFExOSY0V+Zg1skaCfnnkBKQT1wA1pTGpb+DH9NkNzoETFXramEG9B5ijkleBg8exDhZOa+nhlvDzfSRiY99CQM3DOMDHj3wjkdsh7vb1GxlXSQvXKF6orzatMGd5a52uY4WHBwo1KjB8aHe/2t5Eq1LO2u6CEahVatXdCcCsLh0Ml3Q461eclNKIBa8qC0lqzrSY4eUpJLXaawxOER5SY6muFUpiuUIfb21i36qFmPawSAbBfO9KW8vWgQZCnpy9IWqg2REQDdyQOnCg39X1/hsSUB5vnzGiluAsTJqDwTxtZAUb2rp4drCM34HEoZkKwaSWKuyN9/SBwsDjhirYq4vXcKxrNBSQdG0XqQF0YqzHzizBdACHObgGsXdVIaChcKAHlBCBXvkEp9O2rmZbV7Otq9nW1WzrarZ1NdfU1ayW1QRt008wu6dTJnG63Aj+s91TGobfFjhsCxy2BQ7bAocNFThYYSSvNuswDudrOELhETFxr27aywFuiHCHQCpWQ5PbG9vVC0N1jXAwDJZTcER3kKBpQrYu6yaECkx6mUA4eGIWTmHxP42li78+LvEfuqqEgX/5Qyz8qzuCrsmNCDB7JO1Fnx+SqHHmfoQ0Pb2/qGv3wYOgEFmKhki7eGgz40r+1hn7wc2z+vyWPJAUTjjfC2Ug1QMtWZBv/dT8mJwBJ2qugpbWhuzVHtOtZGpExuvdODoXVQPlNowbA3ezwUHfd9j0cLqbfLjySTpQTan7CfoRjW4+n9KS419QkpKi2mepTaqxdL2DeRDG1b1rhzsRfI764xZ2AiH043nsVkf5ZOtZR69I97tnH/4hLcM/uFn4B7YJ/0AG4R/YGqR5fi7M78oanSkYaew9vqmUO0se3fmK7GuFGw9DrNd0kBEXtV1Xbkc+5x484KPkamBZ7CW8TEklvbxaGCneq5o1WHZXOqEgU2lpQ6vjcGcv3psMXneCiAZiI33ACnh3Vukpryi6BXGrgG7nULqLvOZmZjfEF6MTY/iS0iWQSNzMMCKc+sne4O2RZE/46UFEWuRwFYay0smrXr1jNlphI/pzl9lYjbnLdoM43IVoQrA/d+H0Af9b6dEsPoq8xQsPNkSKkyne+QI54F0L40CVbvTBDtlrrdmbSrUX5vYZ5OaIdhxpIXrFJ/RDH2rYJRWkWjdGzwyvY62jlbWs+Jr7fVeRb2RxizV+XeZHQE2u9LxuBiDXkuM2sA2H2ycG0H/v/SYX4Z7TdNXpHpPBko8O9w+e7u4/2T18fLH//Hj/yfHjo+z5k8f/7Pvp8dqrIvtd075AGOz05QCJo8OjfkIXmpx3GOp3MRwOknDcBZELn4/xJnh/ky1KSkvpGoGggGgGcRefXT3tLrV0x/FSy6TZAONsavQC6qatCDUbhETYohCvbfgsNv6pMBFKDcqcITYu1eyDT+cc3FT9YKQCitBYlOIEl0HrMuWswWLuzXUt9njlr4wIKKfxelK175JHN6ramOcINcRwuVPoF1ryHC7ZBZ3ZyCuNROUGckVBVUqRJ9dFwdExLjYIF/+CXb3YhLLULVxrAuU0XC1ZU3F4E/KBMcZL5QXsIkWBQPub6QATOtjVY1/0DN/yoKIgYo5DUBasZwBJahWq3KCuhUBSVYpiE6JiNokzOYHkhNwIF/0w4L3pPPvCjintjybWYpshCFnEcLsZU9Z08NgkCWpjllcS7+AKr0IUkKLxWZoXim048NgORR8FTvH0LGh7pzvsZTMZe5MHrmODTFBPNOot4JMAT8+YM/JKQj/fMVNwkRTUIvhKAwIqHaQ3CG6g6+d0GXNp0qGOeTbN8qyYfIKVIps7bKj1MZWTKpapQco5rrEO3UNCu9owThLtoD1x3j25YUucsPN1GTldfWVB3RnCQgGTKEogKrXpZ80YMYOEUzCoIf0B7/Lu3oesDMOmMqY4ghXoM0xzbZJbgaGPy8WLM4LqY53ksKKUXiNyISGRiAgklcRWD+f/eEspmo9saJlPQAFgh0vGvosdW0Ie4WAk6kJbLZN6U08PgrmSmq5suHwQpQLlwEA3gzbEUhGSE6ZmOxHeDgggLKdOwAYs1AriNvT4wp/J+g8h32GhE0Gk8w2gB4LNrgyRzoME0nlvAMhWaS3OgiB2GTpSAU/80qq8O174nU5frwPWkbZrxdGBhN3rl3EXFRFxQmSQFx78XphC/2YTsPMUSC1mRc0V1FRQzjsQGkq6PvrLiUieEVBp8QQFLUacZlcSpgt1x53XUbFcGMd79UpBVpk4Rgm5VwEmXW+Vcydm2iy9sKI6NetkVTGhbIsFT9xdV3ECBCtlVUWxwZvG6MbAzVbV8hOkEUnyO4ike5lDyPV02Z1fmKg60KMfBUw9lbNWt7Zaem7GbwgkXEIMKi0a7Rgx4CDGx4yHdngo3ltsogdNlOEW4n90lKU2immHEIYsD+4ewinw/SSjB1S6GpkMkzAVFGUSVNhfrc8S88e9SSabCci0SebRmoA7D1QWaI7YXrq7ro8BNBm8x5sq6/or/ABnUNeVc9BGIevQb8+BvXXwvJ/27Sd1C2b34RSSBx5+ts1k22aybTPZtpls20y2/0aZbLK5BYf1h57RMJMs5JHR6wz2NfDVSpiWnZ5dHYEyPj27ehpgiFVd+9kS0NZlv1ER1i0IXOf0OqOqsfso9r5P7A51SNciAWVBN0xx27xy27xy27xy27zyD9e8klqLrHrQwqMbXGjBHQN3D6/6Y4KYxN+0WXOfENhChBxcJ5TrqsILn9eHeWOIt5TgmVZFwp1Ylw2ek+TqxjA2WKkU3P4Ed4Fo5qIWhlcbbLfxKoyRiidNBmBA/5EsUd3jHeDQ3o1AMWqiUVBdJFwJgZ4dyzi0rgGPJIarrK+DnRBA3H2FxguWQm+fhDme86Pyyf5+2SPGRrbT6P3q/glca1qlwIsQMB5OmbwSfgdW8cbQZY90VOZf80uIOjjo6Wgl3o+fCLYIGlkoKX1EKadV0tptiE68ZiL47A2sE3SFECqHGUhroVwO/YIAy4gCJqCgz0kuOve9D6RHuOFmeImuFiu6ZAZAMDI7utesVLNKdHeEDVa0ePxMPBHTUuxz8TQ/+vbZYTEV35b7B8+O+MHTx8+m0+eHR8/K21oUPMyap0qO6EkOxmT/r0mnZWrNh9J2vA8C1neFomXHi8Ut1Em6hY7k6Y5TARavO4DcdMwXDANeJ43T52IZuknFOKWM4Tf4H91IEXcb4N3FmRg7gTAnRFw8esBkhYQsrGkLM6fP6M4T0yowUKLGgXiTXc++QFAK13STZVMOVcI0lZXUAKrixl4AumSvKg4teCiGlJAZ1RbV/gY1DT/nVWshlJSeihiGFv4quLNDENJChmkhSt5WcMlurpsYBo30AnFK3sgIU5YQuQowaD+KYsjqIp3DLu2ZHl/bJMb4kIz9gu6YQfiRt2hO/5J09U/aXTBuYOxQWI5qf52e7QlJOItpFYeLUAHiNZIS5VdXFIxSs49dnxnHne4CqF0fj9hxYNJb+MktjNFbDrIMNrEi/04ZdSsLEmMqC37jqnQyDNt26EtwSnFK3hbOX2++YvPQbIABeRhwSI3H2WGWdjbwoZee+dc9ucH6828NDL9BIC7EdhAr7wjYoyguodaHlETcbom1pZEiCrh9kREhim1tI0JfSETIrwc5jhIm+heGhTxK27DQNiy0DQttw0LbsNA2LHRDWAiVxR8uLERYbzwsdHftvpnY0Jp5bmND29jQNja0jQ394WJDralSx8D7d69v8Qq8f/eaTtvhJkpm2wZEK/IG1LdXkGePaa4G1/L9u9fULY/eDPoA6DU1gl+CQ7bQC6glAId4DnGTMR2WxlifRd9rFsT8XTwA605zD7dpXtLhnMhtqnHs1r8DvY7JKZXleifZEKcKT/vol7WMIz1rvvRJ0pTECxaBb+2HdPVJ5dWyq5MNnoEIFeabeZcvFCVyK8aUXR+1tPemzXRQnBM6xZMjYGAN9qfQo2tp+KzunBgPTtkzbYJ1Hm6/46Wj1hyTrycJoZ1uUupegK/560m4nITuYkFSBKSz0ecqMz8tETossXd6yRrWk8pysNgBukzH1VomvhfM7w3DMTDi4ZrADOBNILdb4IWsSXdzCQFB6LjoTAtBVpAHlDkenD99x1NqxiTLnnbr7pb/+Ojo8Z53r/7l1z/Tc//3107329KGe2w2RNXRe+UvuxFFdz8QsggVkqSzjbMkSHhCoox0qWJhQNccdJz2gini7sSmqGExkf5G8CDGcHl4DnVe6EH3MOBTaamc+Bdo1hxT+UNrWBBsPeZNVzPWb8XPIliO8U7wLwdExz3Buzbye6+FBS665ufemjfc2mQlH3rNzwh82Mu9q/I6HNymDKQzvNCnN3Yig4hAO9ktp4216NzlxDEY8ujo8WDjHh097o2PZV53QOA+9MBoFA5A/Br9Fkgi/wtEPdVs7RwIJtzPw3ZW+Gogzv+C4lx8hOYcIrnGIR0FS1W8MiVzEhQAm/xlgpsxWlqMujYluOOn+A78xuEbTKgIb42TwfADStWIEONtSnXjOnwQdf/mhL5eCcD1IsxsKtxCiE6jw6AQ2c758EjvDaRNre05Qr+W93ZQkKSrBCIVCpcFmxyvVb0e32tEUm9mYCtv8Jz1nsCvTC6tNoydCYJFHP6+IVB2QeZ2MIzDbuifYmK4DF/1KgiUdiWueNTLZJz1w2d0HSHwD978Bn4gAU7m3pkEnkjoN4ZbIZzl/AU6bs4h1QAOw6F8tTH6ShaC8f/H3vX1Ro0D8fd+iggeChLNtogijpM4oS5wPahARxGPW+/G3Q1k11GyS1k+/elnz8RO4vzZNj1AqnQ60Y09M56xx+PxeIYf3NKmqJeZvpskLnENhT4W9890gfxG3o/fwPHxs30ed+6OTnfHL+fp+GWdHLnMJmLOpx9Hswf21x763cBgLW/jMnGep+xCnL2i2FmIuPOF3HJqoYW6ojKkSGXBcSM4Obj5JjWPU5HBWtgUpLJ90V8lR7II0SrL5lZWMmGriiT+sODAgObJcisEWdbV5slHcSmy+P88u35akUCdQB4mcuIn8kz9iJNEjI7Dw+CBYeOfwcmHT8RSZJ87ejw5MoUqOUfaw+Blmibys5y+jdejp4fHKAd2TKCD4MHbv8/P3j0yfd7I2Vf1MKBoptHR4/AwOFPTOJGjo+NXR0+eEZ9GTw+rKWLvkk7fJZ2+Szp9l3R6uKTTt0tqJWKzZWuAFtw7AD+eB1OpS/CQ1YDg570K3Bca2Qk7HmZquVQ46ovCViiOCdqMRGoMHPAoQfSef+M2+0GlbIJv8K21EGh8JcigLETSrh82Ws8AFklcuDXhT3tuCK02XsZzyBw8XWcbWYZuxkItDVg1/SJnbM6aPyadI3lBPzqc1RLjOlM4dRGyyvh0LXvqXTWRGpG8QieCx0Y6lqSIopgy+sBKhwA5pl7joVNoWYYuNU5EeJMEW8iypDkh1wxaC7I2O+pCxCRyVW6r/DRQ77SrA/bO0Sp0WkezRG0iu5BO8CffIepocUEPxjycOKOvxvs3K3XN4Q6QET/NEFE00Q0mDJKTsKnMXWqlMesOYZopTE17MC/0AX05+L7XKizX8KQumC9vlJon0oyYJHg/eAlm4vwYqCRyFw3TBPLDgjDNpQ5peBu3ytrBwa9K7IO4djTc3nJrZ0w9JlgFV8ssa8JGj3smzjJsR0YdQqdDX1yk5uMkXm8nPZRre6++WGmm9RVcbZb3xZPpeLheOEpNG/RBhOxGmVUIY/7bs7jMN2TlXVcfVVA/LO0cjoKJ2R+Q9TzJwUqxmi1UxvgOCmXQsO0WZPl3D7eL2412DDcAxc8mh1X+Ll5xNKBairncHRt6udvBjlgrPfshvT66RExlkgfB/eD8/fg9qmRfwWG3FCkMnFz+5YD1mBsdJkfH1nsKXgWGhJBnLvY7O29Rfco/a09hLzizlZyw6M5vDkNnguJ37/SkHQM5NdmeRP2R4k2MnOXhdpmE1M7UiUDAAfahlVod2J4VJ6shvX2mN4um5AllEFOlEilWPdl7aTmib9+s2Ot4VR5ON3FSR1mXaLFx3zt6Nj46/ONeP3Lefww0BtcfW0j962aKQ7B5vkKyf+v+5gFsvxcGTtlasUCtldKpyWynTm1mm3bKucruVEXVVXuNBeRwIFVUlNmLahNHg2H6oKLg0+m4PoXw/zwVMzkYKguxjgwvRgbl4IpdRXVkRkV1q8J+iEjnLkVax6QjMfVWMRg6B6QfZyZ1csJclo4xN2eohdvA1kimidrquLFBEVu4DYhh6uAuafAhO4AbUFsdPCjiAmwnWr9Zc3O8Bi6pc9KcVpdTxnO/Iud06IUWLw5sPq1rYe+mcuX3voYVYQhrVRJ8xhWN+ItK1NdYHOA5UBTnM/XNNb//MV+DMX3ZBm674rTd53zuAeXueURHATJs4CK1C42Toewa9E0JD134j71+prgcjudMAPnGmnHG0e7oXgmEhQGyDr8Q9gaVXshSGJCMOUMzmBAF0QaBQzjiZOtNWnLfaVMP7mD9XK3wfwEz4mzEUiI4VmXBVAKElpsuii4jEw2if5itEScIoHGkScvlN52dBlGyuQngQSJaJ209wkXQdKHvKEok4ZZap+bXXikfCymHWpqpaDNb787Ic3obatYugcHVejG2NrTXni4ltPt54c5+4GB+2IHaKa+3I2YqnEestsN35kJe5DCJV346OKh/Z+yIn1uoKxNFbtDRbNWUtDF95hb+9xwEGrB+LiKZeXwIa+UpTocmsVkvEH5ANewpwpXVWqLmVou9U3OUrTEiNFf7ba76hJsn8arsiS8NM1HzEM1CJ8bUx1rc+seZjKzJ3sFwAK2mzQIpmgsoBELZbjRVPC1N7hXhK18DeOyPNslaipPQxeibyEaJmo+ofFyi5hdhfZwUMV3OnnDjwVIGCIJaG7Ka040IjzsY2Vd/HiLV5WUu102BtNcTg4FJcYGI3pWRkYVWyXkgqtco+TqTYjkUhzBzDcTgCqkYwAVoc6sDECHyiBfkfr6O1Ga9jxtk/Ftm2X6ZvHiVbtauF9OSo10unVzRAPRirsrLykpf7yJ/R2mi6iRda56UunqHzetT4OCgtAuguAiUJoLD9w3ynMoxkD58HScS1xOkIGi6l0atSQtzhN+sZnKwGcIAHStCK0k3UJNJyLc5FhIVPdkORgMDxIVoJqwDFOxKs1jhmbqfFP46GCkMkM0UM+Q2bmhjA25xfbQcatGALYvNUpjlAjM4YETtQrl1MhiRnwyfdXITebCRQuL49/XJ8ZPHTwhbUQXETwtsvTgakhWMnq3I07F+MrPCqxUKDxdYSMjrEaRx5Kdrmc9vhyyq/hecjjmsccthOW6GLS4S6CXOPLOChTKhW8sGJ0iL6/naA7DIdbooVC6N5HdzwPo4PjgdV2gmOYRUHG5InrqhBgS+ghyivEzEPO8C5jdzdFfG4NMveEQQmoIzuQzJ7JskcjWvmGqea/FS16mKtqGbZ8h7R8Fobchez1N+pV6wv0u1U3N0e12CJfKwM1ZcRz55t0i22GsJlHk0V+z3zdsfo16qaJPIDhEYAKWmrWyHfp0gGiVfi2XaC/gsk04Kxyr0/wYAEeoYrQ=="
 }
@@ -21,13 +21,18 @@ package include
 
 import (
 	// Import packages that need to register themselves.
+	_ "github.com/elastic/beats/filebeat/input/container"
 	_ "github.com/elastic/beats/filebeat/input/docker"
+	_ "github.com/elastic/beats/filebeat/input/http_endpoint"
+	_ "github.com/elastic/beats/filebeat/input/journald"
+	_ "github.com/elastic/beats/filebeat/input/kafka"
 	_ "github.com/elastic/beats/filebeat/input/log"
 	_ "github.com/elastic/beats/filebeat/input/redis"
 	_ "github.com/elastic/beats/filebeat/input/stdin"
 	_ "github.com/elastic/beats/filebeat/input/syslog"
 	_ "github.com/elastic/beats/filebeat/input/tcp"
 	_ "github.com/elastic/beats/filebeat/input/udp"
+	_ "github.com/elastic/beats/filebeat/input/unix"
 	_ "github.com/elastic/beats/filebeat/module/apache"
 	_ "github.com/elastic/beats/filebeat/module/auditd"
 	_ "github.com/elastic/beats/filebeat/module/elasticsearch"
@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package container
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/input/log"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/reader/readjson"
+)
+
+func init() {
+	err := input.Register("container", NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// NewInput creates a new container input. It wraps the log input, parsing
+// the Docker json-file and CRI formats and joining partial lines.
+func NewInput(
+	cfg *common.Config,
+	outletFactory channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "reading container input config")
+	}
+
+	if err := checkStream(config.Stream); err != nil {
+		return nil, err
+	}
+
+	var format readjson.ContainerFormat
+	if err := format.Unpack(config.Format); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Merge(common.MapStr{
+		"docker-json": common.MapStr{
+			"stream":    config.Stream,
+			"partial":   true,
+			"format":    config.Format,
+			"cri_flags": true,
+		},
+		// Kubernetes log files in /var/log/containers are symlinks.
+		"symlinks":                 true,
+		"kubernetes_path_metadata": true,
+	}); err != nil {
+		return nil, errors.Wrap(err, "update input config")
+	}
+
+	// Add stream to meta to ensure different state per stream
+	if config.Stream != "all" {
+		if context.Meta == nil {
+			context.Meta = map[string]string{}
+		}
+		context.Meta["stream"] = config.Stream
+	}
+
+	return log.NewInput(cfg, outletFactory, context)
+}
+
+func checkStream(val string) error {
+	for _, s := range []string{"all", "stdout", "stderr"} {
+		if s == val {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid value for stream: %s, supported values are: all, stdout, stderr", val)
+}
@@ -0,0 +1,41 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"invalid stream": {"paths": []string{"/var/log/containers/*.log"}, "stream": "stdin"},
+		"invalid format": {"paths": []string{"/var/log/containers/*.log"}, "format": "json-file"},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewInput(common.MustNewConfigFrom(settings), nil, input.Context{})
+			assert.Error(t, err)
+		})
+	}
+}
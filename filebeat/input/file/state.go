@@ -39,6 +39,11 @@ type State struct {
 	TTL         time.Duration     `json:"ttl"`
 	Type        string            `json:"type"`
 	Meta        map[string]string `json:"meta"`
+	Cursor      string            `json:"cursor,omitempty"`      // read position of non file based inputs, e.g. journald
+	Fingerprint string            `json:"fingerprint,omitempty"` // hash of the first bytes of the content, to recognize a file after compression
+	Compressed  bool              `json:"compressed,omitempty"`  // the file is compressed, the offset is in the uncompressed content
+	EOF         bool              `json:"eof,omitempty"`         // the compressed file was read completely
+	InputID     string            `json:"input_id,omitempty"`    // id of the input owning the state
 	FileStateOS file.StateOS
 }
 
@@ -81,6 +81,58 @@ func (s *States) FindPrevious(newState State) State {
 	return s.states[i]
 }
 
+// FindByFingerprint lookups the state of another file with the same content
+// fingerprint, e.g. the state of a log file that has been compressed by
+// the log rotation. If multiple states match, the state with the highest
+// offset is returned. Returns a zero-state if no match is found.
+func (s *States) FindByFingerprint(state State) State {
+	s.RLock()
+	defer s.RUnlock()
+
+	var found State
+	if state.Fingerprint == "" {
+		return found
+	}
+
+	id := state.ID()
+	for _, other := range s.states {
+		if other.Fingerprint == state.Fingerprint && other.ID() != id && other.Offset >= found.Offset {
+			found = other
+		}
+	}
+	return found
+}
+
+// Lookup returns the state with the given ID.
+func (s *States) Lookup(id string) (State, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	i := s.findPrevious(id)
+	if i < 0 {
+		return State{}, false
+	}
+	return s.states[i], true
+}
+
+// Remove removes the state with the given ID.
+func (s *States) Remove(id string) {
+	s.Lock()
+	defer s.Unlock()
+
+	i := s.findPrevious(id)
+	if i < 0 {
+		return
+	}
+
+	delete(s.idx, id)
+	last := len(s.states) - 1
+	if i != last {
+		s.states[i] = s.states[last]
+		s.idx[s.states[i].ID()] = i
+	}
+	s.states = s.states[:last]
+}
+
 // findPrevious returns the previous state for the file.
 // In case no previous state exists, index -1 is returned
 func (s *States) findPrevious(id string) int {
@@ -94,6 +146,12 @@ func (s *States) findPrevious(id string) int {
 // The number of states that were cleaned up and number of states that can be
 // cleaned up in the future is returned.
 func (s *States) Cleanup() (int, int) {
+	return s.CleanupWith(nil)
+}
+
+// CleanupWith cleans up the state array like Cleanup, calling fn with the ID
+// of each removed state.
+func (s *States) CleanupWith(fn func(id string)) (int, int) {
 	s.Lock()
 	defer s.Unlock()
 
@@ -115,6 +173,9 @@ func (s *States) Cleanup() (int, int) {
 			}
 
 			delete(s.idx, state.ID())
+			if fn != nil {
+				fn(state.ID())
+			}
 			logp.Debug("state", "State removed for %v because of older: %v", state.Source, state.TTL)
 
 			L--
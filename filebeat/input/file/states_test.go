@@ -86,3 +86,23 @@ func TestCleanup(t *testing.T) {
 		})
 	}
 }
+
+func TestFindByFingerprint(t *testing.T) {
+	states := NewStates()
+	states.SetStates([]State{
+		{Id: "1", Source: "app.log.1", Fingerprint: "abc", Offset: 100},
+		{Id: "2", Source: "app.log.2", Fingerprint: "abc", Offset: 200},
+		{Id: "3", Source: "other.log", Fingerprint: "def", Offset: 300},
+	})
+
+	found := states.FindByFingerprint(State{Id: "4", Fingerprint: "abc"})
+	assert.Equal(t, "app.log.2", found.Source)
+	assert.Equal(t, int64(200), found.Offset)
+
+	// the state of the file itself is ignored
+	found = states.FindByFingerprint(State{Id: "3", Fingerprint: "def"})
+	assert.True(t, found.IsEmpty())
+
+	found = states.FindByFingerprint(State{Id: "4"})
+	assert.True(t, found.IsEmpty())
+}
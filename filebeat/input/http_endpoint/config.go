@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/libbeat/common/cfgtype"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type config struct {
+	harvester.ForwarderConfig `config:",inline"`
+
+	Host           string                  `config:"host"`
+	Path           string                  `config:"url"`
+	Prefix         string                  `config:"prefix"`
+	ContentType    string                  `config:"content_type"`
+	MaxMessageSize cfgtype.ByteSize        `config:"max_message_size" validate:"nonzero,positive"`
+	ResponseCode   int                     `config:"response_code" validate:"positive"`
+	ResponseBody   string                  `config:"response_body"`
+	TLS            *tlscommon.ServerConfig `config:"ssl"`
+
+	BasicAuth bool   `config:"basic_auth"`
+	Username  string `config:"username"`
+	Password  string `config:"password"`
+
+	Secret secretConfig `config:"secret"`
+	HMAC   hmacConfig   `config:"hmac"`
+}
+
+// secretConfig configures a header that must contain a shared secret.
+type secretConfig struct {
+	Header string `config:"header"`
+	Value  string `config:"value"`
+}
+
+// hmacConfig configures the validation of a signature of the request body.
+type hmacConfig struct {
+	Header string     `config:"header"`
+	Key    string     `config:"key"`
+	Type   hmacType   `config:"type"`
+	Prefix string     `config:"prefix"`
+	Format hmacFormat `config:"encoding"`
+}
+
+type hmacType struct {
+	name string
+	new  func() hash.Hash
+}
+
+type hmacFormat string
+
+const (
+	hmacFormatHex    hmacFormat = "hex"
+	hmacFormatBase64 hmacFormat = "base64"
+)
+
+var hmacTypes = map[string]hmacType{
+	"sha1":   {"sha1", sha1.New},
+	"sha256": {"sha256", sha256.New},
+}
+
+var defaultConfig = config{
+	ForwarderConfig: harvester.ForwarderConfig{
+		Type: "http_endpoint",
+	},
+	Path:           "/",
+	Prefix:         "json",
+	MaxMessageSize: 10 * humanize.MiByte,
+	ResponseCode:   http.StatusOK,
+	ResponseBody:   `{"message": "success"}`,
+	HMAC: hmacConfig{
+		Type:   hmacTypes["sha256"],
+		Format: hmacFormatHex,
+	},
+}
+
+func (t *hmacType) Unpack(s string) error {
+	ht, found := hmacTypes[strings.ToLower(s)]
+	if !found {
+		return errors.Errorf("unsupported hmac type '%v'", s)
+	}
+	*t = ht
+	return nil
+}
+
+func (f *hmacFormat) Unpack(s string) error {
+	switch hmacFormat(strings.ToLower(s)) {
+	case hmacFormatHex:
+		*f = hmacFormatHex
+	case hmacFormatBase64:
+		*f = hmacFormatBase64
+	default:
+		return errors.Errorf("unsupported hmac encoding '%v'", s)
+	}
+	return nil
+}
+
+// Validate validates the http_endpoint input configuration.
+func (c *config) Validate() error {
+	if len(c.Host) == 0 {
+		return fmt.Errorf("need to specify the host using the `host:port` syntax")
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		return fmt.Errorf("url must start with a '/', got '%v'", c.Path)
+	}
+	if c.BasicAuth && (c.Username == "" || c.Password == "") {
+		return errors.New("username and password are required when basic_auth is enabled")
+	}
+	if (c.Secret.Header == "") != (c.Secret.Value == "") {
+		return errors.New("both secret.header and secret.value must be set")
+	}
+	if (c.HMAC.Header == "") != (c.HMAC.Key == "") {
+		return errors.New("both hmac.header and hmac.key must be set")
+	}
+	return nil
+}
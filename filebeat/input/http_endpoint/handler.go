@@ -0,0 +1,237 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/jsontransform"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+var (
+	errNotObject = errors.New("JSON payload must contain objects")
+	errEmpty     = errors.New("no events in request body")
+)
+
+// publishFunc sends an event to the pipeline. It returns once the event has
+// been accepted by the publisher pipeline.
+type publishFunc func(remoteAddr string, fields common.MapStr) error
+
+// handler accepts JSON objects, JSON arrays of objects or newline delimited
+// JSON sent via POST requests and publishes one event per object.
+type handler struct {
+	config  *config
+	publish publishFunc
+	log     *logp.Logger
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.sendError(w, http.StatusMethodNotAllowed, "only POST requests are allowed")
+		return
+	}
+
+	if status, err := h.authenticate(r); err != nil {
+		h.log.Debugw("Request rejected.", "remote_address", r.RemoteAddr, "error", err)
+		if status == http.StatusUnauthorized && h.config.BasicAuth {
+			w.Header().Set("WWW-Authenticate", `Basic realm="filebeat"`)
+		}
+		h.sendError(w, status, err.Error())
+		return
+	}
+
+	if h.config.ContentType != "" {
+		contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || !strings.EqualFold(contentType, h.config.ContentType) {
+			h.sendError(w, http.StatusUnsupportedMediaType, "wrong Content-Type header, expecting "+h.config.ContentType)
+			return
+		}
+	}
+
+	limit := int64(h.config.MaxMessageSize)
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, limit+1))
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if int64(len(body)) > limit {
+		h.sendError(w, http.StatusRequestEntityTooLarge, "request body too large")
+		return
+	}
+
+	if err := h.validateHMAC(r, body); err != nil {
+		h.log.Debugw("Request rejected.", "remote_address", r.RemoteAddr, "error", err)
+		h.sendError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	objs, err := decodeJSON(body)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// The response is only sent once all events have been accepted by the
+	// pipeline, such that clients can retry requests that failed.
+	for _, obj := range objs {
+		if err := h.publish(r.RemoteAddr, h.eventFields(obj)); err != nil {
+			h.sendError(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.config.ResponseCode)
+	io.WriteString(w, h.config.ResponseBody)
+}
+
+// authenticate checks the basic auth credentials and the shared secret
+// header. It returns the HTTP status code to use if the check fails.
+func (h *handler) authenticate(r *http.Request) (int, error) {
+	if h.config.BasicAuth {
+		username, password, ok := r.BasicAuth()
+		if !ok {
+			return http.StatusUnauthorized, errors.New("missing basic auth credentials")
+		}
+		if !secureEqual(username, h.config.Username) || !secureEqual(password, h.config.Password) {
+			return http.StatusUnauthorized, errors.New("invalid username or password")
+		}
+	}
+
+	if h.config.Secret.Header != "" {
+		value := r.Header.Get(h.config.Secret.Header)
+		if value == "" {
+			return http.StatusUnauthorized, errors.Errorf("missing %v header", h.config.Secret.Header)
+		}
+		if !secureEqual(value, h.config.Secret.Value) {
+			return http.StatusUnauthorized, errors.Errorf("invalid %v header", h.config.Secret.Header)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// validateHMAC checks the signature of the request body, if configured.
+func (h *handler) validateHMAC(r *http.Request, body []byte) error {
+	cfg := h.config.HMAC
+	if cfg.Header == "" {
+		return nil
+	}
+
+	signature := r.Header.Get(cfg.Header)
+	if signature == "" {
+		return errors.Errorf("missing %v header", cfg.Header)
+	}
+	if cfg.Prefix != "" {
+		if !strings.HasPrefix(signature, cfg.Prefix) {
+			return errors.Errorf("invalid %v header", cfg.Header)
+		}
+		signature = strings.TrimPrefix(signature, cfg.Prefix)
+	}
+
+	var expected []byte
+	var err error
+	switch cfg.Format {
+	case hmacFormatBase64:
+		expected, err = base64.StdEncoding.DecodeString(signature)
+	default:
+		expected, err = hex.DecodeString(signature)
+	}
+	if err != nil {
+		return errors.Errorf("invalid %v header", cfg.Header)
+	}
+
+	mac := hmac.New(cfg.Type.new, []byte(cfg.Key))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return errors.New("invalid HMAC signature")
+	}
+	return nil
+}
+
+func (h *handler) eventFields(obj common.MapStr) common.MapStr {
+	if h.config.Prefix == "" {
+		return obj
+	}
+	return common.MapStr{h.config.Prefix: obj}
+}
+
+func (h *handler) sendError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(common.MapStr{"message": message})
+}
+
+// decodeJSON decodes a request body containing a JSON object, a JSON array of
+// objects or newline delimited JSON objects.
+func decodeJSON(body []byte) ([]common.MapStr, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var objs []common.MapStr
+	for {
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrap(err, "malformed JSON body")
+		}
+
+		switch val := v.(type) {
+		case map[string]interface{}:
+			objs = append(objs, common.MapStr(val))
+		case []interface{}:
+			for _, elem := range val {
+				obj, ok := elem.(map[string]interface{})
+				if !ok {
+					return nil, errNotObject
+				}
+				objs = append(objs, common.MapStr(obj))
+			}
+		default:
+			return nil, errNotObject
+		}
+	}
+
+	if len(objs) == 0 {
+		return nil, errEmpty
+	}
+	for _, obj := range objs {
+		jsontransform.TransformNumbers(obj)
+	}
+	return objs, nil
+}
+
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,226 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+type testPublisher struct {
+	events []common.MapStr
+	err    error
+}
+
+func (p *testPublisher) publish(remoteAddr string, fields common.MapStr) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, fields)
+	return nil
+}
+
+func newTestHandler(t *testing.T, settings map[string]interface{}) (*handler, *testPublisher) {
+	c := defaultConfig
+	settings["host"] = "localhost:0"
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&c))
+
+	pub := &testPublisher{}
+	return &handler{config: &c, publish: pub.publish, log: logp.NewLogger("test")}, pub
+}
+
+func doRequest(h http.Handler, method, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestPayloadFormats(t *testing.T) {
+	cases := map[string]struct {
+		body     string
+		expected []common.MapStr
+	}{
+		"object": {
+			body:     `{"a": 1, "b": {"c": "d"}}`,
+			expected: []common.MapStr{{"json": common.MapStr{"a": int64(1), "b": map[string]interface{}{"c": "d"}}}},
+		},
+		"array": {
+			body: `[{"a": 1}, {"a": 2.5}]`,
+			expected: []common.MapStr{
+				{"json": common.MapStr{"a": int64(1)}},
+				{"json": common.MapStr{"a": 2.5}},
+			},
+		},
+		"ndjson": {
+			body: "{\"a\": \"x\"}\n{\"a\": \"y\"}\n",
+			expected: []common.MapStr{
+				{"json": common.MapStr{"a": "x"}},
+				{"json": common.MapStr{"a": "y"}},
+			},
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			h, pub := newTestHandler(t, map[string]interface{}{})
+			w := doRequest(h, "POST", test.body, nil)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, `{"message": "success"}`, w.Body.String())
+			assert.Equal(t, test.expected, pub.events)
+		})
+	}
+}
+
+func TestInvalidPayloads(t *testing.T) {
+	cases := map[string]string{
+		"empty":             "",
+		"not an object":     `"hello"`,
+		"array of scalars":  `[1, 2]`,
+		"malformed":         `{"a": `,
+		"partially invalid": "{\"a\": 1}\n[",
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			h, pub := newTestHandler(t, map[string]interface{}{})
+			w := doRequest(h, "POST", body, nil)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Empty(t, pub.events)
+		})
+	}
+}
+
+func TestRequestChecks(t *testing.T) {
+	h, _ := newTestHandler(t, map[string]interface{}{
+		"content_type":     "application/json",
+		"max_message_size": 16,
+	})
+
+	w := doRequest(h, "GET", "", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	w = doRequest(h, "POST", `{"a": 1}`, map[string]string{"Content-Type": "text/plain"})
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+	w = doRequest(h, "POST", `{"a": "0123456789"}`, map[string]string{"Content-Type": "application/json"})
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	w = doRequest(h, "POST", `{"a": 1}`, map[string]string{"Content-Type": "application/json; charset=utf-8"})
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestBasicAuth(t *testing.T) {
+	h, pub := newTestHandler(t, map[string]interface{}{
+		"basic_auth": true,
+		"username":   "user",
+		"password":   "secret",
+	})
+
+	w := doRequest(h, "POST", `{}`, nil)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{}`))
+	req.SetBasicAuth("user", "wrong")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req = httptest.NewRequest("POST", "/", strings.NewReader(`{}`))
+	req.SetBasicAuth("user", "secret")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, pub.events, 1)
+}
+
+func TestSecretHeader(t *testing.T) {
+	h, _ := newTestHandler(t, map[string]interface{}{
+		"secret.header": "X-Token",
+		"secret.value":  "abc",
+	})
+
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "POST", `{}`, nil).Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "POST", `{}`, map[string]string{"X-Token": "abd"}).Code)
+	assert.Equal(t, http.StatusOK, doRequest(h, "POST", `{}`, map[string]string{"X-Token": "abc"}).Code)
+}
+
+func TestHMAC(t *testing.T) {
+	h, pub := newTestHandler(t, map[string]interface{}{
+		"hmac.header": "X-Hub-Signature-256",
+		"hmac.key":    "password",
+		"hmac.prefix": "sha256=",
+	})
+
+	body := `{"action": "opened"}`
+	mac := hmac.New(sha256.New, []byte("password"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "POST", body, nil).Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "POST", body+" ", map[string]string{"X-Hub-Signature-256": signature}).Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "POST", body, map[string]string{"X-Hub-Signature-256": "sha1=00"}).Code)
+	assert.Empty(t, pub.events)
+
+	assert.Equal(t, http.StatusOK, doRequest(h, "POST", body, map[string]string{"X-Hub-Signature-256": signature}).Code)
+	assert.Len(t, pub.events, 1)
+}
+
+func TestPublishFailure(t *testing.T) {
+	h, pub := newTestHandler(t, map[string]interface{}{"prefix": ""})
+	pub.err = errors.New("input outlet closed")
+
+	w := doRequest(h, "POST", `{"a": 1}`, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "input outlet closed")
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no host":          {},
+		"relative url":     {"host": "localhost:0", "url": "webhook"},
+		"no password":      {"host": "localhost:0", "basic_auth": true, "username": "user"},
+		"no secret value":  {"host": "localhost:0", "secret.header": "X-Token"},
+		"no hmac key":      {"host": "localhost:0", "hmac.header": "X-Signature"},
+		"invalid hmac":     {"host": "localhost:0", "hmac.type": "md5"},
+		"invalid encoding": {"host": "localhost:0", "hmac.encoding": "base32"},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := defaultConfig
+			assert.Error(t, common.MustNewConfigFrom(settings).Unpack(&c))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http_endpoint
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const inputName = "http_endpoint"
+
+// shutdownTimeout is the maximum time to wait for in-flight requests on stop.
+const shutdownTimeout = 10 * time.Second
+
+func init() {
+	err := input.Register(inputName, NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input for HTTP endpoint
+type Input struct {
+	sync.Mutex
+	server    *http.Server
+	tlsConfig *tls.Config
+	started   bool
+	outlet    channel.Outleter
+	config    *config
+	log       *logp.Logger
+}
+
+// NewInput creates a new HTTP endpoint input
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+
+	out, err := outlet(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+
+	forwarder := harvester.NewForwarder(out)
+
+	config := defaultConfig
+	err = cfg.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSServerConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	log := logp.NewLogger("http_endpoint input").With("address", config.Host)
+	h := &handler{
+		config: &config,
+		log:    log,
+		publish: func(remoteAddr string, fields common.MapStr) error {
+			return forwarder.Send(createEvent(remoteAddr, fields))
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(config.Path, h)
+
+	p := &Input{
+		server:  &http.Server{Addr: config.Host, Handler: mux},
+		started: false,
+		outlet:  out,
+		config:  &config,
+		log:     log,
+	}
+	if tlsConfig != nil {
+		p.tlsConfig = tlsConfig.BuildModuleConfig(config.Host)
+	}
+	return p, nil
+}
+
+// Run starts the HTTP server
+func (p *Input) Run() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.started {
+		p.log.Info("Starting HTTP endpoint input")
+		if err := p.start(); err != nil {
+			p.log.Errorw("Error starting the HTTP server", "error", err)
+			return
+		}
+		p.started = true
+	}
+}
+
+func (p *Input) start() error {
+	l, err := net.Listen("tcp", p.config.Host)
+	if err != nil {
+		return err
+	}
+	if p.tlsConfig != nil {
+		p.log.Info("Listening over TLS")
+		l = tls.NewListener(l, p.tlsConfig)
+	}
+
+	go func() {
+		err := p.server.Serve(l)
+		if err != nil && err != http.ErrServerClosed {
+			p.log.Errorw("HTTP server failed", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Stop stops the HTTP server, waiting for in-flight requests to finish
+func (p *Input) Stop() {
+	defer p.outlet.Close()
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.started {
+		return
+	}
+
+	p.log.Info("Stopping HTTP endpoint input")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := p.server.Shutdown(ctx); err != nil {
+		p.log.Debugw("Failed to shutdown HTTP server gracefully", "error", err)
+		p.server.Close()
+	}
+	p.started = false
+}
+
+// Wait stop the current server
+func (p *Input) Wait() {
+	p.Stop()
+}
+
+func createEvent(remoteAddr string, fields common.MapStr) *util.Data {
+	fields.Put("log.source.address", remoteAddr)
+
+	data := util.NewData()
+	data.Event = beat.Event{
+		Timestamp: time.Now(),
+		Fields:    fields,
+	}
+	return data
+}
@@ -0,0 +1,223 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux,cgo
+
+package journald
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/journalbeat/checkpoint"
+	"github.com/elastic/beats/journalbeat/reader"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const inputName = "journald"
+
+func init() {
+	err := input.Register(inputName, NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input reads entries from one or more journals. The cursor of the last
+// published entry of each journal is stored in the registry.
+type Input struct {
+	config    journaldConfig
+	readers   []*reader.Reader
+	forwarder *harvester.Forwarder
+	outlet    channel.Outleter
+	done      chan struct{}
+	log       *logp.Logger
+
+	runOnce  sync.Once
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewInput creates a new journald input
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	out, err := outlet(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Input{
+		config:    config,
+		forwarder: harvester.NewForwarder(out),
+		outlet:    out,
+		done:      make(chan struct{}),
+		log:       logp.NewLogger("journald input").With("paths", config.Paths),
+	}
+
+	if err := p.openReaders(context.States); err != nil {
+		p.closeReaders()
+		out.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Input) openReaders(states []file.State) error {
+	if len(p.config.Paths) == 0 {
+		r, err := reader.NewLocal(p.readerConfig(reader.LocalSystemJournalID), p.done,
+			journalState(states, reader.LocalSystemJournalID), p.log)
+		if err != nil {
+			return fmt.Errorf("error creating reader for local journal: %v", err)
+		}
+		p.readers = append(p.readers, r)
+	}
+
+	for _, path := range p.config.Paths {
+		r, err := reader.New(p.readerConfig(path), p.done, journalState(states, path), p.log)
+		if err != nil {
+			return fmt.Errorf("error creating reader for journal %v: %v", path, err)
+		}
+		p.readers = append(p.readers, r)
+	}
+	return nil
+}
+
+func (p *Input) readerConfig(path string) reader.Config {
+	return reader.Config{
+		Path:               path, // used to identify the state in the registry
+		Backoff:            p.config.Backoff,
+		MaxBackoff:         p.config.MaxBackoff,
+		Seek:               p.config.Seek,
+		CursorSeekFallback: p.config.CursorSeekFallback,
+		Matches:            p.config.Matches,
+	}
+}
+
+// Run starts reading from all journals
+func (p *Input) Run() {
+	p.runOnce.Do(func() {
+		p.log.Info("Starting journald input")
+		for _, r := range p.readers {
+			r := r
+			p.wg.Add(1)
+			go func() {
+				defer p.wg.Done()
+				p.readJournal(r)
+			}()
+		}
+	})
+}
+
+// readJournal publishes all entries of a journal until the input is stopped.
+func (p *Input) readJournal(r *reader.Reader) {
+	for {
+		event, err := r.Next()
+		select {
+		case <-p.done:
+			return
+		default:
+		}
+
+		if event == nil {
+			if err != nil {
+				p.log.Errorw("Error while reading event", "error", err)
+				p.wait(p.config.Backoff)
+			}
+			continue
+		}
+
+		data := util.NewData()
+		if state, ok := event.Private.(checkpoint.JournalState); ok {
+			data.SetState(newState(state.Path, state.Cursor))
+		}
+		event.Private = nil
+		data.Event = *event
+
+		if err := p.forwarder.Send(data); err != nil {
+			return
+		}
+	}
+}
+
+func (p *Input) wait(d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-p.done:
+	case <-timer.C:
+	}
+}
+
+// Stop stops all readers of the input
+func (p *Input) Stop() {
+	p.stopOnce.Do(func() {
+		p.log.Info("Stopping journald input")
+		close(p.done)
+		p.outlet.Close()
+		p.wg.Wait()
+		p.closeReaders()
+	})
+}
+
+// Wait stops the input
+func (p *Input) Wait() {
+	p.Stop()
+}
+
+func (p *Input) closeReaders() {
+	for _, r := range p.readers {
+		r.Close()
+	}
+}
+
+// newState returns the registry state storing the cursor of a journal.
+func newState(path, cursor string) file.State {
+	return file.State{
+		Type:      inputName,
+		Source:    path,
+		Cursor:    cursor,
+		Meta:      map[string]string{"journal": path},
+		Timestamp: time.Now(),
+		TTL:       -1,
+	}
+}
+
+// journalState returns the stored state of the journal, as expected by the
+// journal reader.
+func journalState(states []file.State, path string) checkpoint.JournalState {
+	for _, state := range states {
+		if state.Type == inputName && state.Meta["journal"] == path {
+			return checkpoint.JournalState{Path: path, Cursor: state.Cursor}
+		}
+	}
+	return checkpoint.JournalState{Path: path}
+}
@@ -0,0 +1,46 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !linux !cgo
+
+package journald
+
+import (
+	"errors"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	err := input.Register("journald", NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// NewInput stub for builds without journald support.
+//
+// Note: NewInput fails if filebeat is not compiled for linux with cgo enabled
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	return nil, errors.New("journald input is only supported on linux with cgo enabled")
+}
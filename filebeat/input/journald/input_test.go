@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux,cgo
+
+package journald
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/journalbeat/checkpoint"
+	"github.com/elastic/beats/journalbeat/config"
+	"github.com/elastic/beats/journalbeat/reader"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestConfig(t *testing.T) {
+	c := defaultConfig
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"paths":           []string{"/var/log/journal"},
+		"seek":            "tail",
+		"include_matches": []string{"systemd.unit=nginx.service"},
+	}).Unpack(&c)
+	require.NoError(t, err)
+	assert.Equal(t, config.SeekTail, c.Seek)
+	assert.Equal(t, config.SeekHead, c.CursorSeekFallback)
+
+	c = defaultConfig
+	err = common.MustNewConfigFrom(map[string]interface{}{"seek": "middle"}).Unpack(&c)
+	assert.Error(t, err)
+}
+
+func TestJournalState(t *testing.T) {
+	states := []file.State{
+		{Source: "/var/log/app.log", Offset: 42, Type: "log"},
+		newState("/var/log/journal", "s=abc;i=1"),
+		newState(reader.LocalSystemJournalID, "s=def;i=2"),
+	}
+
+	assert.Equal(t,
+		checkpoint.JournalState{Path: reader.LocalSystemJournalID, Cursor: "s=def;i=2"},
+		journalState(states, reader.LocalSystemJournalID))
+	assert.Equal(t,
+		checkpoint.JournalState{Path: "/var/log/journal", Cursor: "s=abc;i=1"},
+		journalState(states, "/var/log/journal"))
+	assert.Equal(t,
+		checkpoint.JournalState{Path: "/run/log/journal"},
+		journalState(states, "/run/log/journal"))
+}
+
+func TestStateRoundtrip(t *testing.T) {
+	state := newState("/var/log/journal", "s=abc;i=1")
+	other := newState(reader.LocalSystemJournalID, "s=abc;i=1")
+	assert.NotEqual(t, state.ID(), other.ID())
+
+	// the cursor must survive writing and reading the registry
+	raw, err := json.Marshal([]file.State{state})
+	require.NoError(t, err)
+
+	var states []file.State
+	require.NoError(t, json.Unmarshal(raw, &states))
+	assert.Equal(t, state.ID(), states[0].ID())
+	assert.Equal(t, "s=abc;i=1", journalState(states, "/var/log/journal").Cursor)
+}
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/libbeat/common/kafka"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+type kafkaInputConfig struct {
+	harvester.ForwarderConfig `config:",inline"`
+
+	// Kafka hosts with port, e.g. "localhost:9092"
+	Hosts                  []string          `config:"hosts" validate:"required"`
+	Topics                 []string          `config:"topics"`
+	TopicPatterns          []string          `config:"topic_patterns"`
+	TopicsRefreshFrequency time.Duration     `config:"topics_refresh_frequency" validate:"min=0"`
+	GroupID                string            `config:"group_id" validate:"required"`
+	ClientID               string            `config:"client_id"`
+	Version                kafka.Version     `config:"version"`
+	InitialOffset          initialOffset     `config:"initial_offset"`
+	ConnectBackoff         time.Duration     `config:"connect_backoff" validate:"min=0"`
+	ConsumeBackoff         time.Duration     `config:"consume_backoff" validate:"min=0"`
+	MaxWaitTime            time.Duration     `config:"max_wait_time"`
+	Fetch                  kafkaFetch        `config:"fetch"`
+	Rebalance              kafkaRebalance    `config:"rebalance"`
+	HeadersToMetadata      map[string]string `config:"headers_to_metadata"`
+	TLS                    *tlscommon.Config `config:"ssl"`
+	Username               string            `config:"username"`
+	Password               string            `config:"password"`
+}
+
+type kafkaFetch struct {
+	Min     int32 `config:"min" validate:"min=1"`
+	Default int32 `config:"default" validate:"min=1"`
+	Max     int32 `config:"max" validate:"min=0"`
+}
+
+type kafkaRebalance struct {
+	Strategy     rebalanceStrategy `config:"strategy"`
+	Timeout      time.Duration     `config:"timeout"`
+	MaxRetries   int               `config:"max_retries"`
+	RetryBackoff time.Duration     `config:"retry_backoff" validate:"min=0"`
+}
+
+type initialOffset int64
+
+type rebalanceStrategy string
+
+const (
+	rebalanceStrategyRange      rebalanceStrategy = "range"
+	rebalanceStrategyRoundRobin rebalanceStrategy = "roundrobin"
+)
+
+var initialOffsets = map[string]initialOffset{
+	"oldest": initialOffset(sarama.OffsetOldest),
+	"newest": initialOffset(sarama.OffsetNewest),
+}
+
+func defaultConfig() kafkaInputConfig {
+	return kafkaInputConfig{
+		ForwarderConfig: harvester.ForwarderConfig{
+			Type: "kafka",
+		},
+		TopicsRefreshFrequency: time.Minute,
+		ClientID:               "filebeat",
+		Version:                kafka.Version("1.0.0"),
+		InitialOffset:          initialOffsets["oldest"],
+		ConnectBackoff:         30 * time.Second,
+		ConsumeBackoff:         2 * time.Second,
+		MaxWaitTime:            250 * time.Millisecond,
+		Fetch: kafkaFetch{
+			Min:     1,
+			Default: 1 << 20, // 1 MB
+			Max:     0,
+		},
+		Rebalance: kafkaRebalance{
+			Strategy:     rebalanceStrategyRange,
+			Timeout:      60 * time.Second,
+			MaxRetries:   4,
+			RetryBackoff: 2 * time.Second,
+		},
+	}
+}
+
+// Validate validates the kafka input configuration.
+func (c *kafkaInputConfig) Validate() error {
+	if len(c.Topics) == 0 && len(c.TopicPatterns) == 0 {
+		return errors.New("at least one topic or topic pattern must be configured")
+	}
+	for _, pattern := range c.TopicPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid topic pattern '%v': %v", pattern, err)
+		}
+	}
+	if v, _ := c.Version.Get(); !v.IsAtLeast(sarama.V0_10_2_0) {
+		return fmt.Errorf("consumer groups require kafka version 0.10.2 or newer, got %v", c.Version)
+	}
+	if c.Username != "" && c.Password == "" {
+		return fmt.Errorf("password must be set when username is configured")
+	}
+	return nil
+}
+
+func (o *initialOffset) Unpack(s string) error {
+	offset, found := initialOffsets[strings.ToLower(s)]
+	if !found {
+		return fmt.Errorf("invalid initial offset '%v', must be 'oldest' or 'newest'", s)
+	}
+	*o = offset
+	return nil
+}
+
+func (s *rebalanceStrategy) Unpack(str string) error {
+	switch strategy := rebalanceStrategy(strings.ToLower(str)); strategy {
+	case rebalanceStrategyRange, rebalanceStrategyRoundRobin:
+		*s = strategy
+		return nil
+	}
+	return fmt.Errorf("invalid rebalance strategy '%v', must be 'range' or 'roundrobin'", str)
+}
+
+func (s rebalanceStrategy) get() sarama.BalanceStrategy {
+	if s == rebalanceStrategyRoundRobin {
+		return sarama.BalanceStrategyRoundRobin
+	}
+	return sarama.BalanceStrategyRange
+}
+
+func newSaramaConfig(config kafkaInputConfig) (*sarama.Config, error) {
+	k := sarama.NewConfig()
+
+	version, ok := config.Version.Get()
+	if !ok {
+		return nil, fmt.Errorf("Unknown/unsupported kafka version: %v", config.Version)
+	}
+	k.Version = version
+
+	k.Consumer.Return.Errors = true
+	k.Consumer.Offsets.Initial = int64(config.InitialOffset)
+	k.Consumer.Retry.Backoff = config.ConsumeBackoff
+	k.Consumer.MaxWaitTime = config.MaxWaitTime
+
+	k.Consumer.Fetch.Min = config.Fetch.Min
+	k.Consumer.Fetch.Default = config.Fetch.Default
+	k.Consumer.Fetch.Max = config.Fetch.Max
+
+	k.Consumer.Group.Rebalance.Strategy = config.Rebalance.Strategy.get()
+	k.Consumer.Group.Rebalance.Timeout = config.Rebalance.Timeout
+	k.Consumer.Group.Rebalance.Retry.Backoff = config.Rebalance.RetryBackoff
+	k.Consumer.Group.Rebalance.Retry.Max = config.Rebalance.MaxRetries
+
+	tls, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tls != nil {
+		k.Net.TLS.Enable = true
+		k.Net.TLS.Config = tls.BuildModuleConfig("")
+	}
+
+	if config.Username != "" {
+		k.Net.SASL.Enable = true
+		k.Net.SASL.User = config.Username
+		k.Net.SASL.Password = config.Password
+	}
+
+	k.ClientID = config.ClientID
+
+	if err := k.Validate(); err != nil {
+		logp.Err("Invalid kafka configuration: %v", err)
+		return nil, err
+	}
+	return k, nil
+}
@@ -0,0 +1,364 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	err := input.Register("kafka", NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input is the Kafka input. Messages are consumed as a member of a consumer
+// group. The offset of a message is committed only after the event has been
+// ACKed by the outputs.
+type Input struct {
+	config       kafkaInputConfig
+	saramaConfig *sarama.Config
+	patterns     []*regexp.Regexp
+	outlet       channel.Outleter
+	log          *logp.Logger
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	runOnce sync.Once
+	wg      sync.WaitGroup
+}
+
+// eventMeta is stored in the private field of events. It is used to mark the
+// message as consumed once the event has been ACKed.
+type eventMeta struct {
+	session sarama.ConsumerGroupSession
+	message *sarama.ConsumerMessage
+}
+
+// NewInput creates a new Kafka input
+func NewInput(
+	cfg *common.Config,
+	connector channel.Connector,
+	inputContext input.Context,
+) (input.Input, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing Sarama config: %v", err)
+	}
+
+	patterns := make([]*regexp.Regexp, len(config.TopicPatterns))
+	for i, pattern := range config.TopicPatterns {
+		patterns[i] = regexp.MustCompile(pattern)
+	}
+
+	out, err := connector(cfg, inputContext.DynamicFields, channel.WithACKEvents(ackEvents))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Input{
+		config:       config,
+		saramaConfig: saramaConfig,
+		patterns:     patterns,
+		outlet:       out,
+		log:          logp.NewLogger("kafka input").With("hosts", config.Hosts),
+	}
+	p.ctx, p.cancel = doneContext(inputContext.Done)
+	return p, nil
+}
+
+// doneContext returns a context that is cancelled once done is closed.
+func doneContext(done <-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// ackEvents marks the messages of ACKed events as consumed. Marked offsets
+// are committed by the consumer group periodically.
+func ackEvents(privates []interface{}) {
+	for _, private := range privates {
+		if meta, ok := private.(eventMeta); ok {
+			meta.session.MarkMessage(meta.message, "")
+		}
+	}
+}
+
+// Run starts consuming messages
+func (p *Input) Run() {
+	p.runOnce.Do(func() {
+		p.log.Info("Starting Kafka input")
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.run()
+		}()
+	})
+}
+
+// Stop stops the input and waits for the consumer group to be closed
+func (p *Input) Stop() {
+	p.log.Info("Stopping Kafka input")
+	p.cancel()
+
+	// Closing the outlet unblocks claims waiting for events to be published.
+	p.outlet.Close()
+	p.wg.Wait()
+}
+
+// Wait stops the input
+func (p *Input) Wait() {
+	p.Stop()
+}
+
+// run connects to the cluster, reconnecting after connect_backoff on failure,
+// until the input is stopped.
+func (p *Input) run() {
+	for p.ctx.Err() == nil {
+		if err := p.consume(); err != nil {
+			p.log.Errorw("Kafka consumer failed", "error", err)
+			p.wait(p.config.ConnectBackoff)
+		}
+	}
+}
+
+func (p *Input) consume() error {
+	client, err := sarama.NewClient(p.config.Hosts, p.saramaConfig)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	group, err := sarama.NewConsumerGroupFromClient(p.config.GroupID, client)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	go func() {
+		for err := range group.Errors() {
+			p.log.Errorw("Error reading from Kafka", "error", err)
+		}
+	}()
+
+	handler := &groupHandler{input: p}
+	for p.ctx.Err() == nil {
+		topics, err := p.topics(client)
+		if err != nil {
+			return err
+		}
+		if len(topics) == 0 {
+			p.log.Debug("No topic matching the configured topic patterns found")
+			p.wait(p.config.TopicsRefreshFrequency)
+			continue
+		}
+
+		// Consume returns when the session ends because of a rebalance, or
+		// because the topics matching the patterns have changed.
+		ctx, cancel := context.WithCancel(p.ctx)
+		go p.watchTopics(ctx, cancel, client, topics)
+		err = group.Consume(ctx, topics, handler)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// topics returns the configured topics and all topics matching the
+// configured patterns.
+func (p *Input) topics(client sarama.Client) ([]string, error) {
+	if len(p.patterns) == 0 {
+		return p.config.Topics, nil
+	}
+
+	if err := client.RefreshMetadata(); err != nil {
+		return nil, err
+	}
+	available, err := client.Topics()
+	if err != nil {
+		return nil, err
+	}
+	return matchTopics(p.config.Topics, p.patterns, available), nil
+}
+
+// watchTopics cancels the session once the set of topics matching the topic
+// patterns changes, such that a new session for the new topics is started.
+func (p *Input) watchTopics(ctx context.Context, cancel context.CancelFunc, client sarama.Client, current []string) {
+	if len(p.patterns) == 0 || p.config.TopicsRefreshFrequency <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.config.TopicsRefreshFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		topics, err := p.topics(client)
+		if err != nil {
+			p.log.Debugw("Failed to refresh topics", "error", err)
+			continue
+		}
+		if strings.Join(topics, ",") != strings.Join(current, ",") {
+			p.log.Infow("Topics changed, restarting consumer group session", "topics", topics)
+			cancel()
+			return
+		}
+	}
+}
+
+// wait waits for the given duration or until the input is stopped.
+func (p *Input) wait(d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-p.ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// matchTopics returns the sorted list of topics, extended by all available
+// topics matching one of the patterns.
+func matchTopics(topics []string, patterns []*regexp.Regexp, available []string) []string {
+	set := map[string]struct{}{}
+	for _, topic := range topics {
+		set[topic] = struct{}{}
+	}
+	for _, topic := range available {
+		for _, pattern := range patterns {
+			if pattern.MatchString(topic) {
+				set[topic] = struct{}{}
+				break
+			}
+		}
+	}
+
+	matched := make([]string, 0, len(set))
+	for topic := range set {
+		matched = append(matched, topic)
+	}
+	sort.Strings(matched)
+	return matched
+}
+
+func (p *Input) createEvent(session sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) *util.Data {
+	timestamp := msg.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	kafkaFields := common.MapStr{
+		"topic":     msg.Topic,
+		"partition": msg.Partition,
+		"offset":    msg.Offset,
+	}
+	if len(msg.Key) > 0 {
+		kafkaFields["key"] = string(msg.Key)
+	}
+
+	var meta common.MapStr
+	if len(msg.Headers) > 0 {
+		headers := common.MapStr{}
+		for _, h := range msg.Headers {
+			if h == nil {
+				continue
+			}
+			key, value := string(h.Key), string(h.Value)
+			headers[key] = value
+
+			if target, found := p.config.HeadersToMetadata[key]; found {
+				if meta == nil {
+					meta = common.MapStr{}
+				}
+				meta.Put(target, value)
+			}
+		}
+		kafkaFields["headers"] = headers
+	}
+
+	data := util.NewData()
+	data.Event = beat.Event{
+		Timestamp: timestamp,
+		Meta:      meta,
+		Fields: common.MapStr{
+			"message": string(msg.Value),
+			"kafka":   kafkaFields,
+		},
+		Private: eventMeta{session: session, message: msg},
+	}
+	return data
+}
+
+// groupHandler implements sarama.ConsumerGroupHandler, publishing all
+// messages of the claimed partitions.
+type groupHandler struct {
+	input *Input
+}
+
+func (h *groupHandler) Setup(session sarama.ConsumerGroupSession) error {
+	h.input.log.Debugw("Consumer group session started", "claims", session.Claims())
+	return nil
+}
+
+func (h *groupHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	h.input.log.Debug("Consumer group session ended")
+	return nil
+}
+
+func (h *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		if !h.input.outlet.OnEvent(h.input.createEvent(session, msg)) {
+			// the outlet has been closed
+			return nil
+		}
+	}
+	return nil
+}
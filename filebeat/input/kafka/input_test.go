@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+type testSession struct {
+	marked map[string]int64
+}
+
+func (s *testSession) Claims() map[string][]int32 { return nil }
+func (s *testSession) MemberID() string           { return "" }
+func (s *testSession) GenerationID() int32        { return 0 }
+func (s *testSession) Context() context.Context   { return context.Background() }
+
+func (s *testSession) MarkOffset(topic string, partition int32, offset int64, metadata string) {
+	s.marked[topic] = offset
+}
+
+func (s *testSession) ResetOffset(topic string, partition int32, offset int64, metadata string) {}
+
+func (s *testSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+func newTestConfig(t *testing.T, settings map[string]interface{}) kafkaInputConfig {
+	c := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&c))
+	return c
+}
+
+func TestConfig(t *testing.T) {
+	c := newTestConfig(t, map[string]interface{}{
+		"hosts":              []string{"localhost:9092"},
+		"topics":             []string{"logs"},
+		"group_id":           "filebeat",
+		"initial_offset":     "newest",
+		"rebalance.strategy": "roundrobin",
+		"username":           "user",
+		"password":           "secret",
+	})
+
+	saramaConfig, err := newSaramaConfig(c)
+	require.NoError(t, err)
+	assert.Equal(t, sarama.OffsetNewest, saramaConfig.Consumer.Offsets.Initial)
+	assert.Equal(t, sarama.BalanceStrategyRoundRobin, saramaConfig.Consumer.Group.Rebalance.Strategy)
+	assert.True(t, saramaConfig.Net.SASL.Enable)
+	assert.True(t, saramaConfig.Consumer.Return.Errors)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"hosts":    []string{"localhost:9092"},
+			"topics":   []string{"logs"},
+			"group_id": "filebeat",
+		}
+	}
+
+	cases := map[string]func(map[string]interface{}){
+		"no hosts":         func(m map[string]interface{}) { delete(m, "hosts") },
+		"no group":         func(m map[string]interface{}) { delete(m, "group_id") },
+		"no topics":        func(m map[string]interface{}) { delete(m, "topics") },
+		"invalid pattern":  func(m map[string]interface{}) { m["topic_patterns"] = []string{"logs-("} },
+		"old version":      func(m map[string]interface{}) { m["version"] = "0.10.1" },
+		"invalid offset":   func(m map[string]interface{}) { m["initial_offset"] = "latest" },
+		"invalid strategy": func(m map[string]interface{}) { m["rebalance.strategy"] = "sticky" },
+		"missing password": func(m map[string]interface{}) { m["username"] = "user" },
+	}
+
+	for name, modify := range cases {
+		t.Run(name, func(t *testing.T) {
+			settings := valid()
+			modify(settings)
+			c := defaultConfig()
+			assert.Error(t, common.MustNewConfigFrom(settings).Unpack(&c))
+		})
+	}
+}
+
+func TestMatchTopics(t *testing.T) {
+	patterns := []*regexp.Regexp{regexp.MustCompile(`^logs-`), regexp.MustCompile(`audit$`)}
+	available := []string{"logs-nginx", "metrics", "logs-apache", "system-audit", "__consumer_offsets"}
+
+	topics := matchTopics([]string{"events", "logs-nginx"}, patterns, available)
+	assert.Equal(t, []string{"events", "logs-apache", "logs-nginx", "system-audit"}, topics)
+}
+
+func TestCreateEvent(t *testing.T) {
+	p := &Input{
+		config: newTestConfig(t, map[string]interface{}{
+			"hosts":               []string{"localhost:9092"},
+			"topic_patterns":      []string{"logs-.*"},
+			"group_id":            "filebeat",
+			"headers_to_metadata": map[string]string{"pipeline": "pipeline", "trace": "trace.id"},
+		}),
+		log: logp.NewLogger("test"),
+	}
+
+	ts := time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+	msg := &sarama.ConsumerMessage{
+		Topic:     "logs-nginx",
+		Partition: 3,
+		Offset:    42,
+		Key:       []byte("host-1"),
+		Value:     []byte("hello world"),
+		Timestamp: ts,
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("pipeline"), Value: []byte("nginx")},
+			{Key: []byte("trace"), Value: []byte("abc")},
+			{Key: []byte("other"), Value: []byte("x")},
+		},
+	}
+
+	session := &testSession{marked: map[string]int64{}}
+	event := p.createEvent(session, msg).GetEvent()
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"message": "hello world",
+		"kafka": common.MapStr{
+			"topic":     "logs-nginx",
+			"partition": int32(3),
+			"offset":    int64(42),
+			"key":       "host-1",
+			"headers":   common.MapStr{"pipeline": "nginx", "trace": "abc", "other": "x"},
+		},
+	}, event.Fields)
+	assert.Equal(t, common.MapStr{"pipeline": "nginx", "trace": common.MapStr{"id": "abc"}}, event.Meta)
+
+	// the offset is only marked once the event is ACKed
+	assert.Empty(t, session.marked)
+	ackEvents([]interface{}{nil, event.Private})
+	assert.Equal(t, map[string]int64{"logs-nginx": 43}, session.marked)
+}
@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/elastic/beats/libbeat/common/file"
+)
+
+// Supported values of the compression setting.
+const (
+	compressionNone = "none"
+	compressionAuto = "auto"
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+)
+
+// fingerprintSize is the number of bytes of the content used to recognize a
+// log file after it has been compressed.
+const fingerprintSize = 1024
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+var validCompressions = map[string]struct{}{
+	"":              {},
+	compressionNone: {},
+	compressionAuto: {},
+	compressionGzip: {},
+	compressionZstd: {},
+}
+
+func compressionEnabled(setting string) bool {
+	return setting != "" && setting != compressionNone
+}
+
+// detectCompression returns the compression format of the file, or an empty
+// string if the file is not compressed. With the `auto` setting the format is
+// detected from the magic bytes of the file, otherwise only files of the
+// configured format are decompressed.
+func detectCompression(setting string, f io.ReaderAt) string {
+	if !compressionEnabled(setting) {
+		return ""
+	}
+
+	magic := make([]byte, len(zstdMagic))
+	n, _ := f.ReadAt(magic, 0)
+	magic = magic[:n]
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic) && (setting == compressionAuto || setting == compressionGzip):
+		return compressionGzip
+	case bytes.HasPrefix(magic, zstdMagic) && (setting == compressionAuto || setting == compressionZstd):
+		return compressionZstd
+	}
+	return ""
+}
+
+// newDecompressor creates a reader returning the uncompressed content of r.
+func newDecompressor(format string, r io.Reader) (io.ReadCloser, error) {
+	switch format {
+	case compressionGzip:
+		return gzip.NewReader(r)
+	case compressionZstd:
+		return newZstdReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", format)
+	}
+}
+
+// CompressedFile is a harvester source reading the uncompressed content of a
+// compressed file. Compressed files are read once from start to end, they are
+// not continuable.
+type CompressedFile struct {
+	file   *os.File
+	reader io.ReadCloser
+	offset int64
+}
+
+func newCompressedFile(f *os.File, format string) (*CompressedFile, error) {
+	r, err := newDecompressor(format, f)
+	if err != nil {
+		return nil, err
+	}
+	return &CompressedFile{file: f, reader: r}, nil
+}
+
+// Read reads uncompressed data. Like reading a plain file, io.EOF is only
+// returned once no more data is available, as the readers of the harvester
+// drop the data returned together with an error.
+func (f *CompressedFile) Read(b []byte) (int, error) {
+	n, err := f.reader.Read(b)
+	f.offset += int64(n)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+// Skip discards the uncompressed data up to the given offset.
+func (f *CompressedFile) Skip(offset int64) error {
+	if offset <= f.offset {
+		return nil
+	}
+	_, err := io.CopyN(ioutil.Discard, f, offset-f.offset)
+	return err
+}
+
+// Offset returns the offset in the uncompressed content.
+func (f *CompressedFile) Offset() int64 { return f.offset }
+
+// Close closes the decompressor and the file.
+func (f *CompressedFile) Close() error {
+	f.reader.Close()
+	return f.file.Close()
+}
+
+func (f *CompressedFile) Name() string               { return f.file.Name() }
+func (f *CompressedFile) Stat() (os.FileInfo, error) { return f.file.Stat() }
+func (f *CompressedFile) Continuable() bool          { return false }
+func (f *CompressedFile) HasState() bool             { return true }
+func (f *CompressedFile) Removed() bool              { return file.IsRemoved(f.file) }
+
+// fingerprint hashes the first bytes of the content. Returns an empty string
+// if the content is too short, as the fingerprint of a growing file would
+// change.
+func fingerprint(r io.Reader) (string, error) {
+	buf := make([]byte, fingerprintSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return "", nil
+		}
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// fileFingerprint hashes the first bytes of an uncompressed file, without
+// changing the read position of the file.
+func fileFingerprint(f io.ReaderAt) (string, error) {
+	return fingerprint(io.NewSectionReader(f, 0, fingerprintSize))
+}
+
+// compressedFingerprint hashes the first bytes of the uncompressed content,
+// without changing the read position of the file.
+func compressedFingerprint(f *os.File, format string) (string, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	r, err := newDecompressor(format, io.NewSectionReader(f, 0, info.Size()))
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	return fingerprint(r)
+}
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/reader/readfile/encoding"
+)
+
+func TestDetectCompression(t *testing.T) {
+	gz := bytes.NewReader([]byte{0x1f, 0x8b, 0x08, 0x00})
+	zst := bytes.NewReader([]byte{0x28, 0xb5, 0x2f, 0xfd})
+	plain := bytes.NewReader([]byte("line\n"))
+
+	assert.Equal(t, "", detectCompression("", gz))
+	assert.Equal(t, "", detectCompression("none", gz))
+	assert.Equal(t, "gzip", detectCompression("auto", gz))
+	assert.Equal(t, "gzip", detectCompression("gzip", gz))
+	assert.Equal(t, "", detectCompression("zstd", gz))
+	assert.Equal(t, "zstd", detectCompression("auto", zst))
+	assert.Equal(t, "", detectCompression("auto", plain))
+	assert.Equal(t, "", detectCompression("auto", bytes.NewReader(nil)))
+}
+
+func TestFingerprint(t *testing.T) {
+	short, err := fingerprint(strings.NewReader("short"))
+	require.NoError(t, err)
+	assert.Equal(t, "", short)
+
+	content := strings.Repeat("a", fingerprintSize)
+	fp, err := fingerprint(strings.NewReader(content))
+	require.NoError(t, err)
+	assert.Len(t, fp, 64)
+
+	longer, err := fileFingerprint(strings.NewReader(content + "more content"))
+	require.NoError(t, err)
+	assert.Equal(t, fp, longer)
+}
+
+func TestReadGzipFile(t *testing.T) {
+	content := testLines(100)
+	path := writeGzipFile(t, content)
+	defer os.Remove(path)
+
+	h := newCompressionTestHarvester(t, path, file.NewStates())
+	lines := readAllLines(t, h)
+	assert.Equal(t, strings.Split(strings.TrimSuffix(content, "\n"), "\n"), lines)
+	assert.True(t, h.state.Compressed)
+	assert.NotEmpty(t, h.state.Fingerprint)
+}
+
+func TestContinueCompressedFileByFingerprint(t *testing.T) {
+	content := testLines(100)
+	path := writeGzipFile(t, content)
+	defer os.Remove(path)
+
+	// state of the log file before it was compressed by the log rotation
+	offset := strings.Index(content, "line 0090")
+	fp, err := fingerprint(strings.NewReader(content))
+	require.NoError(t, err)
+
+	states := file.NewStates()
+	states.SetStates([]file.State{{
+		Source:      "/var/log/app.log.1",
+		Offset:      int64(offset),
+		Fingerprint: fp,
+		Finished:    true,
+	}})
+
+	h := newCompressionTestHarvester(t, path, states)
+	assert.Equal(t, int64(offset), h.state.Offset)
+
+	lines := readAllLines(t, h)
+	require.Len(t, lines, 10)
+	assert.Equal(t, "line 0090 of the rotated log file", lines[0])
+}
+
+func testLines(n int) string {
+	buf := &bytes.Buffer{}
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(buf, "line %04d of the rotated log file\n", i)
+	}
+	return buf.String()
+}
+
+func writeGzipFile(t *testing.T, content string) string {
+	f, err := ioutil.TempFile("", "app.log")
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := gzip.NewWriter(f)
+	_, err = io.WriteString(w, content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	path, err := filepath.Abs(f.Name())
+	require.NoError(t, err)
+	return path
+}
+
+func newCompressionTestHarvester(t *testing.T, path string, states *file.States) *Harvester {
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	h := &Harvester{
+		config: config{
+			LogConfig: LogConfig{
+				CloseInactive: time.Second,
+				Backoff:       100 * time.Millisecond,
+				MaxBackoff:    time.Second,
+				BackoffFactor: 2,
+			},
+			BufferSize:  100,
+			MaxBytes:    1000,
+			Compression: compressionAuto,
+		},
+		state:  file.NewState(info, path, "log", nil),
+		states: states,
+	}
+
+	var ok bool
+	h.encodingFactory, ok = encoding.FindEncoding(h.config.Encoding)
+	require.True(t, ok)
+
+	require.NoError(t, h.openFile())
+	return h
+}
+
+func readAllLines(t *testing.T, h *Harvester) []string {
+	defer h.source.Close()
+
+	r, err := h.newLogFileReader()
+	require.NoError(t, err)
+
+	var lines []string
+	for {
+		_, text, _, _, err := readLine(r)
+		if err == io.EOF {
+			return lines
+		}
+		require.NoError(t, err)
+		lines = append(lines, text)
+	}
+}
@@ -75,6 +75,7 @@ type config struct {
 	LogConfig                 `config:",inline"`
 
 	// Common
+	ID            string        `config:"id"`
 	InputType     string        `config:"input_type"`
 	CleanInactive time.Duration `config:"clean_inactive" validate:"min=0"`
 
@@ -91,10 +92,11 @@ type config struct {
 	RecursiveGlob  bool            `config:"recursive_glob.enabled"`
 
 	// Harvester
-	BufferSize int    `config:"harvester_buffer_size"`
-	Encoding   string `config:"encoding"`
-	ScanOrder  string `config:"scan.order"`
-	ScanSort   string `config:"scan.sort"`
+	BufferSize  int    `config:"harvester_buffer_size"`
+	Encoding    string `config:"encoding"`
+	Compression string `config:"compression"`
+	ScanOrder   string `config:"scan.order"`
+	ScanSort    string `config:"scan.sort"`
 
 	ExcludeLines []match.Matcher   `config:"exclude_lines"`
 	IncludeLines []match.Matcher   `config:"include_lines"`
@@ -102,13 +104,11 @@ type config struct {
 	Multiline    *multiline.Config `config:"multiline"`
 	JSON         *readjson.Config  `config:"json"`
 
-	// Hidden on purpose, used by the docker input:
-	DockerJSON *struct {
-		Stream   string `config:"stream"`
-		Partial  bool   `config:"partial"`
-		ForceCRI bool   `config:"force_cri_logs"`
-		CRIFlags bool   `config:"cri_flags"`
-	} `config:"docker-json"`
+	// Hidden on purpose, used by the docker and container inputs:
+	DockerJSON *readjson.ContainerJSONConfig `config:"docker-json"`
+
+	// Hidden on purpose, used by the container input:
+	KubernetesPathMetadata bool `config:"kubernetes_path_metadata"`
 }
 
 type LogConfig struct {
@@ -174,6 +174,10 @@ func (c *config) Validate() error {
 		return fmt.Errorf("When using the JSON decoder and line filtering together, you need to specify a message_key value")
 	}
 
+	if _, ok := validCompressions[c.Compression]; !ok {
+		return fmt.Errorf("Invalid compression: %v", c.Compression)
+	}
+
 	if c.ScanSort != "" {
 		cfgwarn.Experimental("scan_sort is used.")
 
@@ -98,6 +98,9 @@ type Harvester struct {
 	encodingFactory encoding.EncodingFactory
 	encoding        encoding.Encoding
 
+	// fields derived from the path of the file
+	pathFields common.MapStr
+
 	// event/state publishing
 	outletFactory OutletFactory
 	publishState  func(*util.Data) bool
@@ -134,6 +137,10 @@ func NewHarvester(
 		return nil, err
 	}
 
+	if h.config.KubernetesPathMetadata {
+		h.pathFields = kubernetesMetadataFromPath(state.Source)
+	}
+
 	encodingFactory, ok := encoding.FindEncoding(h.config.Encoding)
 	if !ok || encodingFactory == nil {
 		return nil, fmt.Errorf("unknown encoding('%v')", h.config.Encoding)
@@ -274,7 +281,12 @@ func (h *Harvester) Run() error {
 			case ErrClosed:
 				logp.Info("Reader was closed: %s. Closing.", h.state.Source)
 			case io.EOF:
-				logp.Info("End of file reached: %s. Closing because close_eof is enabled.", h.state.Source)
+				if _, ok := h.source.(*CompressedFile); ok {
+					logp.Info("End of compressed file reached: %s. Closing.", h.state.Source)
+					h.state.EOF = true
+				} else {
+					logp.Info("End of file reached: %s. Closing because close_eof is enabled.", h.state.Source)
+				}
 			case ErrInactive:
 				logp.Info("File is inactive: %s. Closing because close_inactive of %v reached.", h.state.Source, h.config.CloseInactive)
 			default:
@@ -296,6 +308,13 @@ func (h *Harvester) Run() error {
 		startingOffset := state.Offset
 		state.Offset += int64(message.Bytes)
 
+		// Remember the beginning of the content, to continue reading the
+		// file after it has been compressed by the log rotation.
+		if f, ok := h.source.(File); ok && compressionEnabled(h.config.Compression) &&
+			state.Fingerprint == "" && state.Offset >= fingerprintSize {
+			state.Fingerprint, _ = fileFingerprint(f.File)
+		}
+
 		// Create state event
 		data := util.NewData()
 		if h.source.HasState() {
@@ -315,6 +334,9 @@ func (h *Harvester) Run() error {
 				},
 			}
 			fields.DeepUpdate(message.Fields)
+			if h.pathFields != nil {
+				fields.DeepUpdate(h.pathFields.Clone())
+			}
 
 			// Check if json fields exist
 			var jsonFields common.MapStr
@@ -433,18 +455,23 @@ func (h *Harvester) openFile() error {
 	harvesterOpenFiles.Add(1)
 
 	// Makes sure file handler is also closed on errors
-	err = h.validateFile(f)
+	var source harvester.Source = File{File: f}
+	if format := detectCompression(h.config.Compression, f); format != "" {
+		source, err = h.validateCompressedFile(f, format)
+	} else {
+		err = h.validateFile(f)
+	}
 	if err != nil {
 		f.Close()
 		harvesterOpenFiles.Add(-1)
 		return err
 	}
 
-	h.source = File{File: f}
+	h.source = source
 	return nil
 }
 
-func (h *Harvester) validateFile(f *os.File) error {
+func (h *Harvester) checkFileInfo(f *os.File) error {
 	info, err := f.Stat()
 	if err != nil {
 		return fmt.Errorf("Failed getting stats for file %s: %s", h.state.Source, err)
@@ -458,6 +485,14 @@ func (h *Harvester) validateFile(f *os.File) error {
 	if !os.SameFile(h.state.Fileinfo, info) {
 		return errors.New("file info is not identical with opened file. Aborting harvesting and retrying file later again")
 	}
+	return nil
+}
+
+func (h *Harvester) validateFile(f *os.File) error {
+	err := h.checkFileInfo(f)
+	if err != nil {
+		return err
+	}
 
 	h.encoding, err = h.encodingFactory(f)
 	if err != nil {
@@ -482,6 +517,54 @@ func (h *Harvester) validateFile(f *os.File) error {
 	return nil
 }
 
+// validateCompressedFile prepares reading the uncompressed content of a
+// compressed file. The offset is skipped by decompressing the file, as
+// compressed files cannot be seeked.
+func (h *Harvester) validateCompressedFile(f *os.File, format string) (*CompressedFile, error) {
+	err := h.checkFileInfo(f)
+	if err != nil {
+		return nil, err
+	}
+
+	h.state.Compressed = true
+	h.state.Fingerprint, err = compressedFingerprint(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("Failed reading compressed file %s: %s", h.state.Source, err)
+	}
+
+	// A log file compressed by the log rotation continues where reading
+	// of the uncompressed file has stopped.
+	if h.state.Offset == 0 && h.states != nil {
+		if previous := h.states.FindByFingerprint(h.state); !previous.IsEmpty() {
+			logp.Info("Compressed file %s has the same content as %s. Continue from offset: %d", h.state.Source, previous.Source, previous.Offset)
+			h.state.Offset = previous.Offset
+		}
+	}
+
+	source, err := newCompressedFile(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("Failed reading compressed file %s: %s", h.state.Source, err)
+	}
+
+	if err := source.Skip(h.state.Offset); err != nil {
+		source.reader.Close()
+		return nil, fmt.Errorf("Failed skipping to offset %d in compressed file %s: %s", h.state.Offset, h.state.Source, err)
+	}
+
+	h.encoding, err = h.encodingFactory(source)
+	if err != nil {
+		source.reader.Close()
+		logp.Err("Initialising encoding for '%v' failed: %v", f, err)
+		return nil, err
+	}
+
+	// the encoding factory may have consumed a BOM
+	logp.Debug("harvester", "Setting offset for compressed file: %s. Offset: %d ", h.state.Source, source.Offset())
+	h.state.Offset = source.Offset()
+
+	return source, nil
+}
+
 func (h *Harvester) initFileOffset(file *os.File) (int64, error) {
 	// continue from last known offset
 	if h.state.Offset > 0 {
@@ -571,7 +654,7 @@ func (h *Harvester) newLogFileReader() (reader.Reader, error) {
 
 	if h.config.DockerJSON != nil {
 		// Docker json-file format, add custom parsing to the pipeline
-		r = readjson.New(r, h.config.DockerJSON.Stream, h.config.DockerJSON.Partial, h.config.DockerJSON.ForceCRI, h.config.DockerJSON.CRIFlags)
+		r = readjson.NewContainerParser(r, h.config.DockerJSON)
 	}
 
 	if h.config.JSON != nil {
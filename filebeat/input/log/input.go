@@ -493,6 +493,30 @@ func (p *Input) scan() {
 func (p *Input) harvestExistingFile(newState file.State, oldState file.State) {
 	logp.Debug("input", "Update existing file for harvesting: %s, offset: %v", newState.Source, oldState.Offset)
 
+	// Compressed files are not updated after being written, they are read only
+	// once. The offset is in the uncompressed content and cannot be compared
+	// with the file size.
+	if oldState.Compressed {
+		if oldState.Finished && !oldState.EOF {
+			logp.Debug("input", "Resuming harvesting of compressed file: %s, offset: %d", newState.Source, oldState.Offset)
+			err := p.startHarvester(newState, oldState.Offset)
+			if err != nil {
+				logp.Err("Harvester could not be started on compressed file: %s, Err: %s", newState.Source, err)
+			}
+			return
+		}
+
+		if oldState.Finished && oldState.Source != newState.Source {
+			logp.Debug("input", "Updating state for renamed compressed file: %s -> %s", oldState.Source, newState.Source)
+			oldState.Source = newState.Source
+			if err := p.updateState(oldState); err != nil {
+				logp.Err("File rotation state update error: %s", err)
+			}
+			filesRenamed.Add(1)
+		}
+		return
+	}
+
 	// No harvester is running for the file, start a new harvester
 	// It is important here that only the size is checked and not modification time, as modification time could be incorrect on windows
 	// https://blogs.technet.microsoft.com/asiasupp/2010/12/14/file-date-modified-property-are-not-updating-while-modifying-a-file-without-closing-it/
@@ -659,6 +683,7 @@ func (p *Input) startHarvester(state file.State, offset int64) error {
 	// Set state to "not" finished to indicate that a harvester is running
 	state.Finished = false
 	state.Offset = offset
+	state.InputID = p.config.ID
 
 	// Create harvester with state
 	h, err := p.createHarvester(state, func() { p.numHarvesters.Dec() })
@@ -695,6 +720,7 @@ func (p *Input) updateState(state file.State) error {
 	if len(state.Meta) == 0 {
 		state.Meta = nil
 	}
+	state.InputID = p.config.ID
 
 	// Update first internal state
 	p.states.Update(state)
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package log
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// containerLogRegexp matches the names of the symlinks in /var/log/containers:
+// <pod name>_<namespace>_<container name>-<container id>.log
+var containerLogRegexp = regexp.MustCompile(`^([^_]+)_([^_]+)_(.+)-([0-9a-f]{64})\.log$`)
+
+// kubernetesMetadataFromPath extracts the Kubernetes metadata from the path of
+// a container log file written by the kubelet. The following layouts are
+// supported:
+//
+//	/var/log/pods/<namespace>_<pod name>_<pod uid>/<container name>/<restart count>.log
+//	/var/log/pods/<pod uid>/<container name>/<restart count>.log
+//	/var/log/containers/<pod name>_<namespace>_<container name>-<container id>.log
+//
+// Returns nil if the path doesn't match any of the layouts.
+func kubernetesMetadataFromPath(path string) common.MapStr {
+	path = filepath.ToSlash(path)
+
+	if idx := strings.Index(path, "/pods/"); idx >= 0 {
+		parts := strings.Split(path[idx+len("/pods/"):], "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+			return nil
+		}
+
+		pod := common.MapStr{}
+		fields := common.MapStr{
+			"pod":       pod,
+			"container": common.MapStr{"name": parts[1]},
+		}
+
+		if pod0 := strings.Split(parts[0], "_"); len(pod0) == 3 {
+			fields["namespace"] = pod0[0]
+			pod["name"] = pod0[1]
+			pod["uid"] = pod0[2]
+		} else {
+			pod["uid"] = parts[0]
+		}
+		return common.MapStr{"kubernetes": fields}
+	}
+
+	if strings.Contains(path, "/containers/") {
+		matches := containerLogRegexp.FindStringSubmatch(filepath.Base(path))
+		if matches == nil {
+			return nil
+		}
+		return common.MapStr{
+			"kubernetes": common.MapStr{
+				"pod":       common.MapStr{"name": matches[1]},
+				"namespace": matches[2],
+				"container": common.MapStr{"name": matches[3]},
+			},
+			"container": common.MapStr{"id": matches[4]},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestKubernetesMetadataFromPath(t *testing.T) {
+	tests := map[string]common.MapStr{
+		"/var/log/pods/kube-system_coredns-5c98db65d4-xv6gm_2f7e2a8d-8c3b-4c7e-9c63-2b3a0c2d6e5f/coredns/0.log": {
+			"kubernetes": common.MapStr{
+				"namespace": "kube-system",
+				"pod": common.MapStr{
+					"name": "coredns-5c98db65d4-xv6gm",
+					"uid":  "2f7e2a8d-8c3b-4c7e-9c63-2b3a0c2d6e5f",
+				},
+				"container": common.MapStr{"name": "coredns"},
+			},
+		},
+		"/var/log/pods/2f7e2a8d-8c3b-4c7e-9c63-2b3a0c2d6e5f/coredns/1.log": {
+			"kubernetes": common.MapStr{
+				"pod":       common.MapStr{"uid": "2f7e2a8d-8c3b-4c7e-9c63-2b3a0c2d6e5f"},
+				"container": common.MapStr{"name": "coredns"},
+			},
+		},
+		"/var/log/containers/coredns-5c98db65d4-xv6gm_kube-system_coredns-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.log": {
+			"kubernetes": common.MapStr{
+				"namespace": "kube-system",
+				"pod":       common.MapStr{"name": "coredns-5c98db65d4-xv6gm"},
+				"container": common.MapStr{"name": "coredns"},
+			},
+			"container": common.MapStr{"id": "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"},
+		},
+		"/var/log/pods/incomplete/0.log":                     nil,
+		"/var/log/containers/not-a-kubernetes-container.log": nil,
+		"/var/log/syslog":                                    nil,
+	}
+
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, expected, kubernetesMetadataFromPath(path))
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/filebeat/inputsource"
 	"github.com/elastic/beats/filebeat/inputsource/tcp"
 	"github.com/elastic/beats/filebeat/inputsource/udp"
+	"github.com/elastic/beats/filebeat/inputsource/unix"
 	"github.com/elastic/beats/libbeat/common"
 )
 
@@ -54,6 +55,19 @@ var defaultTCP = syslogTCP{
 	LineDelimiter: "\n",
 }
 
+type syslogUnix struct {
+	unix.Config   `config:",inline"`
+	LineDelimiter string `config:"line_delimiter" validate:"nonzero"`
+}
+
+var defaultUnix = syslogUnix{
+	Config: unix.Config{
+		Timeout:        time.Minute * 5,
+		MaxMessageSize: 20 * humanize.MiByte,
+	},
+	LineDelimiter: "\n",
+}
+
 var defaultUDP = udp.Config{
 	MaxMessageSize: 10 * humanize.KiByte,
 	Timeout:        time.Minute * 5,
@@ -72,19 +86,31 @@ func factory(
 			return nil, err
 		}
 
-		splitFunc := tcp.SplitFunc([]byte(config.LineDelimiter))
-		if splitFunc == nil {
+		split := splitFunc([]byte(config.LineDelimiter))
+		if split == nil {
 			return nil, fmt.Errorf("error creating splitFunc from delimiter %s", config.LineDelimiter)
 		}
 
-		return tcp.New(&config.Config, splitFunc, cb)
+		return tcp.New(&config.Config, split, cb)
 	case udp.Name:
 		config := defaultUDP
 		if err := cfg.Unpack(&config); err != nil {
 			return nil, err
 		}
 		return udp.New(&config, cb), nil
+	case unix.Name:
+		config := defaultUnix
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+
+		split := splitFunc([]byte(config.LineDelimiter))
+		if split == nil {
+			return nil, fmt.Errorf("error creating splitFunc from delimiter %s", config.LineDelimiter)
+		}
+
+		return unix.New(&config.Config, split, cb)
 	default:
-		return nil, fmt.Errorf("you must choose between TCP or UDP")
+		return nil, fmt.Errorf("you must choose between TCP, UDP or Unix")
 	}
 }
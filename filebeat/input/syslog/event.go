@@ -20,6 +20,8 @@ package syslog
 import (
 	"math"
 	"time"
+
+	"github.com/elastic/beats/libbeat/common"
 )
 
 const severityMask = 7
@@ -72,6 +74,12 @@ type event struct {
 	year       int
 	loc        *time.Location
 	sequence   int
+
+	// RFC5424 only fields.
+	version        int
+	procID         string
+	msgID          string
+	structuredData common.MapStr
 }
 
 // newEvent() return a new event.
@@ -271,6 +279,45 @@ func (s *event) HasPid() bool {
 	return s.pid > 0
 }
 
+// SetVersion sets the version of the RFC5424 protocol.
+func (s *event) SetVersion(b []byte) {
+	s.version = bytesToInt(b)
+}
+
+// Version returns the version of the RFC5424 protocol, 0 for BSD messages.
+func (s *event) Version() int {
+	return s.version
+}
+
+// SetProcID sets the RFC5424 process ID, the pid is set too if the process ID
+// is numeric.
+func (s *event) SetProcID(b []byte) {
+	s.procID = string(b)
+	if isDigits(b) {
+		s.SetPid(b)
+	}
+}
+
+// ProcID returns the RFC5424 process ID.
+func (s *event) ProcID() string {
+	return s.procID
+}
+
+// SetMsgID sets the RFC5424 message ID.
+func (s *event) SetMsgID(b []byte) {
+	s.msgID = string(b)
+}
+
+// MsgID returns the RFC5424 message ID.
+func (s *event) MsgID() string {
+	return s.msgID
+}
+
+// StructuredData returns the RFC5424 structured data, indexed by SD-ID.
+func (s *event) StructuredData() common.MapStr {
+	return s.structuredData
+}
+
 // SetSequence set the sequence number for this event.
 func (s *event) SetSequence(b []byte) {
 	s.sequence = bytesToInt(b)
@@ -319,9 +366,11 @@ func (s *event) Timestamp(timezone *time.Location) time.Time {
 	).UTC()
 }
 
-// IsValid returns true if the date and the message are present.
+// IsValid returns true if the date and the message are present. The message
+// is optional for RFC5424 events.
 func (s *event) IsValid() bool {
-	return s.day != -1 && s.hour != -1 && s.minute != -1 && s.second != -1 && s.message != ""
+	return s.day != -1 && s.hour != -1 && s.minute != -1 && s.second != -1 &&
+		(s.message != "" || s.version > 0)
 }
 
 // BytesToInt takes a variable length of bytes and assume ascii chars and convert it to int, this is
@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bufio"
+	"strconv"
+
+	"github.com/elastic/beats/filebeat/inputsource/tcp"
+)
+
+// maxOctetCountDigits limits the length of the MSG-LEN prefix.
+const maxOctetCountDigits = 9
+
+// splitFunc returns a split function supporting both framing methods of
+// RFC6587 on stream sockets. The framing is detected for every message:
+//
+//   - Octet counting: `MSG-LEN SP SYSLOG-MSG`, e.g. `11 <34>1 - ...`.
+//   - Non-transparent framing: messages are terminated by the delimiter.
+//
+// Returns nil if no split function can be created for the delimiter.
+func splitFunc(delimiter []byte) bufio.SplitFunc {
+	splitDelimiter := tcp.SplitFunc(delimiter)
+	if splitDelimiter == nil {
+		return nil
+	}
+
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		// Some senders terminate octet counted frames with a newline, skip it.
+		skip := 0
+		for skip < len(data) && (data[skip] == '\n' || data[skip] == '\r') {
+			skip++
+		}
+		if skip > 0 {
+			return skip, nil, nil
+		}
+
+		length, offset, ok := octetCount(data)
+		if !ok {
+			return splitDelimiter(data, atEOF)
+		}
+
+		if len(data) < offset+length {
+			if atEOF && len(data) > offset {
+				return len(data), data[offset:], nil
+			}
+			return 0, nil, nil
+		}
+		return offset + length, data[offset : offset+length], nil
+	}
+}
+
+// octetCount detects the MSG-LEN prefix of an octet counted frame. The
+// prefix must be followed by a space and the start of the priority.
+func octetCount(data []byte) (length int, offset int, ok bool) {
+	i := 0
+	for i < len(data) && i <= maxOctetCountDigits && data[i] >= '0' && data[i] <= '9' {
+		i++
+	}
+	if i == 0 || i > maxOctetCountDigits || len(data) < i+2 || data[i] != ' ' || data[i+1] != '<' {
+		return 0, 0, false
+	}
+
+	length, err := strconv.Atoi(string(data[:i]))
+	if err != nil || length == 0 {
+		return 0, 0, false
+	}
+	return length, i + 1, true
+}
@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func scanAll(t *testing.T, input string) []string {
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	scanner.Split(splitFunc([]byte("\n")))
+
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	assert.NoError(t, scanner.Err())
+	return tokens
+}
+
+func TestSplitFunc(t *testing.T) {
+	tests := []struct {
+		title    string
+		input    string
+		expected []string
+	}{
+		{
+			title:    "non-transparent framing",
+			input:    "<13>1 - - - - - first\n<13>1 - - - - - second\n",
+			expected: []string{"<13>1 - - - - - first", "<13>1 - - - - - second"},
+		},
+		{
+			title:    "octet counting",
+			input:    "21 <13>1 - - - - - first22 <13>1 - - - - - second",
+			expected: []string{"<13>1 - - - - - first", "<13>1 - - - - - second"},
+		},
+		{
+			title:    "octet counting keeps embedded newlines",
+			input:    "23 <13>1 - - - - - a\nb\nc",
+			expected: []string{"<13>1 - - - - - a\nb\nc"},
+		},
+		{
+			title:    "octet counted frames terminated by newlines",
+			input:    "21 <13>1 - - - - - first\n22 <13>1 - - - - - second\n",
+			expected: []string{"<13>1 - - - - - first", "<13>1 - - - - - second"},
+		},
+		{
+			title:    "mixed framing",
+			input:    "21 <13>1 - - - - - first<13>Oct 11 22:14:15 host app: second\n",
+			expected: []string{"<13>1 - - - - - first", "<13>Oct 11 22:14:15 host app: second"},
+		},
+		{
+			title:    "digits without priority are not an octet count",
+			input:    "12 apples\n",
+			expected: []string{"12 apples"},
+		},
+		{
+			title:    "truncated frame at EOF",
+			input:    "30 <13>1 - - - - - short",
+			expected: []string{"<13>1 - - - - - short"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			assert.Equal(t, test.expected, scanAll(t, test.input))
+		})
+	}
+}
@@ -126,10 +126,10 @@ func NewInput(
 	forwarder := harvester.NewForwarder(out)
 	cb := func(data []byte, metadata inputsource.NetworkMetadata) {
 		ev := newEvent()
-		Parse(data, ev)
+		ParseMessage(data, ev)
 		var d *util.Data
 		if !ev.IsValid() {
-			log.Errorw("can't parse event as syslog rfc3164", "message", string(data))
+			log.Errorw("can't parse event as syslog rfc3164 or rfc5424", "message", string(data))
 			// On error revert to the raw bytes content, we need a better way to communicate this kind of
 			// error upstream this should be a global effort.
 			d = &util.Data{
@@ -204,11 +204,15 @@ func (p *Input) Wait() {
 func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time.Location, log *logp.Logger) *beat.Event {
 	f := common.MapStr{
 		"message": strings.TrimRight(ev.Message(), "\n"),
-		"log": common.MapStr{
+	}
+
+	// Peers of a unix socket are usually unnamed and have no address.
+	if metadata.RemoteAddr != nil && metadata.RemoteAddr.String() != "" {
+		f["log"] = common.MapStr{
 			"source": common.MapStr{
 				"address": metadata.RemoteAddr.String(),
 			},
-		},
+		}
 	}
 
 	syslog := common.MapStr{}
@@ -247,6 +251,20 @@ func createEvent(ev *event, metadata inputsource.NetworkMetadata, timezone *time
 		}
 	}
 
+	if ev.Version() > 0 {
+		syslog["version"] = ev.Version()
+
+		if ev.ProcID() != "" && !ev.HasPid() {
+			syslog["procid"] = ev.ProcID()
+		}
+		if ev.MsgID() != "" {
+			syslog["msgid"] = ev.MsgID()
+		}
+		if len(ev.StructuredData()) > 0 {
+			syslog["structured_data"] = ev.StructuredData()
+		}
+	}
+
 	f["syslog"] = syslog
 	f["event"] = event
 	f["process"] = process
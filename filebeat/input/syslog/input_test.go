@@ -200,6 +200,30 @@ func TestSequence(t *testing.T) {
 	})
 }
 
+func TestRFC5424Fields(t *testing.T) {
+	e := newEvent()
+	ParseMessage([]byte(`<165>1 2003-10-11T22:14:15.003Z mymachine evntslog worker-1 ID47 [origin@1 ip="192.0.2.1"] hello`), e)
+	event := createEvent(e, inputsource.NetworkMetadata{}, time.Local, logp.NewLogger("syslog"))
+
+	syslog, err := event.GetValue("syslog")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, common.MapStr{
+		"facility":        20,
+		"facility_label":  "local4",
+		"severity_label":  "Notice",
+		"priority":        165,
+		"version":         1,
+		"procid":          "worker-1",
+		"msgid":           "ID47",
+		"structured_data": common.MapStr{"origin@1": common.MapStr{"ip": "192.0.2.1"}},
+	}, syslog)
+
+	_, err = event.GetValue("log.source.address")
+	assert.Error(t, err)
+}
+
 func dummyMetadata() inputsource.NetworkMetadata {
 	ip := "127.0.0.1"
 	parsedIP := net.ParseIP(ip)
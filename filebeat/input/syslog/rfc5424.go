@@ -0,0 +1,256 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const nilValue = '-'
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+var (
+	errInvalidHeader         = errors.New("invalid RFC5424 header")
+	errInvalidTimestamp      = errors.New("invalid RFC5424 timestamp")
+	errInvalidStructuredData = errors.New("invalid RFC5424 structured data")
+)
+
+// ParseMessage parses a syslog message, the format is detected for every
+// message. Messages with a version after the priority are parsed as RFC5424,
+// everything else as BSD (RFC3164).
+func ParseMessage(data []byte, ev *event) {
+	if isRFC5424(data) {
+		ParseRFC5424(data, ev)
+		return
+	}
+	Parse(data, ev)
+}
+
+// isRFC5424 returns true if the message starts with a priority directly
+// followed by a version number and a space, e.g. `<34>1 `.
+func isRFC5424(data []byte) bool {
+	if len(data) == 0 || data[0] != '<' {
+		return false
+	}
+
+	end := bytes.IndexByte(data, '>')
+	if end < 2 || end > 4 {
+		return false
+	}
+
+	digits := 0
+	for _, c := range data[end+1:] {
+		if c == ' ' {
+			return digits > 0
+		}
+		if c < '0' || c > '9' || digits == 2 {
+			return false
+		}
+		digits++
+	}
+	return false
+}
+
+// ParseRFC5424 parses a RFC5424 syslog message:
+//
+//	<PRI>VERSION SP TIMESTAMP SP HOSTNAME SP APP-NAME SP PROCID SP MSGID SP STRUCTURED-DATA [SP MSG]
+//
+// The fields of the header are separated by a single space and the nil value
+// `-` marks absent fields. The event is left invalid when the message can't
+// be parsed.
+func ParseRFC5424(data []byte, ev *event) {
+	if err := parseRFC5424(data, ev); err != nil {
+		ev.day = -1
+		ev.message = ""
+	}
+}
+
+func parseRFC5424(data []byte, ev *event) error {
+	end := bytes.IndexByte(data, '>')
+	if end < 2 || data[0] != '<' || !isDigits(data[1:end]) {
+		return errInvalidHeader
+	}
+	ev.SetPriority(data[1:end])
+	data = data[end+1:]
+
+	// VERSION, TIMESTAMP, HOSTNAME, APP-NAME, PROCID and MSGID
+	var header [6][]byte
+	for i := range header {
+		var ok bool
+		if header[i], data, ok = nextField(data); !ok {
+			return errInvalidHeader
+		}
+	}
+
+	ev.SetVersion(header[0])
+	if err := ev.setRFC3339Timestamp(header[1]); err != nil {
+		return err
+	}
+	if !isNil(header[2]) {
+		ev.SetHostname(header[2])
+	}
+	if !isNil(header[3]) {
+		ev.SetProgram(header[3])
+	}
+	if !isNil(header[4]) {
+		ev.SetProcID(header[4])
+	}
+	if !isNil(header[5]) {
+		ev.SetMsgID(header[5])
+	}
+
+	sd, rest, err := parseStructuredData(data)
+	if err != nil {
+		return err
+	}
+	ev.structuredData = sd
+
+	if len(rest) > 0 {
+		if rest[0] != ' ' {
+			return errInvalidStructuredData
+		}
+		ev.SetMessage(bytes.TrimPrefix(rest[1:], utf8BOM))
+	}
+
+	return nil
+}
+
+// nextField returns the next space delimited field.
+func nextField(data []byte) (field, rest []byte, ok bool) {
+	idx := bytes.IndexByte(data, ' ')
+	if idx <= 0 {
+		return nil, nil, false
+	}
+	return data[:idx], data[idx+1:], true
+}
+
+// parseStructuredData parses the SD-ELEMENTs of a message into a map of
+// SD-IDs to their parameters.
+//
+//	[exampleSDID@32473 iut="3" eventSource="Application"][examplePriority@32473 class="high"]
+func parseStructuredData(data []byte) (common.MapStr, []byte, error) {
+	if len(data) == 0 {
+		return nil, data, nil
+	}
+	if data[0] == nilValue {
+		return nil, data[1:], nil
+	}
+
+	sd := common.MapStr{}
+	for len(data) > 0 && data[0] == '[' {
+		data = data[1:]
+
+		idEnd := bytes.IndexAny(data, " ]")
+		if idEnd <= 0 {
+			return nil, nil, errInvalidStructuredData
+		}
+		params := common.MapStr{}
+		sd[string(data[:idEnd])] = params
+		data = data[idEnd:]
+
+		for len(data) > 0 && data[0] == ' ' {
+			data = data[1:]
+
+			nameEnd := bytes.IndexByte(data, '=')
+			if nameEnd <= 0 || len(data) < nameEnd+2 || data[nameEnd+1] != '"' {
+				return nil, nil, errInvalidStructuredData
+			}
+			name := string(data[:nameEnd])
+			data = data[nameEnd+2:]
+
+			value, rest, ok := parseParamValue(data)
+			if !ok {
+				return nil, nil, errInvalidStructuredData
+			}
+			params[name] = value
+			data = rest
+		}
+
+		if len(data) == 0 || data[0] != ']' {
+			return nil, nil, errInvalidStructuredData
+		}
+		data = data[1:]
+	}
+
+	if len(sd) == 0 {
+		return nil, nil, errInvalidStructuredData
+	}
+	return sd, data, nil
+}
+
+// parseParamValue reads a PARAM-VALUE up to the closing quote, unescaping
+// `\"`, `\\` and `\]`.
+func parseParamValue(data []byte) (string, []byte, bool) {
+	var buf []byte
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			if i+1 < len(data) && (data[i+1] == '"' || data[i+1] == '\\' || data[i+1] == ']') {
+				i++
+			}
+		case '"':
+			return string(buf), data[i+1:], true
+		}
+		buf = append(buf, data[i])
+	}
+	return "", nil, false
+}
+
+// setRFC3339Timestamp sets the date, time and timezone of the event. Messages
+// without a timestamp get the current time.
+func (s *event) setRFC3339Timestamp(b []byte) error {
+	t := time.Now()
+	if !isNil(b) {
+		var err error
+		t, err = time.Parse(time.RFC3339Nano, string(b))
+		if err != nil {
+			return errInvalidTimestamp
+		}
+	}
+
+	s.year = t.Year()
+	s.month = t.Month()
+	s.day = t.Day()
+	s.hour = t.Hour()
+	s.minute = t.Minute()
+	s.second = t.Second()
+	s.nanosecond = t.Nanosecond()
+	s.loc = t.Location()
+	return nil
+}
+
+func isNil(b []byte) bool {
+	return len(b) == 1 && b[0] == nilValue
+}
+
+func isDigits(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestIsRFC5424(t *testing.T) {
+	tests := map[string]bool{
+		"<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 - msg": true,
+		"<165>12 - - - - - -":                      true,
+		"<34>Oct 11 22:14:15 mymachine su: msg":    false,
+		"<34>123 is not a version":                 false,
+		"<34>1":                                    false,
+		"Oct 11 22:14:15 mymachine su: no prio 1 ": false,
+		"": false,
+	}
+
+	for message, expected := range tests {
+		t.Run(message, func(t *testing.T) {
+			assert.Equal(t, expected, isRFC5424([]byte(message)))
+		})
+	}
+}
+
+func TestParseRFC5424(t *testing.T) {
+	tests := []struct {
+		title    string
+		log      string
+		check    func(t *testing.T, ev *event)
+		expected time.Time
+	}{
+		{
+			title:    "RFC5424 example without structured data",
+			log:      "<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ID47 - 'su root' failed for lonvick on /dev/pts/8",
+			expected: time.Date(2003, 10, 11, 22, 14, 15, 3*int(time.Millisecond), time.UTC),
+			check: func(t *testing.T, ev *event) {
+				assert.Equal(t, 34, ev.Priority())
+				assert.Equal(t, 1, ev.Version())
+				assert.Equal(t, "mymachine.example.com", ev.Hostname())
+				assert.Equal(t, "su", ev.Program())
+				assert.Equal(t, "", ev.ProcID())
+				assert.Equal(t, "ID47", ev.MsgID())
+				assert.Nil(t, ev.StructuredData())
+				assert.Equal(t, "'su root' failed for lonvick on /dev/pts/8", ev.Message())
+			},
+		},
+		{
+			title:    "RFC5424 example with timezone and BOM",
+			log:      "<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - - \xEF\xBB\xBF%% It's time to make the do-nuts.",
+			expected: time.Date(2003, 8, 24, 12, 14, 15, 3000, time.UTC),
+			check: func(t *testing.T, ev *event) {
+				assert.Equal(t, "myproc", ev.Program())
+				assert.Equal(t, 8710, ev.Pid())
+				assert.Equal(t, "%% It's time to make the do-nuts.", ev.Message())
+			},
+		},
+		{
+			title:    "structured data and message",
+			log:      `<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][examplePriority@32473 class="high"] An application event log entry...`,
+			expected: time.Date(2003, 10, 11, 22, 14, 15, 3*int(time.Millisecond), time.UTC),
+			check: func(t *testing.T, ev *event) {
+				assert.Equal(t, common.MapStr{
+					"exampleSDID@32473": common.MapStr{
+						"iut":         "3",
+						"eventSource": "Application",
+						"eventID":     "1011",
+					},
+					"examplePriority@32473": common.MapStr{
+						"class": "high",
+					},
+				}, ev.StructuredData())
+				assert.Equal(t, "An application event log entry...", ev.Message())
+			},
+		},
+		{
+			title:    "structured data without message",
+			log:      `<165>1 2003-10-11T22:14:15Z mymachine.example.com evntslog - ID47 [id@1 escaped="a \"quoted\\ value\]"]`,
+			expected: time.Date(2003, 10, 11, 22, 14, 15, 0, time.UTC),
+			check: func(t *testing.T, ev *event) {
+				assert.Equal(t, common.MapStr{
+					"id@1": common.MapStr{"escaped": `a "quoted\ value]`},
+				}, ev.StructuredData())
+				assert.Equal(t, "", ev.Message())
+			},
+		},
+		{
+			title:    "non numeric procid",
+			log:      "<13>1 2019-01-02T03:04:05.123456+02:00 host app worker-1 - - hello",
+			expected: time.Date(2019, 1, 2, 1, 4, 5, 123456000, time.UTC),
+			check: func(t *testing.T, ev *event) {
+				assert.Equal(t, "worker-1", ev.ProcID())
+				assert.False(t, ev.HasPid())
+				assert.Equal(t, "hello", ev.Message())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			ev := newEvent()
+			ParseMessage([]byte(test.log), ev)
+			if !assert.True(t, ev.IsValid()) {
+				return
+			}
+			assert.Equal(t, test.expected, ev.Timestamp(time.Local))
+			test.check(t, ev)
+		})
+	}
+}
+
+func TestParseRFC5424Invalid(t *testing.T) {
+	tests := map[string]string{
+		"truncated header":       "<34>1 2003-10-11T22:14:15.003Z mymachine su",
+		"invalid timestamp":      "<34>1 2003-10-11 22:14:15 mymachine su - ID47 - msg",
+		"unclosed sd element":    `<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 [id@1 a="b" msg`,
+		"unquoted sd param":      `<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 [id@1 a=b] msg`,
+		"missing space after sd": `<34>1 2003-10-11T22:14:15.003Z mymachine su - ID47 [id@1 a="b"]msg`,
+	}
+
+	for title, log := range tests {
+		t.Run(title, func(t *testing.T) {
+			ev := newEvent()
+			ParseMessage([]byte(log), ev)
+			assert.False(t, ev.IsValid())
+		})
+	}
+}
+
+func TestParseRFC5424InvalidPriority(t *testing.T) {
+	tests := map[string]string{
+		"missing priority": ">1 - - - - - -",
+		"empty priority":   "<>1 - - - - - -",
+		"missing bracket":  "34>1 - - - - - -",
+	}
+
+	for title, log := range tests {
+		t.Run(title, func(t *testing.T) {
+			ev := newEvent()
+			ParseRFC5424([]byte(log), ev)
+			assert.False(t, ev.IsValid())
+		})
+	}
+}
+
+func TestParseMessageFallsBackToRFC3164(t *testing.T) {
+	ev := newEvent()
+	ParseMessage([]byte("<34>Oct 11 22:14:15 mymachine su: 'su root' failed"), ev)
+	assert.True(t, ev.IsValid())
+	assert.Equal(t, 0, ev.Version())
+	assert.Equal(t, "su", ev.Program())
+	assert.Equal(t, "'su root' failed", ev.Message())
+}
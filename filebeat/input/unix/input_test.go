@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/filebeat/inputsource/unix"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func TestCreateEvent(t *testing.T) {
+	message := []byte("hello world")
+
+	data := createEvent(message, inputsource.NetworkMetadata{})
+	event := data.GetEvent()
+
+	m, err := event.GetValue("message")
+	assert.NoError(t, err)
+	assert.Equal(t, string(message), m)
+
+	_, err = event.GetValue("log.source.address")
+	assert.Error(t, err)
+}
+
+func TestCreateEventWithNamedPeer(t *testing.T) {
+	addr := &net.UnixAddr{Name: "/var/run/client.sock", Net: "unixgram"}
+
+	data := createEvent([]byte("hello world"), inputsource.NetworkMetadata{RemoteAddr: addr})
+	event := data.GetEvent()
+
+	from, _ := event.GetValue("log.source.address")
+	assert.Equal(t, "/var/run/client.sock", from)
+}
+
+func TestRunRetriesAfterStartFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unix_input")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the directory of the socket does not exist yet
+	socketDir := filepath.Join(dir, "run")
+	config := defaultConfig
+	config.Path = filepath.Join(socketDir, "filebeat.sock")
+
+	server, err := unix.New(&config.Config, tcp.SplitFunc([]byte("\n")),
+		func([]byte, inputsource.NetworkMetadata) {})
+	require.NoError(t, err)
+
+	p := &Input{
+		server: server,
+		config: &config,
+		log:    logp.NewLogger("unix input"),
+	}
+
+	p.Run()
+	assert.False(t, p.started)
+
+	require.NoError(t, os.Mkdir(socketDir, 0755))
+	p.Run()
+	assert.True(t, p.started)
+	server.Stop()
+}
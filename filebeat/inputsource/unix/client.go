@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"bufio"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/tcp"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// client is a connection accepted on a stream socket.
+type client struct {
+	conn           net.Conn
+	log            *logp.Logger
+	callback       inputsource.NetworkFunc
+	metadata       inputsource.NetworkMetadata
+	splitFunc      bufio.SplitFunc
+	maxMessageSize uint64
+	timeout        time.Duration
+}
+
+func newClient(
+	conn net.Conn,
+	log *logp.Logger,
+	callback inputsource.NetworkFunc,
+	splitFunc bufio.SplitFunc,
+	maxReadMessage uint64,
+	timeout time.Duration,
+) *client {
+	return &client{
+		conn:           conn,
+		log:            log,
+		callback:       callback,
+		splitFunc:      splitFunc,
+		maxMessageSize: maxReadMessage,
+		timeout:        timeout,
+		metadata: inputsource.NetworkMetadata{
+			RemoteAddr: conn.RemoteAddr(),
+		},
+	}
+}
+
+func (c *client) handle() error {
+	r := tcp.NewResetableLimitedReader(tcp.NewDeadlineReader(c.conn, c.timeout), c.maxMessageSize)
+	scanner := bufio.NewScanner(bufio.NewReader(r))
+	scanner.Split(c.splitFunc)
+
+	for scanner.Scan() {
+		r.Reset()
+		c.callback(scanner.Bytes(), c.metadata)
+	}
+
+	if err := scanner.Err(); err != nil {
+		// This is a user defined limit and we should notify the user.
+		if tcp.IsMaxReadBufferErr(err) {
+			c.log.Errorw("client error", "error", err)
+		}
+		return errors.Wrap(err, "unix client error")
+	}
+	return nil
+}
+
+func (c *client) close() {
+	c.conn.Close()
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/cfgtype"
+)
+
+// Name is the human readable name and identifier.
+const Name = "unix"
+
+// SocketType is the type of the Unix socket, stream or datagram.
+type SocketType uint8
+
+const (
+	// StreamSocket is a connection oriented Unix socket (SOCK_STREAM).
+	StreamSocket SocketType = iota
+	// DatagramSocket is a connectionless Unix socket (SOCK_DGRAM).
+	DatagramSocket
+)
+
+var socketTypes = map[string]SocketType{
+	"stream":   StreamSocket,
+	"datagram": DatagramSocket,
+}
+
+// Config exposes the unix configuration.
+type Config struct {
+	Path           string           `config:"path"`
+	Group          *string          `config:"group"`
+	Mode           *string          `config:"mode"`
+	Timeout        time.Duration    `config:"timeout" validate:"nonzero,positive"`
+	MaxMessageSize cfgtype.ByteSize `config:"max_message_size" validate:"nonzero,positive"`
+	SocketType     SocketType       `config:"socket_type"`
+}
+
+// Validate validates the Config option for the unix input.
+func (c *Config) Validate() error {
+	if len(c.Path) == 0 {
+		return fmt.Errorf("need to specify the path to the unix socket")
+	}
+
+	if c.Mode != nil {
+		if _, err := parseFileMode(*c.Mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unpack unpacks the socket type from its name.
+func (s *SocketType) Unpack(value string) error {
+	t, found := socketTypes[value]
+	if !found {
+		return fmt.Errorf("unknown socket type '%s', must be 'stream' or 'datagram'", value)
+	}
+	*s = t
+	return nil
+}
+
+func (s SocketType) String() string {
+	for name, t := range socketTypes {
+		if t == s {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// parseFileMode parses the octal file permissions of the socket file, e.g. "0660".
+func parseFileMode(mode string) (uint32, error) {
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("invalid file mode '%s', must be an octal value like '0660'", mode)
+	}
+	return uint32(m), nil
+}
@@ -0,0 +1,301 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unix
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// Server represents a Unix socket server. In stream mode every connection is
+// read with the configured SplitFunc, in datagram mode every datagram is a
+// single message.
+type Server struct {
+	sync.RWMutex
+	config    *Config
+	callback  inputsource.NetworkFunc
+	splitFunc bufio.SplitFunc
+	listener  net.Listener
+	conn      net.PacketConn
+	clients   map[*client]struct{}
+	wg        sync.WaitGroup
+	done      chan struct{}
+	log       *logp.Logger
+}
+
+// New creates a new unix server. The splitFunc is only used in stream mode.
+func New(
+	config *Config,
+	splitFunc bufio.SplitFunc,
+	callback inputsource.NetworkFunc,
+) (*Server, error) {
+	if config.SocketType == StreamSocket && splitFunc == nil {
+		return nil, fmt.Errorf("SplitFunc can't be empty")
+	}
+
+	return &Server{
+		config:    config,
+		callback:  callback,
+		splitFunc: splitFunc,
+		clients:   make(map[*client]struct{}),
+		done:      make(chan struct{}),
+		log:       logp.NewLogger("unix").With("path", config.Path, "socket_type", config.SocketType),
+	}, nil
+}
+
+// Start creates the socket file and starts receiving data.
+func (s *Server) Start() error {
+	if err := cleanupStaleSocket(s.config.Path); err != nil {
+		return err
+	}
+
+	var err error
+	switch s.config.SocketType {
+	case DatagramSocket:
+		s.conn, err = net.ListenPacket("unixgram", s.config.Path)
+	default:
+		s.listener, err = net.Listen("unix", s.config.Path)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.setSocketOwnership(); err != nil {
+		s.close()
+		return err
+	}
+
+	s.log.Info("Started listening on unix socket")
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if s.conn != nil {
+			s.runDatagram()
+		} else {
+			s.runStream()
+		}
+	}()
+	return nil
+}
+
+// Stop stops receiving data, closes all active clients and removes the socket
+// file.
+func (s *Server) Stop() {
+	s.log.Info("Stopping unix server")
+	close(s.done)
+	s.close()
+	for _, client := range s.allClients() {
+		client.close()
+	}
+	s.wg.Wait()
+	s.log.Info("Unix server stopped")
+}
+
+func (s *Server) close() {
+	if s.listener != nil {
+		// Closing a unix listener removes the socket file.
+		s.listener.Close()
+	}
+	if s.conn != nil {
+		s.conn.Close()
+		os.Remove(s.config.Path)
+	}
+}
+
+// Addr returns the address the server is listening on.
+func (s *Server) Addr() net.Addr {
+	if s.conn != nil {
+		return s.conn.LocalAddr()
+	}
+	return s.listener.Addr()
+}
+
+func (s *Server) runStream() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+				s.log.Debugw("Can not accept the connection", "error", err)
+				continue
+			}
+		}
+
+		client := newClient(
+			conn,
+			s.log,
+			s.callback,
+			s.splitFunc,
+			uint64(s.config.MaxMessageSize),
+			s.config.Timeout,
+		)
+
+		s.wg.Add(1)
+		go func() {
+			defer logp.Recover("recovering from a unix client crash")
+			defer s.wg.Done()
+			defer conn.Close()
+
+			s.registerClient(client)
+			defer s.unregisterClient(client)
+			s.log.Debugw("New client", "total", s.clientsCount())
+
+			if err := client.handle(); err != nil {
+				s.log.Debugw("Client error", "error", err)
+			}
+			s.log.Debugw("Client disconnected", "total", s.clientsCount())
+		}()
+	}
+}
+
+func (s *Server) runDatagram() {
+	for {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		buffer := make([]byte, s.config.MaxMessageSize)
+		s.conn.SetDeadline(time.Now().Add(s.config.Timeout))
+
+		// Datagrams bigger than the buffer are truncated.
+		length, addr, err := s.conn.ReadFrom(buffer)
+		if err != nil {
+			// don't log any deadline events.
+			if e, ok := err.(net.Error); ok && e.Timeout() {
+				continue
+			}
+
+			// Closed network error string will never change in Go 1.X
+			// https://github.com/golang/go/issues/4373
+			if opErr, ok := err.(*net.OpError); ok && strings.Contains(opErr.Err.Error(), "use of closed network connection") {
+				s.log.Info("Connection has been closed")
+				return
+			}
+
+			s.log.Errorf("Error reading from the socket %s", err)
+			continue
+		}
+
+		if length > 0 {
+			s.callback(buffer[:length], inputsource.NetworkMetadata{RemoteAddr: addr})
+		}
+	}
+}
+
+// setSocketOwnership applies the configured group and file mode to the socket
+// file.
+func (s *Server) setSocketOwnership() error {
+	if s.config.Group != nil {
+		gid, err := lookupGID(*s.config.Group)
+		if err != nil {
+			return err
+		}
+		if err := os.Chown(s.config.Path, -1, gid); err != nil {
+			return errors.Wrapf(err, "failed to change the group of the socket file '%s'", s.config.Path)
+		}
+	}
+
+	if s.config.Mode != nil {
+		mode, err := parseFileMode(*s.config.Mode)
+		if err != nil {
+			return err
+		}
+		if err := os.Chmod(s.config.Path, os.FileMode(mode)); err != nil {
+			return errors.Wrapf(err, "failed to change the permissions of the socket file '%s'", s.config.Path)
+		}
+	}
+	return nil
+}
+
+func (s *Server) registerClient(client *client) {
+	s.Lock()
+	defer s.Unlock()
+	s.clients[client] = struct{}{}
+}
+
+func (s *Server) unregisterClient(client *client) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.clients, client)
+}
+
+func (s *Server) allClients() []*client {
+	s.RLock()
+	defer s.RUnlock()
+	currentClients := make([]*client, 0, len(s.clients))
+	for client := range s.clients {
+		currentClients = append(currentClients, client)
+	}
+	return currentClients
+}
+
+func (s *Server) clientsCount() int {
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.clients)
+}
+
+// cleanupStaleSocket removes a socket file left behind by a previous run. It
+// refuses to remove the file if it is not a socket.
+func cleanupStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("file '%s' exists and is not a unix socket", path)
+	}
+	if err := os.Remove(path); err != nil {
+		return errors.Wrapf(err, "failed to remove the stale socket file '%s'", path)
+	}
+	return nil
+}
+
+// lookupGID resolves a group name or numeric group ID.
+func lookupGID(group string) (int, error) {
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to lookup group '%s'", group)
+	}
+	return strconv.Atoi(g.Gid)
+}
@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !windows
+
+package unix
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/tcp"
+)
+
+const maxMessageSize = 20
+const timeout = time.Second * 15
+
+func newTestServer(t *testing.T, config *Config) (*Server, chan string, func()) {
+	dir, err := ioutil.TempDir("", "unix-socket")
+	require.NoError(t, err)
+	if config.Path == "" {
+		config.Path = filepath.Join(dir, "test.sock")
+	}
+
+	ch := make(chan string, 10)
+	fn := func(message []byte, _ inputsource.NetworkMetadata) {
+		ch <- string(message)
+	}
+
+	s, err := New(config, tcp.SplitFunc([]byte("\n")), fn)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+
+	return s, ch, func() {
+		s.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestReceiveEventsFromStreamSocket(t *testing.T) {
+	s, ch, cleanup := newTestServer(t, &Config{
+		MaxMessageSize: maxMessageSize,
+		Timeout:        timeout,
+		SocketType:     StreamSocket,
+	})
+	defer cleanup()
+
+	conn, err := net.Dial("unix", s.config.Path)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("first line\nsecond line\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "first line", <-ch)
+	assert.Equal(t, "second line", <-ch)
+}
+
+func TestReceiveEventsFromDatagramSocket(t *testing.T) {
+	s, ch, cleanup := newTestServer(t, &Config{
+		MaxMessageSize: maxMessageSize,
+		Timeout:        timeout,
+		SocketType:     DatagramSocket,
+	})
+	defer cleanup()
+
+	conn, err := net.Dial("unixgram", s.config.Path)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("Hello world"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world", <-ch)
+
+	_, err = conn.Write([]byte("Hello world not so nice"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello world not so n", <-ch)
+}
+
+func TestSocketFileMode(t *testing.T) {
+	mode := "0640"
+	s, _, cleanup := newTestServer(t, &Config{
+		MaxMessageSize: maxMessageSize,
+		Timeout:        timeout,
+		Mode:           &mode,
+	})
+	defer cleanup()
+
+	info, err := os.Stat(s.config.Path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+}
+
+func TestSocketFileIsRemoved(t *testing.T) {
+	for _, socketType := range []SocketType{StreamSocket, DatagramSocket} {
+		t.Run(socketType.String(), func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "unix-socket")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			config := &Config{
+				Path:           filepath.Join(dir, "test.sock"),
+				MaxMessageSize: maxMessageSize,
+				Timeout:        timeout,
+				SocketType:     socketType,
+			}
+			s, err := New(config, tcp.SplitFunc([]byte("\n")), nil)
+			require.NoError(t, err)
+			require.NoError(t, s.Start())
+			s.Stop()
+
+			_, err = os.Stat(config.Path)
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestReplaceStaleSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unix-socket")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.sock")
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	l.SetUnlinkOnClose(false)
+	l.Close()
+
+	s, err := New(&Config{Path: path, MaxMessageSize: maxMessageSize, Timeout: timeout}, tcp.SplitFunc([]byte("\n")), nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	s.Stop()
+}
+
+func TestRefuseToRemoveRegularFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "not-a-socket")
+	require.NoError(t, err)
+	f.Close()
+	defer os.Remove(f.Name())
+
+	s, err := New(&Config{Path: f.Name(), MaxMessageSize: maxMessageSize, Timeout: timeout}, tcp.SplitFunc([]byte("\n")), nil)
+	require.NoError(t, err)
+	assert.Error(t, s.Start())
+}
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	flock "github.com/theckman/go-flock"
+
+	"github.com/elastic/beats/filebeat/config"
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+// Editor gives access to the registry states while Filebeat is not running.
+// Changes are only written on Save. The states are not modified when the
+// registry is opened.
+type Editor struct {
+	lock    *flock.Flock
+	store   store
+	states  *file.States
+	changed map[string]struct{}
+}
+
+// StateFilter selects registry states. Empty fields match all states.
+type StateFilter struct {
+	Path    string // glob pattern matching the source path
+	InputID string
+}
+
+// OpenEditor locks the registry and loads the states. OpenEditor fails with
+// ErrLocked if Filebeat is running. A read-only editor can not save changes.
+func OpenEditor(cfg config.Registry, readOnly bool) (*Editor, error) {
+	regHome := filepath.Join(paths.Resolve(paths.Data, cfg.Path), "filebeat")
+	if !isDir(regHome) {
+		return nil, fmt.Errorf("no registry found in %v", regHome)
+	}
+
+	lock, err := lockRegistry(regHome)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := openEditor(regHome, cfg.Permissions, readOnly)
+	if err != nil {
+		lock.Unlock()
+		return nil, err
+	}
+	e.lock = lock
+	return e, nil
+}
+
+func openEditor(regHome string, perm os.FileMode, readOnly bool) (*Editor, error) {
+	version, err := readVersion(regHome, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// The registry is edited in the format found on disk. A checkpoint size
+	// of 0 writes all changes of the log backend to a new checkpoint, which
+	// replaces the old checkpoint atomically.
+	var backend string
+	switch version {
+	case jsonVersion:
+		backend = backendJSON
+	case logVersion:
+		backend = backendLog
+	default:
+		return nil, fmt.Errorf("no registry found in %v", regHome)
+	}
+
+	loaded, err := readStates(backend, regHome)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Editor{states: file.NewStates(), changed: map[string]struct{}{}}
+	e.states.SetStates(loaded)
+	if !readOnly {
+		e.store, err = openStore(backend, regHome, perm, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Find returns the states matching the filter.
+func (e *Editor) Find(filter StateFilter) ([]file.State, error) {
+	if filter.Path != "" {
+		if _, err := filepath.Match(filter.Path, ""); err != nil {
+			return nil, fmt.Errorf("invalid path pattern '%v': %v", filter.Path, err)
+		}
+	}
+
+	var found []file.State
+	for _, st := range e.states.GetStates() {
+		if filter.match(st) {
+			found = append(found, st)
+		}
+	}
+	return found, nil
+}
+
+func (f StateFilter) match(st file.State) bool {
+	if f.InputID != "" && f.InputID != st.InputID {
+		return false
+	}
+	if f.Path != "" {
+		matched, _ := filepath.Match(f.Path, st.Source)
+		return matched
+	}
+	return true
+}
+
+// Update replaces a state.
+func (e *Editor) Update(st file.State) {
+	e.states.UpdateWithTs(st, st.Timestamp)
+	e.changed[st.ID()] = struct{}{}
+}
+
+// Remove removes a state.
+func (e *Editor) Remove(st file.State) {
+	e.states.Remove(st.ID())
+	e.changed[st.ID()] = struct{}{}
+}
+
+// Save writes the changed states to the registry.
+func (e *Editor) Save() error {
+	if len(e.changed) == 0 {
+		return nil
+	}
+	if e.store == nil {
+		return errors.New("registry is opened read-only")
+	}
+	if err := e.store.Write(e.states, e.changed); err != nil {
+		return err
+	}
+	e.changed = map[string]struct{}{}
+	return nil
+}
+
+// Close closes the registry and releases the lock. Unsaved changes are lost.
+func (e *Editor) Close() error {
+	var err error
+	if e.store != nil {
+		err = e.store.Close()
+	}
+	if e.lock != nil {
+		e.lock.Unlock()
+	}
+	return err
+}
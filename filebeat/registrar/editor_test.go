@@ -0,0 +1,164 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/config"
+	"github.com/elastic/beats/filebeat/input/file"
+)
+
+func newTestRegistry(t *testing.T, backend string, states ...file.State) config.Registry {
+	home, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+
+	cfg := config.DefaultConfig.Registry
+	cfg.Path = home
+	cfg.Backend = backend
+
+	require.NoError(t, ensureCurrent(home, "", backend, cfg.Permissions))
+	s, err := openStore(backend, filepath.Join(home, "filebeat"), cfg.Permissions, cfg.CheckpointSize)
+	require.NoError(t, err)
+	defer s.Close()
+	writeStates(t, s, file.NewStates(), states...)
+	return cfg
+}
+
+func TestEditorRefusesLockedRegistry(t *testing.T) {
+	cfg := newTestRegistry(t, backendJSON)
+	defer os.RemoveAll(cfg.Path)
+
+	lock, err := lockRegistry(filepath.Join(cfg.Path, "filebeat"))
+	require.NoError(t, err)
+
+	_, err = OpenEditor(cfg, false)
+	assert.Equal(t, ErrLocked, err)
+
+	lock.Unlock()
+	editor, err := OpenEditor(cfg, false)
+	require.NoError(t, err)
+	editor.Close()
+}
+
+func TestEditorFind(t *testing.T) {
+	a, b, c := testState("/var/log/a.log", 10), testState("/var/log/b.log", 20), testState("/tmp/c.log", 30)
+	a.InputID = "app"
+	cfg := newTestRegistry(t, backendJSON, a, b, c)
+	defer os.RemoveAll(cfg.Path)
+
+	editor, err := OpenEditor(cfg, true)
+	require.NoError(t, err)
+	defer editor.Close()
+
+	sources := func(filter StateFilter) []string {
+		states, err := editor.Find(filter)
+		require.NoError(t, err)
+		var sources []string
+		for _, st := range states {
+			sources = append(sources, st.Source)
+		}
+		return sources
+	}
+
+	assert.Len(t, sources(StateFilter{}), 3)
+	assert.ElementsMatch(t, []string{"/var/log/a.log", "/var/log/b.log"}, sources(StateFilter{Path: "/var/log/*"}))
+	assert.Equal(t, []string{"/var/log/a.log"}, sources(StateFilter{InputID: "app"}))
+	assert.Empty(t, sources(StateFilter{Path: "/tmp/*", InputID: "app"}))
+
+	_, err = editor.Find(StateFilter{Path: "[a"})
+	assert.Error(t, err)
+}
+
+func TestEditorSave(t *testing.T) {
+	for _, backend := range []string{backendJSON, backendLog} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			cfg := newTestRegistry(t, backend, testState("a.log", 10), testState("b.log", 20))
+			defer os.RemoveAll(cfg.Path)
+
+			editor, err := OpenEditor(cfg, false)
+			require.NoError(t, err)
+
+			states, err := editor.Find(StateFilter{Path: "a.log"})
+			require.NoError(t, err)
+			require.Len(t, states, 1)
+			states[0].Offset = 0
+			editor.Update(states[0])
+
+			states, err = editor.Find(StateFilter{Path: "b.log"})
+			require.NoError(t, err)
+			require.Len(t, states, 1)
+			editor.Remove(states[0])
+
+			require.NoError(t, editor.Save())
+			require.NoError(t, editor.Close())
+
+			s, err := openStore(backend, filepath.Join(cfg.Path, "filebeat"), cfg.Permissions, cfg.CheckpointSize)
+			require.NoError(t, err)
+			defer s.Close()
+			assert.Equal(t, map[string]int64{"a.log": 0}, loadedOffsets(t, s))
+		})
+	}
+}
+
+func TestEditorReadOnly(t *testing.T) {
+	for _, backend := range []string{backendJSON, backendLog} {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			cfg := newTestRegistry(t, backend, testState("a.log", 10))
+			defer os.RemoveAll(cfg.Path)
+
+			regHome := filepath.Join(cfg.Path, "filebeat")
+			readFiles := func() map[string]string {
+				files := map[string]string{}
+				for _, name := range []string{"data.json", "checkpoint.json", "log.json"} {
+					content, err := ioutil.ReadFile(filepath.Join(regHome, name))
+					if err == nil {
+						files[name] = string(content)
+					}
+				}
+				return files
+			}
+			before := readFiles()
+
+			editor, err := OpenEditor(cfg, true)
+			require.NoError(t, err)
+
+			states, err := editor.Find(StateFilter{})
+			require.NoError(t, err)
+			require.Len(t, states, 1)
+			assert.Equal(t, int64(10), states[0].Offset)
+			assert.Equal(t, time.Duration(-1), states[0].TTL)
+			assert.False(t, states[0].Finished)
+
+			editor.Remove(states[0])
+			assert.Error(t, editor.Save())
+			require.NoError(t, editor.Close())
+
+			assert.Equal(t, before, readFiles())
+		})
+	}
+}
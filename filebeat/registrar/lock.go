@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	flock "github.com/theckman/go-flock"
+)
+
+// ErrLocked is returned if the registry is in use by another process.
+var ErrLocked = errors.New("registry is locked by a running filebeat")
+
+// lockRegistry takes an exclusive lock on the registry directory. The lock is
+// released when the process exits.
+func lockRegistry(regHome string) (*flock.Flock, error) {
+	if err := os.MkdirAll(regHome, 0750); err != nil {
+		return nil, err
+	}
+
+	lock := flock.NewFlock(filepath.Join(regHome, "filebeat.lock"))
+	locked, err := lock.TryLock()
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, ErrLocked
+	}
+	return lock, nil
+}
@@ -31,11 +31,12 @@ import (
 )
 
 const (
-	legacyVersion  = "<legacy>"
-	currentVersion = "0"
+	legacyVersion = "<legacy>"
+	jsonVersion   = "0" // states in data.json
+	logVersion    = "1" // states in checkpoint.json and log.json
 )
 
-func ensureCurrent(home, migrateFile string, perm os.FileMode) error {
+func ensureCurrent(home, migrateFile, backend string, perm os.FileMode) error {
 	if migrateFile == "" {
 		if isFile(home) {
 			migrateFile = home
@@ -52,18 +53,123 @@ func ensureCurrent(home, migrateFile string, perm os.FileMode) error {
 
 	switch version {
 	case legacyVersion:
-		return migrateLegacy(home, fbRegHome, migrateFile, perm)
-	case currentVersion:
-		return nil
+		err = migrateLegacy(home, fbRegHome, migrateFile, perm)
+	case jsonVersion, logVersion:
 	case "":
 		backupFile := migrateFile + ".bak"
 		if isFile(backupFile) {
-			return migrateLegacy(home, fbRegHome, backupFile, perm)
+			err = migrateLegacy(home, fbRegHome, backupFile, perm)
+		} else {
+			err = initRegistry(fbRegHome, perm)
 		}
-		return initRegistry(fbRegHome, perm)
 	default:
 		return fmt.Errorf("registry file version %v not supported", version)
 	}
+	if err != nil {
+		return err
+	}
+
+	return migrateBackend(fbRegHome, backend, perm)
+}
+
+// migrateBackend converts the registry to the format of the configured
+// backend. The version in meta.json is updated before the old files are
+// removed, such that an interrupted migration is finished on the next start.
+func migrateBackend(regHome, backend string, perm os.FileMode) error {
+	version, err := readVersion(regHome, "")
+	if err != nil {
+		return err
+	}
+
+	dataFile := filepath.Join(regHome, "data.json")
+	checkpointFile := checkpointFilePath(regHome)
+
+	switch {
+	case backend == backendLog && version == jsonVersion:
+		logp.Info("Migrate registry data file to registry log")
+		if err := writeMeta(regHome, logVersion, perm); err != nil {
+			return err
+		}
+		return migrateJSONToLog(regHome)
+
+	case backend == backendLog && version == logVersion:
+		// data.json is left over from an interrupted migration
+		if isFile(dataFile) && !isFile(checkpointFile) {
+			return migrateJSONToLog(regHome)
+		}
+		if isFile(dataFile) {
+			return os.Remove(dataFile)
+		}
+
+	case backend == backendJSON && version == logVersion:
+		// data.json is left over from an interrupted migration to the log
+		// backend, and still contains the states
+		if isFile(dataFile) && !isFile(checkpointFile) {
+			logp.Info("Revert interrupted migration to registry log")
+			return revertJSONToLog(regHome, perm)
+		}
+		logp.Info("Migrate registry log to registry data file")
+		return migrateLogToJSON(regHome, perm)
+	}
+
+	return nil
+}
+
+// migrateJSONToLog uses data.json as checkpoint of the log backend. Both use
+// the same format.
+func migrateJSONToLog(regHome string) error {
+	logFile := logFilePath(regHome)
+	if isFile(logFile) {
+		if err := os.Remove(logFile); err != nil {
+			return err
+		}
+	}
+
+	dataFile := filepath.Join(regHome, "data.json")
+	if !isFile(dataFile) {
+		return nil
+	}
+	return helper.SafeFileRotate(checkpointFilePath(regHome), dataFile)
+}
+
+// revertJSONToLog restores the version of a registry whose migration to the
+// log backend was interrupted before data.json was moved.
+func revertJSONToLog(regHome string, perm os.FileMode) error {
+	if err := writeMeta(regHome, jsonVersion, perm); err != nil {
+		return err
+	}
+
+	logFile := logFilePath(regHome)
+	if err := os.Remove(logFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func migrateLogToJSON(regHome string, perm os.FileMode) error {
+	store, err := openLogStore(regHome, perm, 1)
+	if err != nil {
+		return err
+	}
+	states, err := store.Load()
+	store.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := writeStatesFile(filepath.Join(regHome, "data.json"), perm, states); err != nil {
+		return err
+	}
+	if err := writeMeta(regHome, jsonVersion, perm); err != nil {
+		return err
+	}
+
+	for _, path := range []string{checkpointFilePath(regHome), logFilePath(regHome)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
 }
 
 func migrateLegacy(home, regHome, migrateFile string, perm os.FileMode) error {
@@ -119,6 +225,17 @@ func initRegistry(regHome string, perm os.FileMode) error {
 	return nil
 }
 
+// writeMeta atomically replaces the registry meta file.
+func writeMeta(regHome, version string, perm os.FileMode) error {
+	metaFile := filepath.Join(regHome, "meta.json")
+	tmpFile := metaFile + ".new"
+	err := safeWriteFile(tmpFile, []byte(fmt.Sprintf(`{"version": "%v"}`, version)), perm)
+	if err != nil {
+		return errors.Wrap(err, "failed writing registry meta.json")
+	}
+	return helper.SafeFileRotate(metaFile, tmpFile)
+}
+
 func readVersion(regHome, migrateFile string) (string, error) {
 	if isFile(migrateFile) {
 		return legacyVersion, nil
@@ -26,25 +26,27 @@ import (
 	"sync"
 	"time"
 
+	flock "github.com/theckman/go-flock"
+
 	"github.com/elastic/beats/filebeat/config"
 	"github.com/elastic/beats/filebeat/input/file"
-	helper "github.com/elastic/beats/libbeat/common/file"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 	"github.com/elastic/beats/libbeat/paths"
 )
 
 type Registrar struct {
-	Channel      chan []file.State
-	out          successLogger
-	done         chan struct{}
-	registryFile string      // Path to the Registry File
-	fileMode     os.FileMode // Permissions to apply on the Registry File
-	wg           sync.WaitGroup
-
-	states               *file.States // Map with all file paths inside and the corresponding state
-	gcRequired           bool         // gcRequired is set if registry state needs to be gc'ed before the next write
-	gcEnabled            bool         // gcEnabled indicates the registry contains some state that can be gc'ed in the future
+	Channel chan []file.State
+	out     successLogger
+	done    chan struct{}
+	store   store        // Backend persisting the states
+	lock    *flock.Flock // Lock preventing other processes from using the registry
+	wg      sync.WaitGroup
+
+	states               *file.States        // Map with all file paths inside and the corresponding state
+	changed              map[string]struct{} // IDs of the states updated or removed since the last write
+	gcRequired           bool                // gcRequired is set if registry state needs to be gc'ed before the next write
+	gcEnabled            bool                // gcEnabled indicates the registry contains some state that can be gc'ed in the future
 	flushTimeout         time.Duration
 	bufferedStateUpdates int
 }
@@ -71,60 +73,58 @@ func New(cfg config.Registry, out successLogger) (*Registrar, error) {
 		migrateFile = paths.Resolve(paths.Data, migrateFile)
 	}
 
-	err := ensureCurrent(home, migrateFile, cfg.Permissions)
-	if err != nil {
-		return nil, err
+	backend := cfg.Backend
+	if backend == "" {
+		backend = backendJSON
 	}
-
-	dataFile := filepath.Join(home, "filebeat", "data.json")
-	r := &Registrar{
-		registryFile: dataFile,
-		fileMode:     cfg.Permissions,
-		done:         make(chan struct{}),
-		states:       file.NewStates(),
-		Channel:      make(chan []file.State, 1),
-		flushTimeout: cfg.FlushTimeout,
-		out:          out,
-		wg:           sync.WaitGroup{},
+	if backend != backendJSON && backend != backendLog {
+		return nil, fmt.Errorf("unknown registry backend: %v", backend)
 	}
-	return r, r.Init()
-}
-
-// Init sets up the Registrar and make sure the registry file is setup correctly
-func (r *Registrar) Init() error {
-	// The registry file is opened in the data path
-	r.registryFile = paths.Resolve(paths.Data, r.registryFile)
 
-	// Create directory if it does not already exist.
-	registryPath := filepath.Dir(r.registryFile)
-	err := os.MkdirAll(registryPath, 0750)
-	if err != nil {
-		return fmt.Errorf("Failed to created registry file dir %s: %v", registryPath, err)
+	// Lock the registry before migrating it. A legacy registry file stored at
+	// the registry home path must be migrated first, in order to create the
+	// registry directory holding the lock file.
+	regHome := filepath.Join(home, "filebeat")
+	var lock *flock.Flock
+	if !isFile(home) {
+		var err error
+		if lock, err = lockRegistry(regHome); err != nil {
+			return nil, err
+		}
 	}
 
-	// Check if files exists
-	fileInfo, err := os.Lstat(r.registryFile)
-	if os.IsNotExist(err) {
-		logp.Info("No registry file found under: %s. Creating a new registry file.", r.registryFile)
-		// No registry exists yet, write empty state to check if registry can be written
-		return r.writeRegistry()
-	}
+	err := ensureCurrent(home, migrateFile, backend, cfg.Permissions)
 	if err != nil {
-		return err
+		if lock != nil {
+			lock.Unlock()
+		}
+		return nil, err
 	}
 
-	// Check if regular file, no dir, no symlink
-	if !fileInfo.Mode().IsRegular() {
-		// Special error message for directory
-		if fileInfo.IsDir() {
-			return fmt.Errorf("Registry file path must be a file. %s is a directory.", r.registryFile)
+	if lock == nil {
+		if lock, err = lockRegistry(regHome); err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("Registry file path is not a regular file: %s", r.registryFile)
 	}
 
-	logp.Debug("registrar", "Registry file set to: %s", r.registryFile)
+	store, err := openStore(backend, regHome, cfg.Permissions, cfg.CheckpointSize)
+	if err != nil {
+		lock.Unlock()
+		return nil, err
+	}
 
-	return nil
+	r := &Registrar{
+		store:        store,
+		lock:         lock,
+		done:         make(chan struct{}),
+		states:       file.NewStates(),
+		changed:      map[string]struct{}{},
+		Channel:      make(chan []file.State, 1),
+		flushTimeout: cfg.FlushTimeout,
+		out:          out,
+		wg:           sync.WaitGroup{},
+	}
+	return r, nil
 }
 
 // GetStates return the registrar states
@@ -132,19 +132,10 @@ func (r *Registrar) GetStates() []file.State {
 	return r.states.GetStates()
 }
 
-// loadStates fetches the previous reading state from the configure RegistryFile file
-// The default file is `registry` in the data path.
+// loadStates fetches the previous reading state from the registry store
+// The default directory is `registry` in the data path.
 func (r *Registrar) loadStates() error {
-	f, err := os.Open(r.registryFile)
-	if err != nil {
-		return err
-	}
-
-	defer f.Close()
-
-	logp.Info("Loading registrar data from %s", r.registryFile)
-
-	states, err := readStatesFrom(f)
+	states, err := r.store.Load()
 	if err != nil {
 		return err
 	}
@@ -155,14 +146,22 @@ func (r *Registrar) loadStates() error {
 }
 
 func readStatesFrom(in io.Reader) ([]file.State, error) {
+	states, err := decodeStates(in)
+	if err != nil {
+		return nil, err
+	}
+
+	states = fixStates(states)
+	states = resetStates(states)
+	return states, nil
+}
+
+func decodeStates(in io.Reader) ([]file.State, error) {
 	states := []file.State{}
 	decoder := json.NewDecoder(in)
 	if err := decoder.Decode(&states); err != nil {
 		return nil, fmt.Errorf("Error decoding states: %s", err)
 	}
-
-	states = fixStates(states)
-	states = resetStates(states)
 	return states, nil
 }
 
@@ -213,6 +212,11 @@ func fixState(st *file.State) {
 // The st state is overwritten with the updated fields.
 func mergeStates(st, other *file.State) {
 	st.Finished = st.Finished || other.Finished
+	st.EOF = st.EOF || other.EOF
+	st.Compressed = st.Compressed || other.Compressed
+	if st.Fingerprint == "" {
+		st.Fingerprint = other.Fingerprint
+	}
 	if st.Offset < other.Offset { // always select the higher offset
 		st.Offset = other.Offset
 	}
@@ -222,6 +226,8 @@ func mergeStates(st, other *file.State) {
 	var meta, metaOld, metaNew map[string]string
 	if st.Timestamp.Before(other.Timestamp) {
 		st.Source = other.Source
+		st.Cursor = other.Cursor
+		st.InputID = other.InputID
 		st.Timestamp = other.Timestamp
 		st.TTL = other.TTL
 		st.FileStateOS = other.FileStateOS
@@ -265,6 +271,8 @@ func (r *Registrar) Start() error {
 	// Load the previous log file locations now, for use in input
 	err := r.loadStates()
 	if err != nil {
+		r.store.Close()
+		r.lock.Unlock()
 		return fmt.Errorf("Error loading state: %v", err)
 	}
 
@@ -279,6 +287,8 @@ func (r *Registrar) Run() {
 	// Writes registry on shutdown
 	defer func() {
 		r.writeRegistry()
+		r.store.Close()
+		r.lock.Unlock()
 		r.wg.Done()
 	}()
 
@@ -340,7 +350,9 @@ func (r *Registrar) gcStates() {
 	}
 
 	beforeCount := r.states.Count()
-	cleanedStates, pendingClean := r.states.Cleanup()
+	cleanedStates, pendingClean := r.states.CleanupWith(func(id string) {
+		r.changed[id] = struct{}{}
+	})
 	statesCleanup.Add(int64(cleanedStates))
 
 	logp.Debug("registrar",
@@ -358,6 +370,7 @@ func (r *Registrar) processEventStates(states []file.State) {
 	ts := time.Now()
 	for i := range states {
 		r.states.UpdateWithTs(states[i], ts)
+		r.changed[states[i].ID()] = struct{}{}
 		statesUpdate.Add(1)
 	}
 }
@@ -380,28 +393,24 @@ func (r *Registrar) flushRegistry() {
 	r.bufferedStateUpdates = 0
 }
 
-// writeRegistry writes the new json registry file to disk.
+// writeRegistry writes the states to the registry store.
 func (r *Registrar) writeRegistry() error {
 	// First clean up states
 	r.gcStates()
-	states := r.states.GetStates()
-	statesCurrent.Set(int64(len(states)))
+	count := r.states.Count()
+	statesCurrent.Set(int64(count))
 
 	registryWrites.Inc()
 
-	tempfile, err := writeTmpFile(r.registryFile, r.fileMode, states)
-	if err != nil {
-		registryFails.Inc()
-		return err
-	}
-
-	err = helper.SafeFileRotate(r.registryFile, tempfile)
+	changed := len(r.changed)
+	err := r.store.Write(r.states, r.changed)
 	if err != nil {
 		registryFails.Inc()
 		return err
 	}
+	r.changed = map[string]struct{}{}
 
-	logp.Debug("registrar", "Registry file updated. %d states written.", len(states))
+	logp.Debug("registrar", "Registry updated. %d states, %d changed states written.", count, changed)
 	registrySuccess.Inc()
 
 	return nil
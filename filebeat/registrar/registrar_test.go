@@ -18,6 +18,9 @@
 package registrar
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -25,7 +28,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/elastic/beats/filebeat/config"
 	"github.com/elastic/beats/filebeat/input/file"
 )
 
@@ -187,3 +192,45 @@ func sortedStates(states []file.State) []file.State {
 	})
 	return tmp
 }
+
+func TestRegistrarLocksBeforeMigration(t *testing.T) {
+	home, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	cfg := config.DefaultConfig.Registry
+	cfg.Path = home
+
+	lock, err := lockRegistry(filepath.Join(home, "filebeat"))
+	require.NoError(t, err)
+
+	_, err = New(cfg, nil)
+	assert.Equal(t, ErrLocked, err)
+	assert.False(t, isFile(filepath.Join(home, "filebeat", "meta.json")))
+
+	lock.Unlock()
+	r, err := New(cfg, nil)
+	require.NoError(t, err)
+	require.NoError(t, r.Start())
+	r.Stop()
+}
+
+func TestRegistrarUnlocksOnStartFailure(t *testing.T) {
+	home, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	cfg := config.DefaultConfig.Registry
+	cfg.Path = home
+	cfg.Backend = backendJSON
+
+	r, err := New(cfg, nil)
+	require.NoError(t, err)
+	dataFile := filepath.Join(home, "filebeat", "data.json")
+	require.NoError(t, ioutil.WriteFile(dataFile, []byte("{invalid"), 0600))
+	assert.Error(t, r.Start())
+
+	lock, err := lockRegistry(filepath.Join(home, "filebeat"))
+	require.NoError(t, err)
+	lock.Unlock()
+}
@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	helper "github.com/elastic/beats/libbeat/common/file"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// Supported registry backends.
+const (
+	backendJSON = "json"
+	backendLog  = "log"
+)
+
+// store persists the registry states.
+type store interface {
+	// Load reads the persisted states. All states returned are finished.
+	Load() ([]file.State, error)
+
+	// Write persists the states. changed contains the IDs of the states
+	// updated or removed since the last call to Write.
+	Write(states *file.States, changed map[string]struct{}) error
+
+	// Close closes the store. The states must be written before.
+	Close() error
+}
+
+// openStore opens the store of the configured backend in the registry
+// directory.
+func openStore(backend, regHome string, perm os.FileMode, checkpointSize int) (store, error) {
+	switch backend {
+	case backendJSON:
+		return openJSONStore(filepath.Join(regHome, "data.json"), perm)
+	case backendLog:
+		return openLogStore(regHome, perm, checkpointSize)
+	default:
+		return nil, fmt.Errorf("unknown registry backend: %v", backend)
+	}
+}
+
+// readStates reads the states persisted by the backend without modifying the
+// registry files. Unlike Load, the states are returned as persisted.
+func readStates(backend, regHome string) ([]file.State, error) {
+	switch backend {
+	case backendJSON:
+		f, err := os.Open(filepath.Join(regHome, "data.json"))
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		states, err := decodeStates(f)
+		if err != nil {
+			return nil, err
+		}
+		return fixStates(states), nil
+	case backendLog:
+		states, err := readLogStates(checkpointFilePath(regHome), logFilePath(regHome))
+		if err != nil {
+			return nil, err
+		}
+		return fixStates(states.GetStates()), nil
+	default:
+		return nil, fmt.Errorf("unknown registry backend: %v", backend)
+	}
+}
+
+// jsonStore writes all states to a single JSON file on every write.
+type jsonStore struct {
+	path string
+	perm os.FileMode
+}
+
+func openJSONStore(path string, perm os.FileMode) (*jsonStore, error) {
+	s := &jsonStore{path: path, perm: perm}
+
+	// Create directory if it does not already exist.
+	registryPath := filepath.Dir(path)
+	err := os.MkdirAll(registryPath, 0750)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to created registry file dir %s: %v", registryPath, err)
+	}
+
+	// Check if files exists
+	fileInfo, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		logp.Info("No registry file found under: %s. Creating a new registry file.", path)
+		// No registry exists yet, write empty state to check if registry can be written
+		return s, s.Write(file.NewStates(), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if regular file, no dir, no symlink
+	if !fileInfo.Mode().IsRegular() {
+		// Special error message for directory
+		if fileInfo.IsDir() {
+			return nil, fmt.Errorf("Registry file path must be a file. %s is a directory.", path)
+		}
+		return nil, fmt.Errorf("Registry file path is not a regular file: %s", path)
+	}
+
+	logp.Debug("registrar", "Registry file set to: %s", path)
+	return s, nil
+}
+
+func (s *jsonStore) Load() ([]file.State, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	logp.Info("Loading registrar data from %s", s.path)
+	return readStatesFrom(f)
+}
+
+func (s *jsonStore) Write(states *file.States, _ map[string]struct{}) error {
+	return writeStatesFile(s.path, s.perm, states.GetStates())
+}
+
+func (s *jsonStore) Close() error { return nil }
+
+// writeStatesFile atomically replaces the file with the JSON encoded states.
+func writeStatesFile(path string, perm os.FileMode, states []file.State) error {
+	tempfile, err := writeTmpFile(path, perm, states)
+	if err != nil {
+		return err
+	}
+	return helper.SafeFileRotate(path, tempfile)
+}
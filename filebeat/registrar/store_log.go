@@ -0,0 +1,227 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+const (
+	logOpSet    = "set"
+	logOpRemove = "remove"
+)
+
+// logStore appends the changed states to a log file on every write. Once the
+// log contains checkpointSize entries, all states are written to the
+// checkpoint file and the log is truncated. Loading the store reads the
+// checkpoint and replays the log.
+type logStore struct {
+	checkpointFile string
+	logFile        string
+	perm           os.FileMode
+	checkpointSize int
+
+	log     *os.File
+	entries int // number of entries in the log since the last checkpoint
+
+	// failed is set if writing to the log failed. The log can end with an
+	// incomplete entry, such that a checkpoint is written before appending
+	// again.
+	failed bool
+}
+
+type logEntry struct {
+	Op    string      `json:"op"`
+	ID    string      `json:"id"`
+	State *file.State `json:"state,omitempty"`
+}
+
+func openLogStore(regHome string, perm os.FileMode, checkpointSize int) (*logStore, error) {
+	if err := os.MkdirAll(regHome, 0750); err != nil {
+		return nil, errors.Wrapf(err, "failed to create registry dir '%v'", regHome)
+	}
+
+	f, err := os.OpenFile(logFilePath(regHome), os.O_RDWR|os.O_CREATE|os.O_APPEND, perm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open registry log")
+	}
+
+	return &logStore{
+		checkpointFile: checkpointFilePath(regHome),
+		logFile:        logFilePath(regHome),
+		perm:           perm,
+		checkpointSize: checkpointSize,
+		log:            f,
+	}, nil
+}
+
+func checkpointFilePath(regHome string) string { return filepath.Join(regHome, "checkpoint.json") }
+func logFilePath(regHome string) string        { return filepath.Join(regHome, "log.json") }
+
+// Load reads the checkpoint and replays the log. The loaded states are
+// written to a new checkpoint, such that an incomplete entry at the end of the
+// log, written when the process was killed, is dropped.
+func (s *logStore) Load() ([]file.State, error) {
+	logp.Info("Loading registrar data from %s and %s", s.checkpointFile, s.logFile)
+
+	states, err := readLogStates(s.checkpointFile, s.logFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkpoint(states); err != nil {
+		return nil, err
+	}
+
+	return resetStates(fixStates(states.GetStates())), nil
+}
+
+// readLogStates reads the checkpoint and replays the log, without modifying
+// the registry files.
+func readLogStates(checkpointFile, logFile string) (*file.States, error) {
+	states := file.NewStates()
+	if isFile(checkpointFile) {
+		f, err := os.Open(checkpointFile)
+		if err != nil {
+			return nil, err
+		}
+		checkpoint, err := decodeStates(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		states.SetStates(checkpoint)
+	}
+
+	f, err := os.Open(logFile)
+	if os.IsNotExist(err) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := replayLog(f, logFile, states); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+// replayLog applies the log entries to states. Invalid entries, like an
+// incomplete entry written when the process was killed, are skipped.
+func replayLog(in io.Reader, logFile string, states *file.States) error {
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			replayEntry(line, logFile, states)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func replayEntry(line []byte, logFile string, states *file.States) {
+	var entry logEntry
+	if err := json.Unmarshal(line, &entry); err != nil {
+		logp.Warn("Ignoring invalid entry in the registry log %s: %v", logFile, err)
+		return
+	}
+
+	switch entry.Op {
+	case logOpSet:
+		if entry.State != nil {
+			states.UpdateWithTs(*entry.State, entry.State.Timestamp)
+		}
+	case logOpRemove:
+		states.Remove(entry.ID)
+	}
+}
+
+func (s *logStore) Write(states *file.States, changed map[string]struct{}) error {
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if s.failed || s.entries+len(changed) > s.checkpointSize {
+		return s.checkpoint(states)
+	}
+
+	if err := s.append(states, changed); err != nil {
+		s.failed = true
+		return err
+	}
+	s.entries += len(changed)
+	return nil
+}
+
+func (s *logStore) append(states *file.States, changed map[string]struct{}) error {
+	w := bufio.NewWriter(s.log)
+	enc := json.NewEncoder(w)
+	for id := range changed {
+		entry := logEntry{Op: logOpRemove, ID: id}
+		if state, found := states.Lookup(id); found {
+			entry = logEntry{Op: logOpSet, ID: id, State: &state}
+		}
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return s.log.Sync()
+}
+
+// checkpoint writes all states to the checkpoint file and truncates the log.
+func (s *logStore) checkpoint(states *file.States) error {
+	logp.Debug("registrar", "Write registry checkpoint: %s", s.checkpointFile)
+
+	if err := writeStatesFile(s.checkpointFile, s.perm, states.GetStates()); err != nil {
+		return err
+	}
+
+	// Replaying the log on top of the new checkpoint results in the same
+	// states, in case the process is killed before the log is truncated.
+	if err := s.log.Truncate(0); err != nil {
+		return err
+	}
+	s.entries = 0
+	s.failed = false
+	return nil
+}
+
+func (s *logStore) Close() error {
+	return s.log.Close()
+}
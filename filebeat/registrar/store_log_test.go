@@ -0,0 +1,290 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/filebeat/input/file"
+)
+
+func testState(name string, offset int64) file.State {
+	return file.State{
+		Source:    name,
+		Offset:    offset,
+		Timestamp: time.Now(),
+		TTL:       -1,
+		Type:      "log",
+		// the meta data makes the ID unique without relying on the file system
+		Meta: map[string]string{"name": name},
+	}
+}
+
+func loadedOffsets(t *testing.T, s store) map[string]int64 {
+	states, err := s.Load()
+	require.NoError(t, err)
+
+	offsets := map[string]int64{}
+	for _, st := range states {
+		assert.True(t, st.Finished)
+		offsets[st.Source] = st.Offset
+	}
+	return offsets
+}
+
+func writeStates(t *testing.T, s store, states *file.States, updates ...file.State) {
+	changed := map[string]struct{}{}
+	for _, st := range updates {
+		states.Update(st)
+		changed[st.ID()] = struct{}{}
+	}
+	require.NoError(t, s.Write(states, changed))
+}
+
+func TestLogStoreReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+
+	states := file.NewStates()
+	writeStates(t, s, states, testState("a.log", 10), testState("b.log", 20))
+	writeStates(t, s, states, testState("a.log", 30))
+
+	removed := testState("b.log", 0)
+	states.Remove(removed.ID())
+	require.NoError(t, s.Write(states, map[string]struct{}{removed.ID(): {}}))
+	require.NoError(t, s.Close())
+
+	// only the changed states have been appended, no checkpoint was written
+	assert.False(t, isFile(checkpointFilePath(dir)))
+
+	s, err = openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, map[string]int64{"a.log": 30}, loadedOffsets(t, s))
+}
+
+func TestLogStoreCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := openLogStore(dir, 0600, 2)
+	require.NoError(t, err)
+
+	states := file.NewStates()
+	writeStates(t, s, states, testState("a.log", 10), testState("b.log", 20))
+	writeStates(t, s, states, testState("c.log", 30))
+	require.NoError(t, s.Close())
+
+	assert.True(t, isFile(checkpointFilePath(dir)))
+	info, err := os.Stat(logFilePath(dir))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+
+	s, err = openLogStore(dir, 0600, 2)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, map[string]int64{"a.log": 10, "b.log": 20, "c.log": 30}, loadedOffsets(t, s))
+}
+
+func TestLogStoreIgnoresIncompleteEntry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	writeStates(t, s, file.NewStates(), testState("a.log", 10))
+	require.NoError(t, s.Close())
+
+	f, err := os.OpenFile(logFilePath(dir), os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"op":"set","id":"x","state":{"sour`)
+	require.NoError(t, err)
+	f.Close()
+
+	s, err = openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 10}, loadedOffsets(t, s))
+
+	// entries written after loading are not hidden behind the incomplete entry
+	writeStates(t, s, file.NewStates(), testState("b.log", 20))
+	require.NoError(t, s.Close())
+
+	s, err = openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, map[string]int64{"a.log": 10, "b.log": 20}, loadedOffsets(t, s))
+}
+
+func TestLogStoreSkipsInvalidEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	writeStates(t, s, file.NewStates(), testState("a.log", 10))
+
+	// an incomplete entry followed by valid entries
+	_, err = s.log.WriteString(`{"op":"set","id":"x","state":{"sour` + "\n")
+	require.NoError(t, err)
+	large := testState("b.log", 20)
+	large.Meta["large"] = strings.Repeat("x", 2<<20)
+	writeStates(t, s, file.NewStates(), large)
+	require.NoError(t, s.Close())
+
+	s, err = openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, map[string]int64{"a.log": 10, "b.log": 20}, loadedOffsets(t, s))
+}
+
+func TestLogStoreCheckpointAfterFailedWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+
+	states := file.NewStates()
+	writeStates(t, s, states, testState("a.log", 10))
+
+	// writing to the log fails
+	log := s.log
+	s.log, err = os.Open(logFilePath(dir))
+	require.NoError(t, err)
+	update := testState("a.log", 20)
+	states.Update(update)
+	assert.Error(t, s.Write(states, map[string]struct{}{update.ID(): {}}))
+	s.log.Close()
+	s.log = log
+
+	// the next write replaces the log with a checkpoint
+	writeStates(t, s, states, testState("b.log", 30))
+	assert.True(t, isFile(checkpointFilePath(dir)))
+	info, err := os.Stat(logFilePath(dir))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+	require.NoError(t, s.Close())
+
+	s, err = openLogStore(dir, 0600, 100)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, map[string]int64{"a.log": 20, "b.log": 30}, loadedOffsets(t, s))
+}
+
+func TestMigrateBackend(t *testing.T) {
+	home, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	regHome := filepath.Join(home, "filebeat")
+
+	// json registry
+	require.NoError(t, ensureCurrent(home, "", backendJSON, 0600))
+	js, err := openStore(backendJSON, regHome, 0600, 100)
+	require.NoError(t, err)
+	states := file.NewStates()
+	writeStates(t, js, states, testState("a.log", 10), testState("b.log", 20))
+
+	// switch to the log backend
+	require.NoError(t, ensureCurrent(home, "", backendLog, 0600))
+	assert.False(t, isFile(filepath.Join(regHome, "data.json")))
+	version, err := readVersion(regHome, "")
+	require.NoError(t, err)
+	assert.Equal(t, logVersion, version)
+
+	ls, err := openStore(backendLog, regHome, 0600, 100)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 10, "b.log": 20}, loadedOffsets(t, ls))
+	writeStates(t, ls, states, testState("a.log", 15))
+	require.NoError(t, ls.Close())
+
+	// switch back to the json backend
+	require.NoError(t, ensureCurrent(home, "", backendJSON, 0600))
+	assert.False(t, isFile(checkpointFilePath(regHome)))
+	assert.False(t, isFile(logFilePath(regHome)))
+	version, err = readVersion(regHome, "")
+	require.NoError(t, err)
+	assert.Equal(t, jsonVersion, version)
+
+	js, err = openStore(backendJSON, regHome, 0600, 100)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 15, "b.log": 20}, loadedOffsets(t, js))
+}
+
+func TestFinishInterruptedMigration(t *testing.T) {
+	home, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	regHome := filepath.Join(home, "filebeat")
+
+	require.NoError(t, ensureCurrent(home, "", backendJSON, 0600))
+	js, err := openStore(backendJSON, regHome, 0600, 100)
+	require.NoError(t, err)
+	writeStates(t, js, file.NewStates(), testState("a.log", 10))
+
+	// meta.json has been updated, but data.json was not moved yet
+	require.NoError(t, writeMeta(regHome, logVersion, 0600))
+
+	require.NoError(t, ensureCurrent(home, "", backendLog, 0600))
+	ls, err := openStore(backendLog, regHome, 0600, 100)
+	require.NoError(t, err)
+	defer ls.Close()
+
+	assert.Equal(t, map[string]int64{"a.log": 10}, loadedOffsets(t, ls))
+	assert.False(t, isFile(filepath.Join(regHome, "data.json")))
+}
+
+func TestRevertInterruptedMigration(t *testing.T) {
+	home, err := ioutil.TempDir("", "registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	regHome := filepath.Join(home, "filebeat")
+
+	require.NoError(t, ensureCurrent(home, "", backendJSON, 0600))
+	js, err := openStore(backendJSON, regHome, 0600, 100)
+	require.NoError(t, err)
+	writeStates(t, js, file.NewStates(), testState("a.log", 10))
+
+	// meta.json has been updated, but data.json was not moved yet, and the
+	// json backend is configured again
+	require.NoError(t, writeMeta(regHome, logVersion, 0600))
+
+	require.NoError(t, ensureCurrent(home, "", backendJSON, 0600))
+	version, err := readVersion(regHome, "")
+	require.NoError(t, err)
+	assert.Equal(t, jsonVersion, version)
+
+	js, err = openStore(backendJSON, regHome, 0600, 100)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a.log": 10}, loadedOffsets(t, js))
+}
@@ -15,12 +15,13 @@
 // specific language governing permissions and limitations
 // under the License.
 
-//+build linux,cgo
+// +build linux,cgo
 
 package instance
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/coreos/go-systemd/sdjournal"
 
@@ -29,28 +30,43 @@ import (
 
 var (
 	metrics  *monitoring.Registry
+	mu       sync.Mutex
 	journals map[string]*sdjournal.Journal
 )
 
 // SetupJournalMetrics initializes and registers monitoring functions.
 func SetupJournalMetrics() {
 	metrics = monitoring.Default.NewRegistry("journalbeat")
+
+	mu.Lock()
 	journals = make(map[string]*sdjournal.Journal)
+	mu.Unlock()
 
 	monitoring.NewFunc(metrics, "journals", reportJournalSizes, monitoring.Report)
 }
 
 // AddJournalToMonitor adds a new journal which has to be monitored.
+// Journals are not monitored if SetupJournalMetrics has not been called, e.g.
+// when the reader is used by Filebeat.
 func AddJournalToMonitor(path string, journal *sdjournal.Journal) {
-	journals[path] = journal
+	mu.Lock()
+	defer mu.Unlock()
+	if journals != nil {
+		journals[path] = journal
+	}
 }
 
 // StopMonitoringJournal stops monitoring the journal under the path.
 func StopMonitoringJournal(path string) {
+	mu.Lock()
+	defer mu.Unlock()
 	delete(journals, path)
 }
 
 func reportJournalSizes(m monitoring.Mode, V monitoring.Visitor) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	i := 0
 	for path, journal := range journals {
 		s, err := journal.GetUsage()
@@ -50,6 +50,7 @@ type BeatsRootCmd struct {
 	ExportCmd     *cobra.Command
 	TestCmd       *cobra.Command
 	KeystoreCmd   *cobra.Command
+	SpoolCmd      *cobra.Command
 }
 
 // GenRootCmdWithSettings returns the root command to use for your beat. It take the
@@ -76,6 +77,7 @@ func GenRootCmdWithSettings(beatCreator beat.Creator, settings instance.Settings
 	rootCmd.TestCmd = genTestCmd(settings, beatCreator)
 	rootCmd.SetupCmd = genSetupCmd(settings, beatCreator)
 	rootCmd.KeystoreCmd = genKeystoreCmd(settings)
+	rootCmd.SpoolCmd = genSpoolCmd(settings)
 	rootCmd.VersionCmd = genVersionCmd(settings)
 	rootCmd.CompletionCmd = genCompletionCmd(settings, rootCmd)
 
@@ -109,6 +111,7 @@ func GenRootCmdWithSettings(beatCreator beat.Creator, settings instance.Settings
 	rootCmd.AddCommand(rootCmd.ExportCmd)
 	rootCmd.AddCommand(rootCmd.TestCmd)
 	rootCmd.AddCommand(rootCmd.KeystoreCmd)
+	rootCmd.AddCommand(rootCmd.SpoolCmd)
 
 	return rootCmd
 }
@@ -0,0 +1,226 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/libbeat/cmd/instance"
+	"github.com/elastic/beats/libbeat/common/cli"
+	"github.com/elastic/beats/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/publisher/queue/spool"
+)
+
+// genSpoolCmd initializes the spool command to inspect the contents of the
+// spool file with the following subcommands:
+//  - stats
+//  - export
+func genSpoolCmd(settings instance.Settings) *cobra.Command {
+	spoolCmd := cobra.Command{
+		Use:   "spool",
+		Short: "Inspect the spool queue file",
+		Long: "Inspect the events pending in the spool queue file. The spool file is opened " +
+			"readonly, events are not removed from the queue. Stop the Beat before " +
+			"inspecting the spool file, as the file is locked while the Beat is running.",
+	}
+
+	spoolCmd.AddCommand(genSpoolStatsCmd(settings))
+	spoolCmd.AddCommand(genSpoolExportCmd(settings))
+
+	return &spoolCmd
+}
+
+func genSpoolStatsCmd(settings instance.Settings) *cobra.Command {
+	var flagPath string
+	var flagSample int
+	command := &cobra.Command{
+		Use:   "stats",
+		Short: "Show spool file usage and pending events",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return spoolStats(settings, flagPath, flagSample)
+		}),
+	}
+	command.Flags().StringVar(&flagPath, "path", "", "Spool file path (defaults to the configured spool file)")
+	command.Flags().IntVar(&flagSample, "sample", 0, "Number of pending events to decode and print")
+	return command
+}
+
+func genSpoolExportCmd(settings instance.Settings) *cobra.Command {
+	var flagPath string
+	var flagOutput string
+	var flagLimit int
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export pending events as newline delimited JSON",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return spoolExport(settings, flagPath, flagOutput, flagLimit)
+		}),
+	}
+	command.Flags().StringVar(&flagPath, "path", "", "Spool file path (defaults to the configured spool file)")
+	command.Flags().StringVarP(&flagOutput, "output", "o", "", "Write events to file instead of stdout")
+	command.Flags().IntVar(&flagLimit, "limit", 0, "Maximum number of events to export (0 exports all events)")
+	return command
+}
+
+// openSpool opens the spool file configured in the beat settings, or the
+// file given by path, for inspection.
+func openSpool(settings instance.Settings, path string) (*instance.Beat, *spool.Inspector, error) {
+	b, err := instance.NewBeat(settings.Name, settings.IndexPrefix, settings.Version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error initializing beat: %s", err)
+	}
+
+	if err = b.InitWithSettings(settings); err != nil {
+		return nil, nil, fmt.Errorf("error initializing beat: %s", err)
+	}
+
+	if path == "" {
+		queue := b.Config.Pipeline.Queue
+		if queue.Name() != "spool" {
+			return nil, nil, fmt.Errorf("spool queue is not configured, use --path to select a spool file")
+		}
+
+		path, err = spool.ConfiguredPath(queue.Config())
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading spool settings: %s", err)
+		}
+	}
+
+	inspector, err := spool.OpenInspector(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening spool file %s: %s", path, err)
+	}
+	return b, inspector, nil
+}
+
+func spoolStats(settings instance.Settings, path string, sample int) error {
+	b, inspector, err := openSpool(settings, path)
+	if err != nil {
+		return err
+	}
+	defer inspector.Close()
+
+	stats, err := inspector.Stats()
+	if err != nil {
+		return fmt.Errorf("error reading spool file: %s", err)
+	}
+
+	printSpoolStats(os.Stdout, stats)
+	if sample <= 0 || stats.Pending == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("Sample events:")
+	return exportSpoolEvents(os.Stdout, b, inspector, sample)
+}
+
+func spoolExport(settings instance.Settings, path, output string, limit int) error {
+	b, inspector, err := openSpool(settings, path)
+	if err != nil {
+		return err
+	}
+	defer inspector.Close()
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return fmt.Errorf("error creating export file: %s", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	return exportSpoolEvents(out, b, inspector, limit)
+}
+
+func printSpoolStats(out io.Writer, stats spool.Stats) {
+	fmt.Fprintf(out, "Path:           %s\n", stats.Path)
+	fmt.Fprintf(out, "File size:      %s (max: %s)\n",
+		humanize.IBytes(stats.FileSize), humanize.IBytes(stats.MaxSize))
+	fmt.Fprintf(out, "Page size:      %s\n", humanize.IBytes(uint64(stats.PageSize)))
+
+	usage := ""
+	if stats.TotalPages > 0 {
+		used := float64(stats.DataPages+stats.MetaPages) / float64(stats.TotalPages)
+		usage = fmt.Sprintf(" (%.2f%% in use)", used*100)
+	}
+	fmt.Fprintf(out, "Pages:          %v data, %v meta, %v total%s\n",
+		stats.DataPages, stats.MetaPages, stats.TotalPages, usage)
+
+	fmt.Fprintf(out, "Pending events: %v (%s)\n", stats.Pending, humanize.IBytes(stats.Bytes))
+	if stats.Invalid > 0 {
+		fmt.Fprintf(out, "Invalid events: %v\n", stats.Invalid)
+	}
+	if len(stats.Codecs) > 0 {
+		codecs := make([]string, 0, len(stats.Codecs))
+		for name, count := range stats.Codecs {
+			codecs = append(codecs, fmt.Sprintf("%v=%v", name, count))
+		}
+		sort.Strings(codecs)
+		fmt.Fprintf(out, "Codecs:         %s\n", strings.Join(codecs, ", "))
+	}
+	if !stats.Oldest.IsZero() {
+		fmt.Fprintf(out, "Oldest event:   %s\n", stats.Oldest.UTC().Format(time.RFC3339Nano))
+		fmt.Fprintf(out, "Newest event:   %s\n", stats.Newest.UTC().Format(time.RFC3339Nano))
+	}
+}
+
+// exportSpoolEvents writes up to limit pending events to out, one JSON
+// document per line. All events are exported if limit is 0.
+func exportSpoolEvents(out io.Writer, b *instance.Beat, inspector *spool.Inspector, limit int) error {
+	w := bufio.NewWriter(out)
+	enc := json.New(b.Info.Version, json.Config{})
+
+	count := 0
+	var encErr error
+	err := inspector.Each(func(event publisher.Event) bool {
+		buf, err := enc.Encode(b.Info.Beat, &event.Content)
+		if err != nil {
+			encErr = fmt.Errorf("error encoding event: %s", err)
+			return false
+		}
+
+		if _, err := w.Write(buf); err != nil {
+			encErr = err
+			return false
+		}
+		w.WriteByte('\n')
+
+		count++
+		return limit <= 0 || count < limit
+	})
+	if err == nil {
+		err = encErr
+	}
+	if err != nil {
+		return fmt.Errorf("error exporting events: %s", err)
+	}
+	return w.Flush()
+}
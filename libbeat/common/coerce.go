@@ -17,7 +17,10 @@
 
 package common
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 // TryToInt tries to coerce the given interface to an int. On success it returns
 // the int value and true.
@@ -55,3 +58,86 @@ func TryToInt(number interface{}) (int, bool) {
 	}
 	return rtn, true
 }
+
+// TryToInt64 tries to coerce the given interface to an int64. Floating point
+// numbers are truncated. On success it returns the int64 value and true.
+func TryToInt64(number interface{}) (int64, bool) {
+	var rtn int64
+	switch v := number.(type) {
+	case int:
+		rtn = int64(v)
+	case int8:
+		rtn = int64(v)
+	case int16:
+		rtn = int64(v)
+	case int32:
+		rtn = int64(v)
+	case int64:
+		rtn = v
+	case uint:
+		rtn = int64(v)
+	case uint8:
+		rtn = int64(v)
+	case uint16:
+		rtn = int64(v)
+	case uint32:
+		rtn = int64(v)
+	case uint64:
+		rtn = int64(v)
+	case float32:
+		rtn = int64(v)
+	case float64:
+		rtn = int64(v)
+	case string:
+		var err error
+		rtn, err = strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	return rtn, true
+}
+
+// TryToFloat64 tries to coerce the given interface to a float64. On success it
+// returns the float64 value and true.
+func TryToFloat64(number interface{}) (float64, bool) {
+	var rtn float64
+	switch v := number.(type) {
+	case float32:
+		rtn = float64(v)
+	case float64:
+		rtn = v
+	case string:
+		var err error
+		rtn, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false
+		}
+	default:
+		i, ok := TryToInt64(number)
+		if !ok {
+			return 0, false
+		}
+		rtn = float64(i)
+	}
+	return rtn, true
+}
+
+// TryToBool tries to coerce the given interface to a bool. Strings are parsed
+// with strconv.ParseBool. On success it returns the bool value and true.
+func TryToBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, false
+		}
+		return b, true
+	default:
+		return false, false
+	}
+}
@@ -72,3 +72,69 @@ func TestTryToInt(t *testing.T) {
 		assert.Equal(t, b, test.resultB)
 	}
 }
+
+func TestTryToInt64(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		result  int64
+		resultB bool
+	}{
+		{int(4), 4, true},
+		{int64(1) << 40, 1 << 40, true},
+		{uint16(12), 12, true},
+		{float64(3.9), 3, true},
+		{" 42 ", 42, true},
+		{"4.2", 0, false},
+		{"abc", 0, false},
+		{true, 0, false},
+	}
+
+	for _, test := range tests {
+		a, b := TryToInt64(test.input)
+		assert.Equal(t, test.result, a)
+		assert.Equal(t, test.resultB, b)
+	}
+}
+
+func TestTryToFloat64(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		result  float64
+		resultB bool
+	}{
+		{float64(1.5), 1.5, true},
+		{float32(0.5), 0.5, true},
+		{int(7), 7, true},
+		{"4.2", 4.2, true},
+		{"1e3", 1000, true},
+		{"abc", 0, false},
+		{[]string{"1"}, 0, false},
+	}
+
+	for _, test := range tests {
+		a, b := TryToFloat64(test.input)
+		assert.Equal(t, test.result, a)
+		assert.Equal(t, test.resultB, b)
+	}
+}
+
+func TestTryToBool(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		result  bool
+		resultB bool
+	}{
+		{true, true, true},
+		{"true", true, true},
+		{"F", false, true},
+		{"0", false, true},
+		{"yes", false, false},
+		{1, false, false},
+	}
+
+	for _, test := range tests {
+		a, b := TryToBool(test.input)
+		assert.Equal(t, test.result, a)
+		assert.Equal(t, test.resultB, b)
+	}
+}
@@ -18,9 +18,12 @@
 package file
 
 import (
+	"compress/gzip"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,6 +34,10 @@ import (
 // greater will result in an error.
 const MaxBackupsLimit = 1024
 
+// CompressedSuffix is appended to the names of rotated files if compression
+// is enabled.
+const CompressedSuffix = ".gz"
+
 // rotateReason is the reason why file rotation occurred.
 type rotateReason uint32
 
@@ -69,6 +76,7 @@ type Rotator struct {
 	interval        time.Duration
 	intervalRotator *intervalRotator // Optional, may be nil
 	redirectStderr  bool
+	compress        bool
 
 	file  *os.File
 	size  uint
@@ -132,6 +140,14 @@ func RedirectStderr(redirect bool) RotatorOption {
 	}
 }
 
+// Compress enables gzip compression of rotated files. Compressed backups
+// are named with the CompressedSuffix. The default is false.
+func Compress(enabled bool) RotatorOption {
+	return func(r *Rotator) {
+		r.compress = enabled
+	}
+}
+
 // NewFileRotator returns a new Rotator.
 func NewFileRotator(filename string, options ...RotatorOption) (*Rotator, error) {
 	r := &Rotator{
@@ -168,6 +184,7 @@ func NewFileRotator(filename string, options ...RotatorOption) (*Rotator, error)
 			"max_backups", r.maxBackups,
 			"permissions", r.permissions,
 			"interval", r.interval,
+			"compress", r.compress,
 		)
 	}
 
@@ -242,7 +259,11 @@ func (r *Rotator) backupName(n uint) string {
 	if n == 0 {
 		return r.filename
 	}
-	return r.filename + "." + strconv.Itoa(int(n))
+	name := r.filename + "." + strconv.Itoa(int(n))
+	if r.compress {
+		name += CompressedSuffix
+	}
+	return name
 }
 
 func (r *Rotator) dir() string {
@@ -403,7 +424,7 @@ func (r *Rotator) rotateByInterval(reason rotateReason) error {
 		targetFilename = logPrefix + strconv.Itoa(int(lastLogIndex)+1)
 	}
 
-	if err := os.Rename(r.filename, targetFilename); err != nil {
+	if err := r.moveFile(r.filename, targetFilename); err != nil {
 		return errors.Wrap(err, "failed to rotate backups")
 	}
 
@@ -430,7 +451,14 @@ func (r *Rotator) rotateBySize(reason rotateReason) error {
 		if err := os.Remove(older); err != nil && !os.IsNotExist(err) {
 			return errors.Wrap(err, "failed to rotate backups")
 		}
-		if err := os.Rename(old, older); err != nil {
+
+		var err error
+		if i == 1 {
+			err = r.moveFile(old, older)
+		} else {
+			err = os.Rename(old, older)
+		}
+		if err != nil {
 			return errors.Wrap(err, "failed to rotate backups")
 		} else if i == 1 {
 			// Log when rotation of the main file occurs.
@@ -441,3 +469,57 @@ func (r *Rotator) rotateBySize(reason rotateReason) error {
 	}
 	return nil
 }
+
+// moveFile moves the active file to its backup name. If compression is
+// enabled, the file is compressed into the target file instead.
+func (r *Rotator) moveFile(src, dst string) error {
+	if !r.compress {
+		return os.Rename(src, dst)
+	}
+
+	if !strings.HasSuffix(dst, CompressedSuffix) {
+		dst += CompressedSuffix
+	}
+	return compressFile(src, dst, r.permissions)
+}
+
+// compressFile writes a gzip compressed copy of src to dst and removes src.
+// The compressed file is written to a temporary file first, such that dst
+// never contains partial data.
+func compressFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(out)
+	_, err = io.Copy(gz, in)
+	if err == nil {
+		err = gz.Close()
+	}
+	if err == nil {
+		err = out.Sync()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return errors.Wrapf(err, "failed to compress %v", src)
+	}
+
+	if err := os.Rename(tmp, dst); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	in.Close()
+	return os.Remove(src)
+}
@@ -18,6 +18,7 @@
 package file_test
 
 import (
+	"compress/gzip"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -75,6 +76,77 @@ func TestFileRotator(t *testing.T) {
 	AssertDirContents(t, dir, "sample.log.2")
 }
 
+func TestFileRotatorCompress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file_rotator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "sample.log")
+	r, err := file.NewFileRotator(filename, file.MaxBackups(2), file.Compress(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	WriteMsg(t, r)
+	Rotate(t, r)
+	AssertDirContents(t, dir, "sample.log.1.gz")
+
+	WriteMsg(t, r)
+	Rotate(t, r)
+	AssertDirContents(t, dir, "sample.log.1.gz", "sample.log.2.gz")
+
+	WriteMsg(t, r)
+	Rotate(t, r)
+	AssertDirContents(t, dir, "sample.log.1.gz", "sample.log.2.gz")
+
+	f, err := os.Open(filepath.Join(dir, "sample.log.1.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, logMessage, string(content))
+}
+
+func TestIntervalRotationCompress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "interval_file_rotator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	logname := "hourly"
+	prefix := logname + "-" + time.Now().Format("2006-01-02-15") + "-"
+
+	filename := filepath.Join(dir, logname)
+	r, err := file.NewFileRotator(filename, file.MaxBackups(2), file.Interval(time.Hour), file.Compress(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	WriteMsg(t, r)
+	Rotate(t, r)
+	AssertDirContents(t, dir, prefix+"1.gz")
+
+	for i := 0; i < 10; i++ {
+		WriteMsg(t, r)
+		Rotate(t, r)
+	}
+	AssertDirContents(t, dir, prefix+"10.gz", prefix+"11.gz")
+}
+
 func TestFileRotatorConcurrently(t *testing.T) {
 	dir, err := ioutil.TempDir("", "file_rotator")
 	if err != nil {
@@ -418,17 +418,7 @@ func createEventBulkMeta(
 		return nil, err
 	}
 
-	var id string
-	if m := event.Meta; m != nil {
-		if tmp := m["id"]; tmp != nil {
-			if s, ok := tmp.(string); ok {
-				id = s
-			} else {
-				logp.Err("Event ID '%v' is no string value", id)
-			}
-		}
-	}
-
+	id := getEventID(event)
 	meta := bulkEventMeta{
 		Index:    index,
 		DocType:  eventType,
@@ -442,6 +432,28 @@ func createEventBulkMeta(
 	return bulkIndexAction{meta}, nil
 }
 
+// getEventID returns the document ID configured in the event metadata.
+// The ID can be set via `@metadata._id` (e.g. by the fingerprint processor) or
+// `@metadata.id`.
+func getEventID(event *beat.Event) string {
+	if event.Meta == nil {
+		return ""
+	}
+
+	for _, key := range []string{"_id", "id"} {
+		tmp := event.Meta[key]
+		if tmp == nil {
+			continue
+		}
+
+		if s, ok := tmp.(string); ok {
+			return s
+		}
+		logp.Err("Event ID '%v' is no string value", tmp)
+	}
+	return ""
+}
+
 func getPipeline(event *beat.Event, pipelineSel *outil.Selector) (string, error) {
 	if event.Meta != nil {
 		if pipeline, exists := event.Meta["pipeline"]; exists {
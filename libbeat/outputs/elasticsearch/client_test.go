@@ -413,6 +413,54 @@ func TestBulkEncodeEvents(t *testing.T) {
 	}
 }
 
+func TestBulkEncodeEventID(t *testing.T) {
+	info := beat.Info{
+		IndexPrefix: "test",
+		Version:     version.GetDefaultVersion(),
+	}
+	im, err := idxmgmt.DefaultSupport(nil, info, common.NewConfig())
+	require.NoError(t, err)
+	index, pipeline, err := buildSelectors(im, info, common.NewConfig())
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		meta common.MapStr
+		id   string
+	}{
+		"no metadata":  {},
+		"_id":          {meta: common.MapStr{"_id": "fingerprint"}, id: "fingerprint"},
+		"id":           {meta: common.MapStr{"id": "legacy"}, id: "legacy"},
+		"_id first":    {meta: common.MapStr{"_id": "fingerprint", "id": "legacy"}, id: "fingerprint"},
+		"no string id": {meta: common.MapStr{"_id": 42}},
+	}
+
+	for name, test := range cases {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			events := []publisher.Event{{
+				Content: beat.Event{
+					Timestamp: time.Now(),
+					Meta:      test.meta,
+					Fields:    common.MapStr{"message": "test"},
+				},
+			}}
+
+			recorder := &testBulkRecorder{}
+			encoded := bulkEncodePublishRequest(recorder, index, pipeline, "doc", events)
+			require.Len(t, encoded, 1)
+
+			switch v := recorder.data[0].(type) {
+			case bulkCreateAction:
+				assert.Equal(t, test.id, v.Create.ID)
+			case bulkIndexAction:
+				assert.Equal(t, "", test.id, "expected create action")
+			default:
+				t.Fatalf("unknown action type %T", v)
+			}
+		})
+	}
+}
+
 func (r *testBulkRecorder) Add(meta, obj interface{}) error {
 	if r.inAction {
 		panic("can not add a new action if other action is active")
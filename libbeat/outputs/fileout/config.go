@@ -18,25 +18,31 @@
 package fileout
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/elastic/beats/libbeat/common/file"
 	"github.com/elastic/beats/libbeat/outputs/codec"
 )
 
 type config struct {
-	Path          string       `config:"path"`
-	Filename      string       `config:"filename"`
-	RotateEveryKb uint         `config:"rotate_every_kb" validate:"min=1"`
-	NumberOfFiles uint         `config:"number_of_files"`
-	Codec         codec.Config `config:"codec"`
-	Permissions   uint32       `config:"permissions"`
+	Path          string        `config:"path"`
+	Filename      string        `config:"filename"`
+	RotateEveryKb uint          `config:"rotate_every_kb" validate:"min=1"`
+	NumberOfFiles uint          `config:"number_of_files"`
+	Interval      time.Duration `config:"interval"`
+	Compress      bool          `config:"compress"`
+	CloseInactive time.Duration `config:"close_inactive" validate:"min=0"`
+	Codec         codec.Config  `config:"codec"`
+	Permissions   uint32        `config:"permissions"`
 }
 
 var (
 	defaultConfig = config{
 		NumberOfFiles: 7,
 		RotateEveryKb: 10 * 1024,
+		CloseInactive: 5 * time.Minute,
 		Permissions:   0600,
 	}
 )
@@ -47,5 +53,9 @@ func (c *config) Validate() error {
 			file.MaxBackupsLimit)
 	}
 
+	if c.Interval != 0 && c.Interval < time.Second {
+		return errors.New("The interval must be at least 1 second")
+	}
+
 	return nil
 }
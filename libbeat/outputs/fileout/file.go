@@ -18,12 +18,15 @@
 package fileout
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/file"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/outputs"
 	"github.com/elastic/beats/libbeat/outputs/codec"
@@ -38,8 +41,19 @@ type fileOutput struct {
 	filePath string
 	beat     beat.Info
 	observer outputs.Observer
-	rotator  *file.Rotator
 	codec    codec.Codec
+	config   config
+
+	// filename is evaluated per event, to select the file to write to. The
+	// rotators of all open files are indexed by the evaluated filename.
+	filename *fmtstr.EventFormatString
+	files    map[string]*openFile
+	clock    func() time.Time
+}
+
+type openFile struct {
+	rotator   *file.Rotator
+	lastWrite time.Time
 }
 
 // makeFileout instantiates a new file output instance.
@@ -68,49 +82,118 @@ func makeFileout(
 	return outputs.Success(-1, 0, fo)
 }
 
-func (out *fileOutput) init(beat beat.Info, c config) error {
-	var path string
-	if c.Filename != "" {
-		path = filepath.Join(c.Path, c.Filename)
-	} else {
-		path = filepath.Join(c.Path, out.beat.Beat)
+func (out *fileOutput) init(info beat.Info, c config) error {
+	name := c.Filename
+	if name == "" {
+		name = out.beat.Beat
 	}
 
-	out.filePath = path
-
 	var err error
-	out.rotator, err = file.NewFileRotator(
-		path,
-		file.MaxSizeBytes(c.RotateEveryKb*1024),
-		file.MaxBackups(c.NumberOfFiles),
-		file.Permissions(os.FileMode(c.Permissions)),
-		file.WithLogger(logp.NewLogger("rotator").With(logp.Namespace("rotator"))),
-	)
+	out.filename, err = fmtstr.CompileEvent(name)
 	if err != nil {
 		return err
 	}
 
-	out.codec, err = codec.CreateEncoder(beat, c.Codec)
+	out.config = c
+	out.filePath = filepath.Join(c.Path, name)
+	out.files = map[string]*openFile{}
+	out.clock = time.Now
+
+	// Open the rotator right away if the filename does not depend on the
+	// events, such that configuration errors are reported on startup.
+	if out.filename.IsConst() {
+		name, err := out.filename.Run(&beat.Event{})
+		if err != nil {
+			return err
+		}
+		if _, err := out.getFile(name); err != nil {
+			return err
+		}
+	}
+
+	out.codec, err = codec.CreateEncoder(info, c.Codec)
 	if err != nil {
 		return err
 	}
 
 	logp.Info("Initialized file output. "+
-		"path=%v max_size_bytes=%v max_backups=%v permissions=%v",
-		path, c.RotateEveryKb*1024, c.NumberOfFiles, os.FileMode(c.Permissions))
+		"path=%v max_size_bytes=%v max_backups=%v permissions=%v interval=%v compress=%v",
+		out.filePath, c.RotateEveryKb*1024, c.NumberOfFiles, os.FileMode(c.Permissions),
+		c.Interval, c.Compress)
 
 	return nil
 }
 
+// getFile returns the rotator for the given filename, creating a new rotator
+// if the file is not open yet.
+func (out *fileOutput) getFile(name string) (*openFile, error) {
+	if f, exists := out.files[name]; exists {
+		return f, nil
+	}
+
+	if name == "" || name == "." || name == ".." || filepath.Base(name) != name {
+		return nil, fmt.Errorf("invalid filename '%v'", name)
+	}
+
+	c := out.config
+	rotator, err := file.NewFileRotator(
+		filepath.Join(c.Path, name),
+		file.MaxSizeBytes(c.RotateEveryKb*1024),
+		file.MaxBackups(c.NumberOfFiles),
+		file.Permissions(os.FileMode(c.Permissions)),
+		file.Interval(c.Interval),
+		file.Compress(c.Compress),
+		file.WithLogger(logp.NewLogger("rotator").With(logp.Namespace("rotator"))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &openFile{rotator: rotator, lastWrite: out.clock()}
+	out.files[name] = f
+	return f, nil
+}
+
+// closeInactive closes all files that have not been written to for the
+// configured close_inactive duration.
+func (out *fileOutput) closeInactive() {
+	if out.filename.IsConst() || out.config.CloseInactive <= 0 {
+		return
+	}
+
+	now := out.clock()
+	for name, f := range out.files {
+		if now.Sub(f.lastWrite) < out.config.CloseInactive {
+			continue
+		}
+
+		if err := f.rotator.Close(); err != nil {
+			logp.Warn("Failed to close file %v: %v", name, err)
+		}
+		delete(out.files, name)
+	}
+}
+
 // Implement Outputer
 func (out *fileOutput) Close() error {
-	return out.rotator.Close()
+	var errs []error
+	for name, f := range out.files {
+		if err := f.rotator.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(out.files, name)
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
 }
 
 func (out *fileOutput) Publish(
 	batch publisher.Batch,
 ) error {
 	defer batch.ACK()
+	defer out.closeInactive()
 
 	st := out.observer
 	events := batch.Events()
@@ -120,6 +203,19 @@ func (out *fileOutput) Publish(
 	for i := range events {
 		event := &events[i]
 
+		f, err := out.selectFile(&event.Content)
+		if err != nil {
+			if event.Guaranteed() {
+				logp.Critical("Failed to select the file for the event: %v", err)
+			} else {
+				logp.Warn("Failed to select the file for the event: %v", err)
+			}
+			logp.Debug("file", "Failed event: %v", event)
+
+			dropped++
+			continue
+		}
+
 		serializedEvent, err := out.codec.Encode(out.beat.Beat, &event.Content)
 		if err != nil {
 			if event.Guaranteed() {
@@ -133,7 +229,7 @@ func (out *fileOutput) Publish(
 			continue
 		}
 
-		if _, err = out.rotator.Write(append(serializedEvent, '\n')); err != nil {
+		if _, err = f.rotator.Write(append(serializedEvent, '\n')); err != nil {
 			st.WriteError(err)
 
 			if event.Guaranteed() {
@@ -146,6 +242,7 @@ func (out *fileOutput) Publish(
 			continue
 		}
 
+		f.lastWrite = out.clock()
 		st.WriteBytes(len(serializedEvent) + 1)
 	}
 
@@ -155,6 +252,14 @@ func (out *fileOutput) Publish(
 	return nil
 }
 
+func (out *fileOutput) selectFile(event *beat.Event) (*openFile, error) {
+	name, err := out.filename.Run(event)
+	if err != nil {
+		return nil, err
+	}
+	return out.getFile(name)
+}
+
 func (out *fileOutput) String() string {
 	return "file(" + out.filePath + ")"
 }
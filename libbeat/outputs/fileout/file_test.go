@@ -18,3 +18,121 @@
 // +build !integration
 
 package fileout
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	_ "github.com/elastic/beats/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/libbeat/outputs/outest"
+)
+
+func newTestOutput(t *testing.T, settings common.MapStr) *fileOutput {
+	cfg := common.MustNewConfigFrom(settings)
+	config := defaultConfig
+	require.NoError(t, cfg.Unpack(&config))
+
+	out := &fileOutput{
+		beat:     beat.Info{Beat: "testbeat"},
+		observer: outputs.NewNilObserver(),
+	}
+	require.NoError(t, out.init(out.beat, config))
+	return out
+}
+
+func testEvent(fields common.MapStr) beat.Event {
+	return beat.Event{
+		Timestamp: time.Date(2019, 4, 2, 10, 15, 30, 0, time.UTC),
+		Fields:    fields,
+	}
+}
+
+func dirContents(t *testing.T, dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPublishSelectsFileByEvent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileout")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	out := newTestOutput(t, common.MapStr{
+		"path":     dir,
+		"filename": "%{[service]}-%{+yyyy.MM.dd}",
+	})
+	defer out.Close()
+
+	batch := outest.NewBatch(
+		testEvent(common.MapStr{"service": "web", "message": "a"}),
+		testEvent(common.MapStr{"service": "db", "message": "b"}),
+		testEvent(common.MapStr{"service": "web", "message": "c"}),
+		testEvent(common.MapStr{"message": "missing service"}),
+		testEvent(common.MapStr{"service": "../escape", "message": "d"}),
+	)
+	require.NoError(t, out.Publish(batch))
+	require.NoError(t, out.Close())
+
+	assert.Equal(t, []string{"db-2019.04.02", "web-2019.04.02"}, dirContents(t, dir))
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, "web-2019.04.02"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, 2)
+}
+
+func TestCloseInactiveFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileout")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	out := newTestOutput(t, common.MapStr{
+		"path":           dir,
+		"filename":       "%{[service]}",
+		"close_inactive": "1m",
+	})
+	defer out.Close()
+
+	now := time.Now()
+	out.clock = func() time.Time { return now }
+
+	require.NoError(t, out.Publish(outest.NewBatch(
+		testEvent(common.MapStr{"service": "web"}),
+		testEvent(common.MapStr{"service": "db"}),
+	)))
+	assert.Len(t, out.files, 2)
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, out.Publish(outest.NewBatch(
+		testEvent(common.MapStr{"service": "web"}),
+	)))
+	assert.Len(t, out.files, 1)
+	assert.Contains(t, out.files, "web")
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := defaultConfig
+	err := common.MustNewConfigFrom(common.MapStr{
+		"path":     "/tmp",
+		"interval": "10ms",
+	}).Unpack(&config)
+	assert.Error(t, err)
+}
@@ -0,0 +1,370 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package httpout
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+type client struct {
+	url         string
+	method      string
+	username    string
+	password    string
+	bearerToken string
+	headers     map[string]string
+
+	http *http.Client
+
+	index       string
+	codec       codec.Codec
+	batchFormat string
+
+	// request body buffers
+	buf              bytes.Buffer
+	gzip             *gzip.Writer
+	compressionLevel int
+
+	observer outputs.Observer
+}
+
+type clientSettings struct {
+	URL                string
+	Method             string
+	Proxy              *url.URL
+	TLS                *transport.TLSConfig
+	Username, Password string
+	BearerToken        string
+	Parameters         map[string]string
+	Headers            map[string]string
+	Timeout            time.Duration
+	CompressionLevel   int
+	BatchFormat        string
+	Index              string
+	Codec              codec.Codec
+	Observer           outputs.Observer
+}
+
+// eventStatus classifies how the events of a batch must be handled after the
+// endpoint has responded.
+type eventStatus uint8
+
+const (
+	statusOK eventStatus = iota
+	statusRetry
+	statusDrop
+)
+
+var (
+	nl         = []byte("\n")
+	comma      = []byte(",")
+	arrayOpen  = []byte("[")
+	arrayClose = []byte("]")
+)
+
+func newClient(s clientSettings) (*client, error) {
+	proxy := http.ProxyFromEnvironment
+	if s.Proxy != nil {
+		proxy = http.ProxyURL(s.Proxy)
+	}
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse http URL: %v", err)
+	}
+	if u.User != nil {
+		s.Username = u.User.Username()
+		s.Password, _ = u.User.Password()
+		u.User = nil
+	}
+	if len(s.Parameters) > 0 {
+		values := u.Query()
+		for k, v := range s.Parameters {
+			values.Add(k, v)
+		}
+		u.RawQuery = values.Encode()
+	}
+	s.URL = u.String()
+
+	logp.Info("HTTP output url: %s", s.URL)
+
+	var dialer, tlsDialer transport.Dialer
+	dialer = transport.NetDialer(s.Timeout)
+	tlsDialer, err = transport.TLSDialer(dialer, s.TLS, s.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if st := s.Observer; st != nil {
+		dialer = transport.StatsDialer(dialer, st)
+		tlsDialer = transport.StatsDialer(tlsDialer, st)
+	}
+
+	c := &client{
+		url:         s.URL,
+		method:      s.Method,
+		username:    s.Username,
+		password:    s.Password,
+		bearerToken: s.BearerToken,
+		headers:     s.Headers,
+		http: &http.Client{
+			Transport: &http.Transport{
+				Dial:    dialer.Dial,
+				DialTLS: tlsDialer.Dial,
+				Proxy:   proxy,
+			},
+			Timeout: s.Timeout,
+		},
+		index:            s.Index,
+		codec:            s.Codec,
+		batchFormat:      s.BatchFormat,
+		compressionLevel: s.CompressionLevel,
+		observer:         s.Observer,
+	}
+
+	if c.method == "" {
+		c.method = http.MethodPost
+	}
+
+	if s.CompressionLevel > 0 {
+		c.gzip, err = gzip.NewWriterLevel(&c.buf, s.CompressionLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// Connect is a no-op. HTTP connections are established lazily on the first
+// request, and are kept alive by the transport.
+func (c *client) Connect() error {
+	return nil
+}
+
+func (c *client) Close() error {
+	if t, ok := c.http.Transport.(*http.Transport); ok {
+		t.CloseIdleConnections()
+	}
+	return nil
+}
+
+func (c *client) String() string {
+	return "http(" + c.url + ")"
+}
+
+func (c *client) Publish(batch publisher.Batch) error {
+	events := batch.Events()
+	rest, err := c.publishEvents(events)
+	if len(rest) == 0 {
+		batch.ACK()
+	} else {
+		batch.RetryEvents(rest)
+	}
+	return err
+}
+
+// publishEvents encodes all events into one request body and sends it to the
+// configured endpoint. On error a slice with all events that need to be
+// retried is returned.
+func (c *client) publishEvents(data []publisher.Event) ([]publisher.Event, error) {
+	begin := time.Now()
+	st := c.observer
+
+	if st != nil {
+		st.NewBatch(len(data))
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	origCount := len(data)
+	data, err := c.encodeBody(data)
+	if err != nil {
+		return data, err
+	}
+
+	newCount := len(data)
+	if st != nil && origCount > newCount {
+		st.Dropped(origCount - newCount)
+	}
+	if newCount == 0 {
+		return nil, nil
+	}
+
+	status, err := c.sendRequest()
+	switch status {
+	case statusOK:
+		debugf("PublishEvents: %d events have been published to %v in %v.",
+			newCount, c.url, time.Now().Sub(begin))
+		if st != nil {
+			st.Acked(newCount)
+		}
+		return nil, nil
+
+	case statusDrop:
+		logp.Err("Dropping %d events rejected by %v: %v", newCount, c.url, err)
+		if st != nil {
+			st.Dropped(newCount)
+		}
+		return nil, nil
+
+	default:
+		logp.Err("Failed to publish events to %v: %v", c.url, err)
+		if st != nil {
+			st.Failed(newCount)
+		}
+		return data, err
+	}
+}
+
+// encodeBody serializes the events into the request buffer, based on the
+// configured batch format. Events failing to encode are removed from the
+// returned slice.
+func (c *client) encodeBody(data []publisher.Event) ([]publisher.Event, error) {
+	c.buf.Reset()
+
+	var w io.Writer = &c.buf
+	if c.gzip != nil {
+		c.gzip.Reset(&c.buf)
+		w = c.gzip
+	}
+
+	if c.batchFormat == batchFormatJSONArray {
+		w.Write(arrayOpen)
+	}
+
+	okEvents := data[:0]
+	for i := range data {
+		event := &data[i].Content
+		serialized, err := c.codec.Encode(c.index, event)
+		if err != nil {
+			logp.Err("Failed to encode event: %s", err)
+			logp.Debug("http", "Failed event: %v", event)
+			continue
+		}
+
+		if c.batchFormat == batchFormatJSONArray && len(okEvents) > 0 {
+			w.Write(comma)
+		}
+		w.Write(serialized)
+		if c.batchFormat == batchFormatNDJSON {
+			w.Write(nl)
+		}
+
+		okEvents = append(okEvents, data[i])
+	}
+
+	if c.batchFormat == batchFormatJSONArray {
+		w.Write(arrayClose)
+	}
+
+	if c.gzip != nil {
+		if err := c.gzip.Close(); err != nil {
+			return okEvents, err
+		}
+	}
+
+	return okEvents, nil
+}
+
+// sendRequest sends the buffered body to the endpoint. Connection errors,
+// request timeouts, 429 and 5xx responses are reported as retryable. All other
+// non 2xx responses indicate the endpoint rejected the batch, which must be
+// dropped.
+func (c *client) sendRequest() (eventStatus, error) {
+	size := c.buf.Len()
+	req, err := http.NewRequest(c.method, c.url, &c.buf)
+	if err != nil {
+		return statusRetry, err
+	}
+
+	if c.batchFormat == batchFormatNDJSON {
+		req.Header.Set("Content-Type", "application/x-ndjson")
+	} else {
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
+	if c.gzip != nil {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	switch {
+	case c.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	case c.username != "" || c.password != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	for name, value := range c.headers {
+		req.Header.Add(name, value)
+	}
+
+	// The stlib will override the value in the header based on the configured `Host`
+	// on the request which default to the current machine.
+	if host := req.Header.Get("Host"); host != "" {
+		req.Host = host
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if c.observer != nil {
+			c.observer.WriteError(err)
+		}
+		return statusRetry, err
+	}
+	defer closing(resp.Body)
+
+	if c.observer != nil {
+		c.observer.WriteBytes(size)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	status := resp.StatusCode
+	switch {
+	case status >= 200 && status < 300:
+		return statusOK, nil
+	case status == http.StatusTooManyRequests, status == http.StatusRequestTimeout, status >= 500:
+		if status == http.StatusTooManyRequests && c.observer != nil {
+			c.observer.ErrTooMany(1)
+		}
+		return statusRetry, fmt.Errorf("%v: %s", resp.Status, body)
+	default:
+		return statusDrop, fmt.Errorf("%v: %s", resp.Status, body)
+	}
+}
+
+func closing(c io.Closer) {
+	err := c.Close()
+	if err != nil {
+		logp.Warn("Close failed with: %v", err)
+	}
+}
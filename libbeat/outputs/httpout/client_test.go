@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package httpout
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+	_ "github.com/elastic/beats/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/libbeat/outputs/outest"
+)
+
+type request struct {
+	header http.Header
+	body   string
+}
+
+func newTestServer(t *testing.T, status int) (*httptest.Server, <-chan request) {
+	requests := make(chan request, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+		var err error
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			var gz *gzip.Reader
+			gz, err = gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body, err = ioutil.ReadAll(gz)
+		} else {
+			body, err = ioutil.ReadAll(r.Body)
+		}
+		require.NoError(t, err)
+
+		requests <- request{header: r.Header, body: string(body)}
+		w.WriteHeader(status)
+	}))
+	return server, requests
+}
+
+func newTestClient(t *testing.T, url string, settings clientSettings) *client {
+	enc, err := codec.CreateEncoder(beat.Info{Beat: "test", Version: "1.2.3"}, codec.Config{})
+	require.NoError(t, err)
+
+	settings.URL = url
+	settings.Index = "test"
+	settings.Codec = enc
+	settings.Timeout = 5 * time.Second
+	if settings.BatchFormat == "" {
+		settings.BatchFormat = batchFormatNDJSON
+	}
+
+	c, err := newClient(settings)
+	require.NoError(t, err)
+	return c
+}
+
+func testEvents() []beat.Event {
+	return []beat.Event{
+		{Timestamp: time.Now(), Fields: common.MapStr{"message": "first"}},
+		{Timestamp: time.Now(), Fields: common.MapStr{"message": "second"}},
+	}
+}
+
+func TestPublishNDJSON(t *testing.T) {
+	server, requests := newTestServer(t, http.StatusOK)
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, clientSettings{
+		Headers:     map[string]string{"X-Test": "value"},
+		BearerToken: "secret",
+	})
+
+	batch := outest.NewBatch(testEvents()...)
+	require.NoError(t, c.Publish(batch))
+
+	req := <-requests
+	assert.Equal(t, "application/x-ndjson", req.header.Get("Content-Type"))
+	assert.Equal(t, "value", req.header.Get("X-Test"))
+	assert.Equal(t, "Bearer secret", req.header.Get("Authorization"))
+
+	lines := strings.Split(strings.TrimSuffix(req.body, "\n"), "\n")
+	require.Len(t, lines, 2)
+	for i, expected := range []string{"first", "second"} {
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &doc))
+		assert.Equal(t, expected, doc["message"])
+	}
+
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+}
+
+func TestPublishJSONArrayGzip(t *testing.T) {
+	server, requests := newTestServer(t, http.StatusOK)
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, clientSettings{
+		BatchFormat:      batchFormatJSONArray,
+		CompressionLevel: 5,
+		Username:         "user",
+		Password:         "pass",
+	})
+
+	batch := outest.NewBatch(testEvents()...)
+	require.NoError(t, c.Publish(batch))
+
+	req := <-requests
+	assert.Equal(t, "gzip", req.header.Get("Content-Encoding"))
+	user, pass, ok := (&http.Request{Header: req.header}).BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "pass", pass)
+
+	var docs []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(req.body), &docs))
+	require.Len(t, docs, 2)
+	assert.Equal(t, "first", docs[0]["message"])
+	assert.Equal(t, "second", docs[1]["message"])
+}
+
+func TestPublishResponseStatus(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		err    bool
+		signal outest.BatchSignalTag
+	}{
+		"ok":                {http.StatusAccepted, false, outest.BatchACK},
+		"too many requests": {http.StatusTooManyRequests, true, outest.BatchRetryEvents},
+		"server error":      {http.StatusServiceUnavailable, true, outest.BatchRetryEvents},
+		"bad request":       {http.StatusBadRequest, false, outest.BatchACK},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, requests := newTestServer(t, test.status)
+			defer server.Close()
+
+			c := newTestClient(t, server.URL, clientSettings{})
+
+			batch := outest.NewBatch(testEvents()...)
+			err := c.Publish(batch)
+			<-requests
+
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			require.Len(t, batch.Signals, 1)
+			assert.Equal(t, test.signal, batch.Signals[0].Tag)
+		})
+	}
+}
+
+func TestMakeHTTP(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"hosts":        []string{"localhost:8080"},
+		"path":         "/ingest",
+		"batch_format": "json_array",
+	})
+
+	group, err := makeHTTP(nil, beat.Info{Beat: "test"}, outputs.NewNilObserver(), cfg)
+	require.NoError(t, err)
+	require.Len(t, group.Clients, 1)
+	assert.Equal(t, "backoff(http(http://localhost:8080/ingest))", group.Clients[0].String())
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := map[string]common.MapStr{
+		"invalid batch format": {"hosts": []string{"localhost"}, "batch_format": "xml"},
+		"invalid method":       {"hosts": []string{"localhost"}, "method": "GET"},
+		"conflicting auth": {
+			"hosts":        []string{"localhost"},
+			"username":     "user",
+			"bearer_token": "token",
+		},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig
+			err := common.MustNewConfigFrom(settings).Unpack(&config)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package httpout
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+)
+
+type httpConfig struct {
+	Protocol         string            `config:"protocol"`
+	Path             string            `config:"path"`
+	Method           string            `config:"method"`
+	Params           map[string]string `config:"parameters"`
+	Headers          map[string]string `config:"headers"`
+	Username         string            `config:"username"`
+	Password         string            `config:"password"`
+	BearerToken      string            `config:"bearer_token"`
+	ProxyURL         string            `config:"proxy_url"`
+	LoadBalance      bool              `config:"loadbalance"`
+	CompressionLevel int               `config:"compression_level" validate:"min=0, max=9"`
+	BatchFormat      string            `config:"batch_format"`
+	TLS              *tlscommon.Config `config:"ssl"`
+	BulkMaxSize      int               `config:"bulk_max_size"`
+	MaxRetries       int               `config:"max_retries"`
+	Timeout          time.Duration     `config:"timeout"`
+	Backoff          Backoff           `config:"backoff"`
+	Codec            codec.Config      `config:"codec"`
+}
+
+type Backoff struct {
+	Init time.Duration
+	Max  time.Duration
+}
+
+const (
+	batchFormatNDJSON    = "ndjson"
+	batchFormatJSONArray = "json_array"
+)
+
+var (
+	defaultConfig = httpConfig{
+		Protocol:         "",
+		Path:             "",
+		Method:           "POST",
+		Timeout:          90 * time.Second,
+		MaxRetries:       3,
+		BulkMaxSize:      50,
+		CompressionLevel: 0,
+		BatchFormat:      batchFormatNDJSON,
+		TLS:              nil,
+		LoadBalance:      true,
+		Backoff: Backoff{
+			Init: 1 * time.Second,
+			Max:  60 * time.Second,
+		},
+	}
+)
+
+func (c *httpConfig) Validate() error {
+	switch c.BatchFormat {
+	case batchFormatNDJSON, batchFormatJSONArray:
+	default:
+		return fmt.Errorf("batch_format '%v' not supported", c.BatchFormat)
+	}
+
+	switch strings.ToUpper(c.Method) {
+	case "POST", "PUT":
+	default:
+		return fmt.Errorf("http method '%v' not supported", c.Method)
+	}
+
+	if c.BearerToken != "" && (c.Username != "" || c.Password != "") {
+		return errors.New("username/password and bearer_token can not be used together")
+	}
+
+	if c.ProxyURL != "" {
+		if _, err := parseProxyURL(c.ProxyURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseProxyURL(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	url, err := url.Parse(raw)
+	if err == nil && strings.HasPrefix(url.Scheme, "http") {
+		return url, err
+	}
+
+	// Proxy was bogus. Try prepending "http://" to it and
+	// see if that parses correctly.
+	return url.Parse("http://" + raw)
+}
@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package httpout
+
+import (
+	"strings"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+)
+
+func init() {
+	outputs.RegisterType("http", makeHTTP)
+}
+
+var (
+	debugf = logp.MakeDebug("http")
+)
+
+const (
+	defaultHTTPPort  = 80
+	defaultHTTPSPort = 443
+)
+
+func makeHTTP(
+	_ outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *common.Config,
+) (outputs.Group, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return outputs.Fail(err)
+	}
+
+	hosts, err := outputs.ReadHostList(cfg)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	proxyURL, err := parseProxyURL(config.ProxyURL)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	if proxyURL != nil {
+		logp.Info("Using proxy URL: %s", proxyURL)
+	}
+
+	params := config.Params
+	if len(params) == 0 {
+		params = nil
+	}
+
+	clients := make([]outputs.NetworkClient, len(hosts))
+	for i, host := range hosts {
+		hostURL, err := common.MakeURL(config.Protocol, config.Path, host, defaultPort(config.Protocol, host))
+		if err != nil {
+			logp.Err("Invalid host param set: %s, Error: %v", host, err)
+			return outputs.Fail(err)
+		}
+
+		enc, err := codec.CreateEncoder(beat, config.Codec)
+		if err != nil {
+			return outputs.Fail(err)
+		}
+
+		var client outputs.NetworkClient
+		client, err = newClient(clientSettings{
+			URL:              hostURL,
+			Method:           strings.ToUpper(config.Method),
+			Proxy:            proxyURL,
+			TLS:              tlsConfig,
+			Username:         config.Username,
+			Password:         config.Password,
+			BearerToken:      config.BearerToken,
+			Parameters:       params,
+			Headers:          config.Headers,
+			Timeout:          config.Timeout,
+			CompressionLevel: config.CompressionLevel,
+			BatchFormat:      config.BatchFormat,
+			Index:            beat.Beat,
+			Codec:            enc,
+			Observer:         observer,
+		})
+		if err != nil {
+			return outputs.Fail(err)
+		}
+
+		client = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
+		clients[i] = client
+	}
+
+	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+}
+
+// defaultPort selects the port to use if the host does not configure one,
+// based on the scheme set in the host or the protocol setting.
+func defaultPort(protocol, host string) int {
+	if strings.HasPrefix(host, "https://") {
+		return defaultHTTPSPort
+	}
+	if strings.HasPrefix(host, "http://") {
+		return defaultHTTPPort
+	}
+	if protocol == "https" {
+		return defaultHTTPSPort
+	}
+	return defaultHTTPPort
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/conditions"
+)
+
+type config struct {
+	Routes      []routeConfig `config:"routes" validate:"required"`
+	BulkMaxSize int           `config:"bulk_max_size"`
+	QueueSize   int           `config:"queue_size" validate:"min=0"`
+}
+
+type routeConfig struct {
+	Name      string                 `config:"name"`
+	Condition *conditions.Config     `config:"when"`
+	Output    common.ConfigNamespace `config:"output"`
+}
+
+var defaultConfig = config{
+	BulkMaxSize: 2048,
+	QueueSize:   4,
+}
+
+func (c *config) Validate() error {
+	if len(c.Routes) == 0 {
+		return errors.New("no routes configured")
+	}
+
+	for i, r := range c.Routes {
+		if !r.Output.IsSet() {
+			return fmt.Errorf("route %v has no output configured", i)
+		}
+		if r.Output.Name() == "route" {
+			return fmt.Errorf("route %v can not be of type 'route'", i)
+		}
+	}
+	return nil
+}
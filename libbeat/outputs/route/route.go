@@ -0,0 +1,466 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package route provides the `route` output, which forwards events to
+// multiple outputs based on conditions.
+//
+// Each route manages its own output group, with its own batch size, retry
+// and ACK handling. Batches received from the publisher pipeline are split by
+// route, and are ACKed to the pipeline only after every route the events have
+// been forwarded to has confirmed its part of the batch.
+package route
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/conditions"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/beats/libbeat/testing"
+)
+
+// router is the outputs.Client passed to the publisher pipeline. It splits
+// batches by route and forwards the events to the route work queues.
+type router struct {
+	routes   []*route
+	observer outputs.Observer
+	log      *logp.Logger
+
+	// publishMu is held while publishing a batch, such that routes are only
+	// closed after the batch has been queued or cancelled.
+	publishMu sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// route forwards events matching the condition to a group of output clients.
+type route struct {
+	name      string
+	condition conditions.Condition
+	batchSize int
+	ttl       int
+
+	queue   chan *routeBatch
+	workers []*worker
+	done    chan struct{}
+	log     *logp.Logger
+
+	// requeueMu is held while requeueing a batch, such that the queue is only
+	// drained on close after the batch has been queued or cancelled.
+	requeueMu sync.RWMutex
+}
+
+// parentBatch tracks the pending route batches created from a batch received
+// from the publisher pipeline.
+type parentBatch struct {
+	batch     publisher.Batch
+	observer  outputs.Observer
+	events    int // number of events forwarded to at least one route
+	dropped   atomic.Int
+	pending   atomic.Int
+	cancelled atomic.Bool
+}
+
+// routeObserver forwards the I/O metrics and the failed and dropped events of
+// the route outputs to the output observer. New and ACKed events are accounted
+// for by the router only, such that events forwarded to multiple routes are not
+// counted multiple times.
+type routeObserver struct {
+	outputs.Observer
+}
+
+// routeBatch implements publisher.Batch for the events forwarded to a single
+// route.
+type routeBatch struct {
+	route  *route
+	parent *parentBatch
+	events []publisher.Event
+	ttl    int
+}
+
+func init() {
+	outputs.RegisterType("route", makeRoute)
+}
+
+func makeRoute(
+	im outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *common.Config,
+) (outputs.Group, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return outputs.Fail(err)
+	}
+
+	r, err := newRouter(im, beat, observer, config)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	// Retries are handled per route. The router never returns batches to the
+	// publisher pipeline for retrying.
+	return outputs.Success(config.BulkMaxSize, -1, r)
+}
+
+func newRouter(
+	im outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	config config,
+) (*router, error) {
+	if observer == nil {
+		observer = outputs.NewNilObserver()
+	}
+
+	r := &router{
+		observer: observer,
+		log:      logp.NewLogger("route"),
+		done:     make(chan struct{}),
+	}
+
+	for i, rc := range config.Routes {
+		name := rc.Name
+		if name == "" {
+			name = fmt.Sprintf("%v-%v", i, rc.Output.Name())
+		}
+
+		var cond conditions.Condition
+		if rc.Condition != nil {
+			var err error
+			cond, err = conditions.NewCondition(rc.Condition)
+			if err != nil {
+				r.Close()
+				return nil, fmt.Errorf("failed to initialize condition of route %v: %v", name, err)
+			}
+		}
+
+		group, err := outputs.Load(im, beat, routeObserver{observer}, rc.Output.Name(), rc.Output.Config())
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("failed to initialize output of route %v: %v", name, err)
+		}
+
+		r.routes = append(r.routes, newRoute(name, cond, group, config.QueueSize, r.log))
+	}
+
+	return r, nil
+}
+
+func newRoute(
+	name string,
+	cond conditions.Condition,
+	group outputs.Group,
+	queueSize int,
+	log *logp.Logger,
+) *route {
+	r := &route{
+		name:      name,
+		condition: cond,
+		batchSize: group.BatchSize,
+		ttl:       group.Retry + 1,
+		queue:     make(chan *routeBatch, queueSize),
+		done:      make(chan struct{}),
+		log:       log.With("route", name),
+	}
+
+	for _, client := range group.Clients {
+		r.workers = append(r.workers, startWorker(r, client))
+	}
+	return r
+}
+
+func (r *router) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+
+		r.publishMu.Lock()
+		defer r.publishMu.Unlock()
+		for _, rt := range r.routes {
+			rt.close()
+		}
+	})
+	return nil
+}
+
+func (r *router) Publish(batch publisher.Batch) error {
+	r.publishMu.Lock()
+	defer r.publishMu.Unlock()
+
+	events := batch.Events()
+	r.observer.NewBatch(len(events))
+
+	// assign events to all matching routes
+	perRoute := make([][]publisher.Event, len(r.routes))
+	unmatched := 0
+	for i := range events {
+		event := &events[i]
+		matched := false
+		for j, rt := range r.routes {
+			if rt.matches(&event.Content) {
+				perRoute[j] = append(perRoute[j], *event)
+				matched = true
+			}
+		}
+		if !matched {
+			unmatched++
+		}
+	}
+
+	if unmatched > 0 {
+		r.log.Debugf("Drop %v events not matching any route", unmatched)
+		r.observer.Dropped(unmatched)
+	}
+
+	// split events per route into batches, such that the number of pending
+	// batches is known before publishing
+	var batches []*routeBatch
+	parent := &parentBatch{
+		batch:    batch,
+		observer: r.observer,
+		events:   len(events) - unmatched,
+	}
+	for i, rt := range r.routes {
+		for _, chunk := range splitEvents(perRoute[i], rt.batchSize) {
+			batches = append(batches, &routeBatch{
+				route:  rt,
+				parent: parent,
+				events: chunk,
+				ttl:    rt.ttl,
+			})
+		}
+	}
+
+	if len(batches) == 0 {
+		batch.ACK()
+		return nil
+	}
+
+	parent.pending.Store(len(batches))
+	for i, b := range batches {
+		select {
+		case b.route.queue <- b:
+		case <-r.done:
+			// Batches already queued are cancelled by the routes on close.
+			parent.cancel(len(batches) - i)
+			return errors.New("route output closed")
+		}
+	}
+	return nil
+}
+
+func (r *router) String() string {
+	names := make([]string, len(r.routes))
+	for i, rt := range r.routes {
+		names[i] = rt.name
+	}
+	return "route(" + strings.Join(names, ",") + ")"
+}
+
+func (r *router) Test(d testing.Driver) {
+	for _, rt := range r.routes {
+		d.Run("route "+rt.name, func(d testing.Driver) {
+			for _, w := range rt.workers {
+				c, ok := w.client.(testing.Testable)
+				if !ok {
+					d.Fatal("output", errors.New("client doesn't support testing"))
+				}
+				c.Test(d)
+			}
+		})
+	}
+}
+
+func (rt *route) matches(event *beat.Event) bool {
+	return rt.condition == nil || rt.condition.Check(event)
+}
+
+func (rt *route) close() {
+	close(rt.done)
+	for _, w := range rt.workers {
+		w.close()
+	}
+
+	// wait for the batches being requeued
+	rt.requeueMu.Lock()
+	rt.requeueMu.Unlock()
+
+	// cancel the batches left in the queue, such that their parent batches
+	// can be resolved
+	for {
+		select {
+		case b := <-rt.queue:
+			b.parent.cancel(1)
+		default:
+			return
+		}
+	}
+}
+
+// requeue returns a batch to the route work queue. Batches are requeued
+// asynchronously, as the caller might be the only worker reading from the
+// queue. Once the route is closed, the batch is cancelled instead.
+func (rt *route) requeue(b *routeBatch) {
+	go func() {
+		rt.requeueMu.RLock()
+		defer rt.requeueMu.RUnlock()
+
+		select {
+		case <-rt.done:
+			b.parent.cancel(1)
+			return
+		default:
+		}
+
+		select {
+		case rt.queue <- b:
+		case <-rt.done:
+			b.parent.cancel(1)
+		}
+	}()
+}
+
+func splitEvents(events []publisher.Event, size int) [][]publisher.Event {
+	if len(events) == 0 {
+		return nil
+	}
+	if size <= 0 || len(events) <= size {
+		return [][]publisher.Event{events}
+	}
+
+	var chunks [][]publisher.Event
+	for len(events) > 0 {
+		n := size
+		if n > len(events) {
+			n = len(events)
+		}
+		chunks = append(chunks, events[:n])
+		events = events[n:]
+	}
+	return chunks
+}
+
+// done marks a route batch as finished.
+func (p *parentBatch) done() {
+	p.finish(1)
+}
+
+// drop marks a route batch as finished, with n of its events being dropped.
+func (p *parentBatch) drop(n int) {
+	p.dropped.Add(n)
+	p.finish(1)
+}
+
+// cancel marks n route batches as cancelled. The parent batch is cancelled
+// instead of ACKed once all route batches are finished.
+func (p *parentBatch) cancel(n int) {
+	p.cancelled.Store(true)
+	p.finish(n)
+}
+
+func (p *parentBatch) finish(n int) {
+	if p.pending.Sub(n) != 0 {
+		return
+	}
+
+	// Events dropped by multiple routes are counted once per route.
+	dropped := p.dropped.Load()
+	if dropped > p.events {
+		dropped = p.events
+	}
+	if dropped > 0 {
+		p.observer.Dropped(dropped)
+	}
+
+	if p.cancelled.Load() {
+		p.observer.Cancelled(p.events - dropped)
+		p.batch.Cancelled()
+	} else {
+		p.observer.Acked(p.events - dropped)
+		p.batch.ACK()
+	}
+}
+
+func (b *routeBatch) Events() []publisher.Event {
+	return b.events
+}
+
+func (b *routeBatch) ACK() {
+	b.parent.done()
+}
+
+func (b *routeBatch) Drop() {
+	b.parent.drop(len(b.events))
+}
+
+func (b *routeBatch) Retry() {
+	b.decTTL()
+	if len(b.events) == 0 {
+		b.route.log.Info("Drop batch")
+		b.Drop()
+		return
+	}
+	b.route.requeue(b)
+}
+
+func (b *routeBatch) Cancelled() {
+	b.route.requeue(b)
+}
+
+func (b *routeBatch) RetryEvents(events []publisher.Event) {
+	b.events = events
+	b.Retry()
+}
+
+func (b *routeBatch) CancelledEvents(events []publisher.Event) {
+	b.events = events
+	b.Cancelled()
+}
+
+// decTTL decrements the batch its retry counter. Once exhausted, only events
+// with guaranteed send flags are kept for retrying.
+func (b *routeBatch) decTTL() {
+	if b.ttl <= 0 {
+		return
+	}
+
+	b.ttl--
+	if b.ttl > 0 {
+		return
+	}
+
+	events := b.events[:0]
+	for _, event := range b.events {
+		if event.Guaranteed() {
+			events = append(events, event)
+		}
+	}
+	b.parent.dropped.Add(len(b.events) - len(events))
+	b.events = events
+}
+
+func (routeObserver) NewBatch(int)  {}
+func (routeObserver) Acked(int)     {}
+func (routeObserver) Duplicate(int) {}
+func (routeObserver) Cancelled(int) {}
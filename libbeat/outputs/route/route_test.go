@@ -0,0 +1,451 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package route
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/outest"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+// mockClient records published events. The publish function can be
+// replaced to simulate failures or slow outputs.
+type mockClient struct {
+	mu        sync.Mutex
+	published []string
+	publish   func(c *mockClient, batch publisher.Batch) error
+	observer  outputs.Observer
+}
+
+var (
+	mockMu      sync.Mutex
+	mockClients = map[string]*mockClient{}
+)
+
+func init() {
+	outputs.RegisterType("mock", func(
+		_ outputs.IndexManager,
+		_ beat.Info,
+		observer outputs.Observer,
+		cfg *common.Config,
+	) (outputs.Group, error) {
+		settings := struct {
+			ID        string `config:"id"`
+			BatchSize int    `config:"bulk_max_size"`
+			Retry     int    `config:"max_retries"`
+		}{}
+		if err := cfg.Unpack(&settings); err != nil {
+			return outputs.Fail(err)
+		}
+
+		mockMu.Lock()
+		defer mockMu.Unlock()
+		client := mockClients[settings.ID]
+		client.observer = observer
+		return outputs.Success(settings.BatchSize, settings.Retry, client)
+	})
+}
+
+func newMockClient(id string, publish func(c *mockClient, batch publisher.Batch) error) *mockClient {
+	c := &mockClient{publish: publish}
+	mockMu.Lock()
+	mockClients[id] = c
+	mockMu.Unlock()
+	return c
+}
+
+func ackAll(c *mockClient, batch publisher.Batch) error {
+	c.observer.NewBatch(len(batch.Events()))
+	c.record(batch)
+	c.observer.Acked(len(batch.Events()))
+	batch.ACK()
+	return nil
+}
+
+func (c *mockClient) record(batch publisher.Batch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range batch.Events() {
+		msg, _ := e.Content.Fields.GetValue("message")
+		c.published = append(c.published, msg.(string))
+	}
+}
+
+func (c *mockClient) Published() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.published...)
+}
+
+func (c *mockClient) Close() error                        { return nil }
+func (c *mockClient) String() string                      { return "mock" }
+func (c *mockClient) Publish(batch publisher.Batch) error { return c.publish(c, batch) }
+
+// countingObserver counts the events reported by the router.
+type countingObserver struct {
+	outputs.Observer
+	mu                                       sync.Mutex
+	total, acked, failed, dropped, cancelled int
+}
+
+func (o *countingObserver) NewBatch(n int)  { o.add(&o.total, n) }
+func (o *countingObserver) Acked(n int)     { o.add(&o.acked, n) }
+func (o *countingObserver) Failed(n int)    { o.add(&o.failed, n) }
+func (o *countingObserver) Dropped(n int)   { o.add(&o.dropped, n) }
+func (o *countingObserver) Cancelled(n int) { o.add(&o.cancelled, n) }
+
+func (o *countingObserver) add(counter *int, n int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	*counter += n
+}
+
+func makeTestRouter(t *testing.T, settings map[string]interface{}) *router {
+	return makeTestRouterWithObserver(t, settings, outputs.NewNilObserver())
+}
+
+func makeTestRouterWithObserver(
+	t *testing.T,
+	settings map[string]interface{},
+	observer outputs.Observer,
+) *router {
+	group, err := makeRoute(nil, beat.Info{Beat: "test"}, observer,
+		common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+	require.Len(t, group.Clients, 1)
+	return group.Clients[0].(*router)
+}
+
+func testEvent(msg string, tags ...string) beat.Event {
+	fields := common.MapStr{"message": msg}
+	if len(tags) > 0 {
+		fields["tags"] = tags
+	}
+	return beat.Event{Timestamp: time.Now(), Fields: fields}
+}
+
+func waitSignals(t *testing.T, batch *outest.Batch, acked <-chan struct{}) {
+	select {
+	case <-acked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for batch to be ACKed")
+	}
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+}
+
+func newSignalingBatch(events ...beat.Event) (*outest.Batch, <-chan struct{}) {
+	acked := make(chan struct{})
+	batch := outest.NewBatch(events...)
+	batch.OnSignal = func(_ outest.BatchSignal) { close(acked) }
+	return batch, acked
+}
+
+func TestRouteByCondition(t *testing.T) {
+	security := newMockClient("security", ackAll)
+	all := newMockClient("all", ackAll)
+
+	r := makeTestRouter(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{
+				"when.contains.tags": "security",
+				"output.mock":        map[string]interface{}{"id": "security"},
+			},
+			{
+				"output.mock": map[string]interface{}{"id": "all"},
+			},
+		},
+	})
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(
+		testEvent("login failed", "security"),
+		testEvent("GET /index.html"),
+	)
+	require.NoError(t, r.Publish(batch))
+	waitSignals(t, batch, acked)
+
+	assert.Equal(t, []string{"login failed"}, security.Published())
+	assert.Equal(t, []string{"login failed", "GET /index.html"}, all.Published())
+}
+
+func TestRouteACKAfterAllOutputsConfirmed(t *testing.T) {
+	release := make(chan struct{})
+	newMockClient("fast", ackAll)
+	newMockClient("slow", func(c *mockClient, batch publisher.Batch) error {
+		<-release
+		return ackAll(c, batch)
+	})
+
+	r := makeTestRouter(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{"output.mock": map[string]interface{}{"id": "fast"}},
+			{"output.mock": map[string]interface{}{"id": "slow"}},
+		},
+	})
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(testEvent("a"), testEvent("b"))
+	require.NoError(t, r.Publish(batch))
+
+	select {
+	case <-acked:
+		t.Fatal("batch ACKed before all routes confirmed the events")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	waitSignals(t, batch, acked)
+}
+
+func TestRouteRetry(t *testing.T) {
+	attempts := 0
+	client := newMockClient("flaky", func(c *mockClient, batch publisher.Batch) error {
+		attempts++
+		if attempts == 1 {
+			batch.RetryEvents(batch.Events()[1:])
+			return errors.New("temporary failure")
+		}
+		return ackAll(c, batch)
+	})
+
+	r := makeTestRouter(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{"output.mock": map[string]interface{}{"id": "flaky", "max_retries": 3}},
+		},
+	})
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(testEvent("a"), testEvent("b"), testEvent("c"))
+	require.NoError(t, r.Publish(batch))
+	waitSignals(t, batch, acked)
+
+	assert.Equal(t, []string{"b", "c"}, client.Published())
+}
+
+func TestRouteSplitsBatches(t *testing.T) {
+	client := newMockClient("small", ackAll)
+
+	r := makeTestRouter(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{"output.mock": map[string]interface{}{"id": "small", "bulk_max_size": 2}},
+		},
+	})
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(testEvent("a"), testEvent("b"), testEvent("c"))
+	require.NoError(t, r.Publish(batch))
+	waitSignals(t, batch, acked)
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, client.Published())
+}
+
+func TestRouteDropsUnmatchedEvents(t *testing.T) {
+	client := newMockClient("none", ackAll)
+
+	r := makeTestRouter(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{
+				"when.contains.tags": "security",
+				"output.mock":        map[string]interface{}{"id": "none"},
+			},
+		},
+	})
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(testEvent("a"))
+	require.NoError(t, r.Publish(batch))
+	waitSignals(t, batch, acked)
+
+	assert.Empty(t, client.Published())
+}
+
+func TestRouteCountsEventsOnce(t *testing.T) {
+	newMockClient("first", ackAll)
+	newMockClient("second", ackAll)
+
+	observer := &countingObserver{Observer: outputs.NewNilObserver()}
+	r := makeTestRouterWithObserver(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{"output.mock": map[string]interface{}{"id": "first"}},
+			{
+				"when.contains.tags": "security",
+				"output.mock":        map[string]interface{}{"id": "second"},
+			},
+			{
+				"when.contains.tags": "never",
+				"output.mock":        map[string]interface{}{"id": "second"},
+			},
+		},
+	}, observer)
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(
+		testEvent("a", "security"),
+		testEvent("b"),
+	)
+	require.NoError(t, r.Publish(batch))
+	waitSignals(t, batch, acked)
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	assert.Equal(t, 2, observer.total)
+	assert.Equal(t, 2, observer.acked)
+	assert.Equal(t, 0, observer.dropped)
+}
+
+func TestRouteCountsDroppedEvents(t *testing.T) {
+	newMockClient("failing", func(c *mockClient, batch publisher.Batch) error {
+		c.observer.NewBatch(len(batch.Events()))
+		c.observer.Failed(len(batch.Events()))
+		batch.Retry()
+		return errors.New("permanent failure")
+	})
+	newMockClient("working", ackAll)
+
+	observer := &countingObserver{Observer: outputs.NewNilObserver()}
+	r := makeTestRouterWithObserver(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{
+				"when.contains.tags": "security",
+				"output.mock":        map[string]interface{}{"id": "failing", "max_retries": 1},
+			},
+			{
+				"when.not.contains.tags": "security",
+				"output.mock":            map[string]interface{}{"id": "working"},
+			},
+		},
+	}, observer)
+	defer r.Close()
+
+	batch, acked := newSignalingBatch(
+		testEvent("a", "security"),
+		testEvent("b", "security"),
+		testEvent("c"),
+	)
+	require.NoError(t, r.Publish(batch))
+	waitSignals(t, batch, acked)
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	assert.Equal(t, 3, observer.total)
+	assert.Equal(t, 1, observer.acked)
+	assert.Equal(t, 4, observer.failed)
+	assert.Equal(t, 2, observer.dropped)
+}
+
+func TestRouteRequeueAfterClose(t *testing.T) {
+	newMockClient("closed", ackAll)
+	r := makeTestRouter(t, map[string]interface{}{
+		"routes": []map[string]interface{}{
+			{"output.mock": map[string]interface{}{"id": "closed"}},
+		},
+	})
+	r.Close()
+
+	var signals sync.WaitGroup
+	signals.Add(1)
+	batch := outest.NewBatch(testEvent("a"))
+	batch.OnSignal = func(_ outest.BatchSignal) { signals.Done() }
+
+	rt := r.routes[0]
+	parent := &parentBatch{batch: batch, observer: outputs.NewNilObserver(), events: 1}
+	parent.pending.Store(1)
+	rt.requeue(&routeBatch{route: rt, parent: parent, events: batch.Events(), ttl: rt.ttl})
+
+	signals.Wait()
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchCancelled, batch.Signals[0].Tag)
+	assert.Empty(t, rt.queue)
+}
+
+func TestRouteCancelOnClose(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	newMockClient("blocked", func(c *mockClient, batch publisher.Batch) error {
+		started <- struct{}{}
+		<-release
+		return ackAll(c, batch)
+	})
+
+	observer := &countingObserver{Observer: outputs.NewNilObserver()}
+	r := makeTestRouterWithObserver(t, map[string]interface{}{
+		"queue_size": 1,
+		"routes": []map[string]interface{}{
+			{"output.mock": map[string]interface{}{"id": "blocked", "bulk_max_size": 1}},
+		},
+	}, observer)
+
+	var signals sync.WaitGroup
+	signals.Add(1)
+	batch := outest.NewBatch(testEvent("a"), testEvent("b"), testEvent("c"), testEvent("d"))
+	batch.OnSignal = func(_ outest.BatchSignal) { signals.Done() }
+
+	published := make(chan error, 1)
+	go func() { published <- r.Publish(batch) }()
+
+	// the first route batch is blocked in the client, the second is
+	// queued and the router waits for queue space for the remaining ones
+	<-started
+	r.Close()
+	assert.Error(t, <-published)
+	assert.Empty(t, batch.Signals)
+
+	close(release)
+	signals.Wait()
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchCancelled, batch.Signals[0].Tag)
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	assert.Equal(t, 4, observer.total)
+	assert.Equal(t, 0, observer.acked)
+	assert.Equal(t, 4, observer.cancelled)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no routes": {},
+		"missing output": {
+			"routes": []map[string]interface{}{{"name": "a"}},
+		},
+		"nested route": {
+			"routes": []map[string]interface{}{{"output.route": map[string]interface{}{}}},
+		},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig
+			err := common.MustNewConfigFrom(settings).Unpack(&config)
+			assert.Error(t, err)
+		})
+	}
+}
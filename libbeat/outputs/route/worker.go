@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"github.com/elastic/beats/libbeat/common/atomic"
+	"github.com/elastic/beats/libbeat/outputs"
+)
+
+// worker passes batches from the route work queue to an output client.
+// Reconnectable clients are (re-)connected before publishing.
+type worker struct {
+	route  *route
+	client outputs.Client
+	closed atomic.Bool
+}
+
+func startWorker(rt *route, client outputs.Client) *worker {
+	w := &worker{route: rt, client: client}
+	go w.run()
+	return w
+}
+
+func (w *worker) close() {
+	w.closed.Store(true)
+	w.client.Close()
+}
+
+func (w *worker) run() {
+	nc, reconnectable := w.client.(outputs.NetworkClient)
+	log := w.route.log
+
+	for !w.closed.Load() {
+		if reconnectable {
+			log.Infof("Connecting to %v", nc)
+			if err := nc.Connect(); err != nil {
+				log.Errorf("Failed to connect to %v: %v", nc, err)
+				continue
+			}
+			log.Infof("Connection to %v established", nc)
+		}
+
+		if !w.publishLoop() {
+			return
+		}
+	}
+}
+
+// publishLoop publishes batches until the route is closed or an error
+// requires the client to reconnect. It returns false once the route has been
+// closed.
+func (w *worker) publishLoop() bool {
+	for {
+		select {
+		case <-w.route.done:
+			return false
+
+		case batch := <-w.route.queue:
+			if w.closed.Load() {
+				batch.Cancelled()
+				return false
+			}
+
+			if err := w.client.Publish(batch); err != nil {
+				w.route.log.Errorf("Failed to publish events: %v", err)
+				return true
+			}
+		}
+	}
+}
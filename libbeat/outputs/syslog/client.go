@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+	"github.com/elastic/beats/libbeat/publisher"
+)
+
+type client struct {
+	*transport.Client
+	observer outputs.Observer
+	encoder  *messageEncoder
+	timeout  time.Duration
+}
+
+func newClient(
+	conn *transport.Client,
+	observer outputs.Observer,
+	encoder *messageEncoder,
+	timeout time.Duration,
+) *client {
+	return &client{
+		Client:   conn,
+		observer: observer,
+		encoder:  encoder,
+		timeout:  timeout,
+	}
+}
+
+func (c *client) Connect() error {
+	debugf("connect to %v", c.Host())
+	return c.Client.Connect()
+}
+
+func (c *client) Close() error {
+	debugf("close connection to %v", c.Host())
+	return c.Client.Close()
+}
+
+func (c *client) String() string {
+	return "syslog(" + c.Client.String() + ")"
+}
+
+func (c *client) Publish(batch publisher.Batch) error {
+	events := batch.Events()
+	st := c.observer
+
+	st.NewBatch(len(events))
+
+	if len(events) == 0 {
+		batch.ACK()
+		return nil
+	}
+
+	dropped := 0
+	for i := range events {
+		event := &events[i]
+		msg, err := c.encoder.Encode(&event.Content)
+		if err != nil {
+			logp.Err("Failed to encode event: %v", err)
+			logp.Debug("syslog", "Failed event: %v", event)
+			dropped++
+			continue
+		}
+
+		if c.timeout > 0 {
+			c.SetWriteDeadline(time.Now().Add(c.timeout))
+		}
+		if _, err := c.Write(msg); err != nil {
+			// return all events not yet sent to the pipeline
+			rest := events[i:]
+			batch.RetryEvents(rest)
+			_ = c.Close()
+
+			logp.Err("Failed to publish events caused by: %v", err)
+
+			st.Acked(i - dropped)
+			st.Dropped(dropped)
+			st.Failed(len(rest))
+			return err
+		}
+	}
+
+	batch.ACK()
+	st.Acked(len(events) - dropped)
+	st.Dropped(dropped)
+	return nil
+}
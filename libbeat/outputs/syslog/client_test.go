@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package syslog
+
+import (
+	"bufio"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/outest"
+)
+
+func TestPublishTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	lines := make(chan string, 2)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"hosts":    []string{l.Addr().String()},
+		"network":  "tcp",
+		"framing":  "newline",
+		"hostname": "%{[host.name]}",
+		"app_name": "myapp",
+	})
+	group, err := makeSyslog(nil, beat.Info{Beat: "testbeat", Hostname: "localhost"}, outputs.NewNilObserver(), cfg)
+	require.NoError(t, err)
+	require.Len(t, group.Clients, 1)
+
+	client := group.Clients[0].(outputs.NetworkClient)
+	require.NoError(t, client.Connect())
+	defer client.Close()
+
+	batch := outest.NewBatch(
+		*testEvent(common.MapStr{"message": "first", "host": common.MapStr{"name": "web-1"}}),
+		*testEvent(common.MapStr{"message": "second", "event": common.MapStr{"severity": 2}}),
+	)
+	require.NoError(t, client.Publish(batch))
+
+	assert.Equal(t, "<14>1 2019-04-02T10:15:30.123456Z web-1 myapp - - - first", <-lines)
+	assert.Equal(t, "<10>1 2019-04-02T10:15:30.123456Z localhost myapp - - - second", <-lines)
+
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := map[string]common.MapStr{
+		"invalid network":  {"network": "sctp"},
+		"invalid format":   {"format": "cef"},
+		"invalid framing":  {"framing": "nul"},
+		"udp with ssl":     {"network": "udp", "ssl.enabled": true},
+		"invalid facility": {"facility.default": "local8"},
+		"invalid severity": {"severity.mapping": map[string]string{"fatal": "deadly"}},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := defaultConfig
+			err := common.MustNewConfigFrom(settings).Unpack(&config)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+)
+
+type syslogConfig struct {
+	Network     string            `config:"network"`
+	Format      string            `config:"format"`
+	Framing     string            `config:"framing"`
+	LoadBalance bool              `config:"loadbalance"`
+	BulkMaxSize int               `config:"bulk_max_size"`
+	MaxRetries  int               `config:"max_retries" validate:"min=-1"`
+	Timeout     time.Duration     `config:"timeout"`
+	TLS         *tlscommon.Config `config:"ssl"`
+	Backoff     Backoff           `config:"backoff"`
+	Codec       codec.Config      `config:"codec"`
+
+	Hostname *fmtstr.EventFormatString `config:"hostname"`
+	AppName  *fmtstr.EventFormatString `config:"app_name"`
+	ProcID   *fmtstr.EventFormatString `config:"proc_id"`
+	MsgID    *fmtstr.EventFormatString `config:"msg_id"`
+
+	Facility priorityConfig `config:"facility"`
+	Severity priorityConfig `config:"severity"`
+}
+
+// priorityConfig configures how the facility or severity of a message is
+// read from an event. Values found in Field are first looked up in Mapping,
+// and are otherwise parsed as a number or a well known keyword. If the field
+// is missing or can not be parsed, Default is used.
+type priorityConfig struct {
+	Field   string            `config:"field"`
+	Default string            `config:"default"`
+	Mapping map[string]string `config:"mapping"`
+}
+
+type Backoff struct {
+	Init time.Duration
+	Max  time.Duration
+}
+
+const (
+	formatRFC5424 = "rfc5424"
+	formatRFC3164 = "rfc3164"
+
+	framingOctetCounting = "octet_counting"
+	framingNewline       = "newline"
+)
+
+var (
+	defaultConfig = syslogConfig{
+		Network:     "udp",
+		Format:      formatRFC5424,
+		Framing:     framingOctetCounting,
+		LoadBalance: false,
+		BulkMaxSize: 2048,
+		MaxRetries:  3,
+		Timeout:     30 * time.Second,
+		Backoff: Backoff{
+			Init: 1 * time.Second,
+			Max:  60 * time.Second,
+		},
+		Facility: priorityConfig{
+			Field:   "syslog.facility",
+			Default: "user",
+		},
+		Severity: priorityConfig{
+			Field:   "event.severity",
+			Default: "informational",
+		},
+	}
+)
+
+func (c *syslogConfig) Validate() error {
+	switch c.Network {
+	case "udp", "tcp":
+	default:
+		return fmt.Errorf("network '%v' not supported", c.Network)
+	}
+
+	if c.Network == "udp" && c.TLS.IsEnabled() {
+		return errors.New("ssl can not be used with network 'udp'")
+	}
+
+	switch c.Format {
+	case formatRFC5424, formatRFC3164:
+	default:
+		return fmt.Errorf("syslog format '%v' not supported", c.Format)
+	}
+
+	switch c.Framing {
+	case framingOctetCounting, framingNewline:
+	default:
+		return fmt.Errorf("framing '%v' not supported", c.Framing)
+	}
+
+	if _, err := newPriorityMapper(c.Facility, facilities, maxFacility); err != nil {
+		return fmt.Errorf("invalid facility setting: %v", err)
+	}
+	if _, err := newPriorityMapper(c.Severity, severities, maxSeverity); err != nil {
+		return fmt.Errorf("invalid severity setting: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+)
+
+const (
+	maxFacility = 23
+	maxSeverity = 7
+
+	nilValue = "-"
+
+	rfc5424TimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+	rfc3164TimeFormat = "Jan _2 15:04:05"
+)
+
+var facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"ntp":      12,
+	"security": 13,
+	"console":  14,
+	"clock":    15,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+var severities = map[string]int{
+	"emergency":     0,
+	"emerg":         0,
+	"alert":         1,
+	"critical":      2,
+	"crit":          2,
+	"error":         3,
+	"err":           3,
+	"warning":       4,
+	"warn":          4,
+	"notice":        5,
+	"informational": 6,
+	"info":          6,
+	"debug":         7,
+}
+
+// priorityMapper reads a facility or severity code from an event.
+type priorityMapper struct {
+	field   string
+	def     int
+	mapping map[string]int
+	names   map[string]int
+	max     int
+}
+
+func newPriorityMapper(config priorityConfig, names map[string]int, max int) (*priorityMapper, error) {
+	m := &priorityMapper{
+		field: config.Field,
+		names: names,
+		max:   max,
+	}
+
+	var ok bool
+	if m.def, ok = m.parse(config.Default); !ok {
+		return nil, fmt.Errorf("unknown default value '%v'", config.Default)
+	}
+
+	if len(config.Mapping) > 0 {
+		m.mapping = make(map[string]int, len(config.Mapping))
+		for from, to := range config.Mapping {
+			code, ok := m.parse(to)
+			if !ok {
+				return nil, fmt.Errorf("unknown value '%v' in mapping of '%v'", to, from)
+			}
+			m.mapping[strings.ToLower(from)] = code
+		}
+	}
+
+	return m, nil
+}
+
+// parse converts a keyword or a number into a code.
+func (m *priorityMapper) parse(s string) (int, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if code, exists := m.names[s]; exists {
+		return code, true
+	}
+
+	code, err := strconv.Atoi(s)
+	if err != nil || code < 0 || code > m.max {
+		return 0, false
+	}
+	return code, true
+}
+
+// Map returns the code for the event. The default code is returned if the
+// field is missing or has a value that can not be mapped.
+func (m *priorityMapper) Map(event *beat.Event) int {
+	if m.field == "" {
+		return m.def
+	}
+
+	v, err := event.GetValue(m.field)
+	if err != nil {
+		return m.def
+	}
+
+	var s string
+	switch val := v.(type) {
+	case string:
+		s = val
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		s = fmt.Sprint(val)
+	case float32:
+		s = strconv.Itoa(int(val))
+	case float64:
+		s = strconv.Itoa(int(val))
+	default:
+		return m.def
+	}
+
+	if m.mapping != nil {
+		if code, exists := m.mapping[strings.ToLower(s)]; exists {
+			return code
+		}
+	}
+
+	if code, ok := m.parse(s); ok {
+		return code
+	}
+	return m.def
+}
+
+// header holds optional format strings used to build the syslog header
+// fields of a message.
+type header struct {
+	hostname *fmtstr.EventFormatString
+	appName  *fmtstr.EventFormatString
+	procID   *fmtstr.EventFormatString
+	msgID    *fmtstr.EventFormatString
+
+	defaultHostname string
+	defaultAppName  string
+}
+
+// messageEncoder serializes events into syslog messages, including the
+// transport framing. If framing is empty, messages are not framed.
+type messageEncoder struct {
+	format  string
+	framing string
+	header  header
+
+	facility *priorityMapper
+	severity *priorityMapper
+
+	codec codec.Codec
+	index string
+
+	msg bytes.Buffer
+	buf bytes.Buffer
+}
+
+func (e *messageEncoder) Encode(event *beat.Event) ([]byte, error) {
+	body, err := e.codec.Encode(e.index, event)
+	if err != nil {
+		return nil, err
+	}
+
+	pri := e.facility.Map(event)*8 + e.severity.Map(event)
+	hostname := headerValue(e.header.hostname, event, e.header.defaultHostname, 255)
+	appName := headerValue(e.header.appName, event, e.header.defaultAppName, 48)
+	procID := headerValue(e.header.procID, event, "", 128)
+
+	msg := &e.msg
+	msg.Reset()
+	switch e.format {
+	case formatRFC3164:
+		ts := event.Timestamp.Local().Format(rfc3164TimeFormat)
+		fmt.Fprintf(msg, "<%d>%s %s %s", pri, ts, hostname, truncate(appName, 32))
+		if procID != nilValue {
+			fmt.Fprintf(msg, "[%s]", procID)
+		}
+		msg.WriteString(": ")
+
+	default:
+		ts := event.Timestamp.UTC().Format(rfc5424TimeFormat)
+		msgID := headerValue(e.header.msgID, event, "", 32)
+		fmt.Fprintf(msg, "<%d>1 %s %s %s %s %s %s ", pri, ts, hostname, appName, procID, msgID, nilValue)
+	}
+	msg.Write(bytes.TrimRight(body, "\n"))
+
+	switch e.framing {
+	case framingNewline:
+		msg.WriteByte('\n')
+		return msg.Bytes(), nil
+
+	case framingOctetCounting:
+		e.buf.Reset()
+		e.buf.WriteString(strconv.Itoa(msg.Len()))
+		e.buf.WriteByte(' ')
+		e.buf.Write(msg.Bytes())
+		return e.buf.Bytes(), nil
+
+	default:
+		// datagram transports send a single message per packet, no framing
+		// required
+		return msg.Bytes(), nil
+	}
+}
+
+// headerValue evaluates a header format string. The syslog nil value is
+// returned if the value is empty. Characters not allowed in header fields are
+// removed and the value is truncated to maxLen.
+func headerValue(fs *fmtstr.EventFormatString, event *beat.Event, def string, maxLen int) string {
+	s := def
+	if fs != nil {
+		if v, err := fs.Run(event); err == nil && v != "" {
+			s = v
+		}
+	}
+
+	s = strings.Map(func(r rune) rune {
+		if r <= 32 || r >= 127 {
+			return -1
+		}
+		return r
+	}, s)
+	if s == "" {
+		return nilValue
+	}
+	return truncate(s, maxLen)
+}
+
+func truncate(s string, maxLen int) string {
+	if len(s) > maxLen {
+		return s[:maxLen]
+	}
+	return s
+}
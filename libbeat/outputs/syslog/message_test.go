@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package syslog
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/outputs/codec/format"
+)
+
+func newTestEncoder(t *testing.T, syslogFormat, framing string, facility, severity priorityConfig) *messageEncoder {
+	f, err := newPriorityMapper(facility, facilities, maxFacility)
+	require.NoError(t, err)
+	s, err := newPriorityMapper(severity, severities, maxSeverity)
+	require.NoError(t, err)
+
+	return &messageEncoder{
+		format:  syslogFormat,
+		framing: framing,
+		header: header{
+			hostname:        fmtstr.MustCompileEvent("%{[host.name]}"),
+			appName:         fmtstr.MustCompileEvent("%{[process.program]}"),
+			procID:          fmtstr.MustCompileEvent("%{[process.pid]}"),
+			msgID:           fmtstr.MustCompileEvent("%{[event.action]}"),
+			defaultHostname: "localhost",
+			defaultAppName:  "testbeat",
+		},
+		facility: f,
+		severity: s,
+		codec:    format.New(defaultMessage),
+		index:    "testbeat",
+	}
+}
+
+func testEvent(fields common.MapStr) *beat.Event {
+	return &beat.Event{
+		Timestamp: time.Date(2019, 4, 2, 10, 15, 30, 123456000, time.UTC),
+		Fields:    fields,
+	}
+}
+
+func TestEncodeRFC5424(t *testing.T) {
+	enc := newTestEncoder(t, formatRFC5424, framingOctetCounting, defaultConfig.Facility, defaultConfig.Severity)
+
+	cases := map[string]struct {
+		fields   common.MapStr
+		expected string
+	}{
+		"all header fields": {
+			fields: common.MapStr{
+				"message": "hello world",
+				"host":    common.MapStr{"name": "web-1"},
+				"process": common.MapStr{"program": "nginx", "pid": 42},
+				"event":   common.MapStr{"action": "access", "severity": 3},
+				"syslog":  common.MapStr{"facility": 16},
+			},
+			expected: "<131>1 2019-04-02T10:15:30.123456Z web-1 nginx 42 access - hello world",
+		},
+		"defaults": {
+			fields:   common.MapStr{"message": "hello world\n"},
+			expected: "<14>1 2019-04-02T10:15:30.123456Z localhost testbeat - - - hello world",
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			msg, err := enc.Encode(testEvent(test.fields))
+			require.NoError(t, err)
+
+			framed := fmtOctetCount(test.expected)
+			assert.Equal(t, framed, string(msg))
+		})
+	}
+}
+
+func TestEncodeRFC3164(t *testing.T) {
+	enc := newTestEncoder(t, formatRFC3164, framingNewline, defaultConfig.Facility, defaultConfig.Severity)
+
+	event := testEvent(common.MapStr{
+		"message": "connection closed",
+		"host":    common.MapStr{"name": "web 1"},
+		"process": common.MapStr{"program": "sshd", "pid": 1234},
+	})
+	msg, err := enc.Encode(event)
+	require.NoError(t, err)
+
+	ts := event.Timestamp.Local().Format(rfc3164TimeFormat)
+	assert.Equal(t, "<14>"+ts+" web1 sshd[1234]: connection closed\n", string(msg))
+}
+
+func TestEncodeNoFraming(t *testing.T) {
+	enc := newTestEncoder(t, formatRFC5424, "", defaultConfig.Facility, defaultConfig.Severity)
+
+	msg, err := enc.Encode(testEvent(common.MapStr{"message": "hello"}))
+	require.NoError(t, err)
+	assert.Equal(t, "<14>1 2019-04-02T10:15:30.123456Z localhost testbeat - - - hello", string(msg))
+}
+
+func TestPriorityMapper(t *testing.T) {
+	config := priorityConfig{
+		Field:   "log.level",
+		Default: "notice",
+		Mapping: map[string]string{"fatal": "crit", "trace": "7"},
+	}
+	m, err := newPriorityMapper(config, severities, maxSeverity)
+	require.NoError(t, err)
+
+	cases := map[interface{}]int{
+		"ERROR":    3,
+		"warn":     4,
+		"Fatal":    2,
+		"trace":    7,
+		"1":        1,
+		6:          6,
+		float64(0): 0,
+		"unknown":  5,
+		42:         5,
+	}
+
+	for value, expected := range cases {
+		event := testEvent(common.MapStr{"log": common.MapStr{"level": value}})
+		assert.Equal(t, expected, m.Map(event), "value: %v", value)
+	}
+
+	assert.Equal(t, 5, m.Map(testEvent(common.MapStr{})))
+}
+
+func TestPriorityMapperInvalidConfig(t *testing.T) {
+	_, err := newPriorityMapper(priorityConfig{Default: "unknown"}, facilities, maxFacility)
+	assert.Error(t, err)
+
+	_, err = newPriorityMapper(priorityConfig{Default: "24"}, facilities, maxFacility)
+	assert.Error(t, err)
+
+	_, err = newPriorityMapper(priorityConfig{
+		Default: "user",
+		Mapping: map[string]string{"a": "local9"},
+	}, facilities, maxFacility)
+	assert.Error(t, err)
+}
+
+func fmtOctetCount(msg string) string {
+	return strconv.Itoa(len(msg)) + " " + msg
+}
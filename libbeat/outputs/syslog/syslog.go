@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/fmtstr"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/codec"
+	"github.com/elastic/beats/libbeat/outputs/codec/format"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+const (
+	defaultPort    = 514
+	defaultTLSPort = 6514
+)
+
+var debugf = logp.MakeDebug("syslog")
+
+// defaultMessage is used to build the message part if no codec is configured.
+var defaultMessage = fmtstr.MustCompileEvent("%{[message]}")
+
+func init() {
+	outputs.RegisterType("syslog", makeSyslog)
+}
+
+func makeSyslog(
+	_ outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *common.Config,
+) (outputs.Group, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return outputs.Fail(err)
+	}
+
+	hosts, err := outputs.ReadHostList(cfg)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	tls, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	facility, err := newPriorityMapper(config.Facility, facilities, maxFacility)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	severity, err := newPriorityMapper(config.Severity, severities, maxSeverity)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	transp := &transport.Config{
+		Timeout: config.Timeout,
+		TLS:     tls,
+		Stats:   observer,
+	}
+
+	port := defaultPort
+	if tls != nil {
+		port = defaultTLSPort
+	}
+
+	framing := config.Framing
+	if config.Network == "udp" {
+		framing = ""
+	}
+
+	clients := make([]outputs.NetworkClient, len(hosts))
+	for i, host := range hosts {
+		var enc codec.Codec
+		if config.Codec.Namespace.IsSet() {
+			enc, err = codec.CreateEncoder(beat, config.Codec)
+			if err != nil {
+				return outputs.Fail(err)
+			}
+		} else {
+			enc = format.New(defaultMessage)
+		}
+
+		conn, err := transport.NewClient(transp, config.Network, host, port)
+		if err != nil {
+			return outputs.Fail(err)
+		}
+
+		encoder := &messageEncoder{
+			format:  config.Format,
+			framing: framing,
+			header: header{
+				hostname:        config.Hostname,
+				appName:         config.AppName,
+				procID:          config.ProcID,
+				msgID:           config.MsgID,
+				defaultHostname: beat.Hostname,
+				defaultAppName:  beat.Beat,
+			},
+			facility: facility,
+			severity: severity,
+			codec:    enc,
+			index:    beat.Beat,
+		}
+
+		var client outputs.NetworkClient = newClient(conn, observer, encoder, config.Timeout)
+		client = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
+		clients[i] = client
+	}
+
+	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+}
@@ -0,0 +1,288 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const (
+	csvFilePathField   = "log.file.path"
+	csvFileOffsetField = "log.offset"
+
+	// headers of files not seen for this time are forgotten, and read again
+	// from the file if needed.
+	fileHeadersExpiration = time.Hour
+)
+
+type decodeCSVFields struct {
+	csvConfig
+	fields    map[string]string
+	separator rune
+
+	// headers read from the first line of files, indexed by file path.
+	mu          sync.Mutex
+	fileHeaders *common.Cache
+}
+
+type csvConfig struct {
+	Fields           common.MapStr `config:"fields"`
+	IgnoreMissing    bool          `config:"ignore_missing"`
+	TrimLeadingSpace bool          `config:"trim_leading_space"`
+	LazyQuotes       bool          `config:"lazy_quotes"`
+	OverwriteKeys    bool          `config:"overwrite_keys"`
+	FailOnError      bool          `config:"fail_on_error"`
+	Separator        string        `config:"separator"`
+	Headers          []string      `config:"headers"`
+	HeadersFromFile  bool          `config:"headers_from_file"`
+}
+
+var defaultCSVConfig = csvConfig{
+	Separator:   ",",
+	FailOnError: true,
+}
+
+func init() {
+	processors.RegisterPlugin("decode_csv_fields",
+		configChecked(NewDecodeCSVField,
+			requireFields("fields"),
+			allowedFields("fields", "ignore_missing", "trim_leading_space", "lazy_quotes",
+				"overwrite_keys", "fail_on_error", "separator", "headers", "headers_from_file", "when")))
+}
+
+// NewDecodeCSVField construct a new decode_csv_fields processor.
+func NewDecodeCSVField(c *common.Config) (processors.Processor, error) {
+	config := defaultCSVConfig
+
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the decode_csv_fields configuration: %s", err)
+	}
+
+	return newDecodeCSVFields(config)
+}
+
+func newDecodeCSVFields(c csvConfig) (*decodeCSVFields, error) {
+	if utf8.RuneCountInString(c.Separator) != 1 {
+		return nil, fmt.Errorf("separator must be a single character, got %d in string '%s'",
+			utf8.RuneCountInString(c.Separator), c.Separator)
+	}
+	if len(c.Headers) > 0 && c.HeadersFromFile {
+		return nil, errors.New("headers and headers_from_file can not be used together")
+	}
+
+	f := &decodeCSVFields{
+		csvConfig:   c,
+		fields:      make(map[string]string, len(c.Fields)),
+		fileHeaders: common.NewCache(fileHeadersExpiration, 0),
+	}
+	f.separator, _ = utf8.DecodeRuneInString(c.Separator)
+
+	for src, dstIf := range c.Fields.Flatten() {
+		dst, ok := dstIf.(string)
+		if !ok {
+			return nil, fmt.Errorf("bad destination mapping for %s: destination field must be string, not %T (got %v)", src, dstIf, dstIf)
+		}
+		f.fields[src] = dst
+	}
+	if len(f.fields) == 0 {
+		return nil, errors.New("no fields to decode configured")
+	}
+	return f, nil
+}
+
+// Run applies the decode_csv_fields processor to an event.
+func (f *decodeCSVFields) Run(event *beat.Event) (*beat.Event, error) {
+	// Creates a copy of the event to revert in case of failure
+	var backup common.MapStr
+	if f.FailOnError {
+		backup = event.Fields.Clone()
+	}
+
+	for src, dest := range f.fields {
+		drop, err := f.decodeCSVField(src, dest, event)
+		if err != nil {
+			if f.FailOnError {
+				event.Fields = backup
+				event.PutValue("error.message", err.Error())
+				return event, err
+			}
+			continue
+		}
+		if drop {
+			return nil, nil
+		}
+	}
+
+	return event, nil
+}
+
+// decodeCSVField decodes the CSV record in src and stores it in dest. It
+// returns true if the event contains the header line of a file and should be
+// dropped.
+func (f *decodeCSVFields) decodeCSVField(src, dest string, event *beat.Event) (bool, error) {
+	data, err := event.GetValue(src)
+	if err != nil {
+		if f.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "could not fetch value for field %s", src)
+	}
+
+	text, ok := data.(string)
+	if !ok {
+		return false, fmt.Errorf("field %s is not of string type", src)
+	}
+
+	record, err := f.parse(text)
+	if err != nil {
+		return false, errors.Wrapf(err, "error decoding CSV from field %s", src)
+	}
+
+	headers := f.Headers
+	if f.HeadersFromFile {
+		path, isHeader := f.fileHeaderLine(event)
+		if isHeader {
+			f.setFileHeaders(path, record)
+			return true, nil
+		}
+		if headers, err = f.readFileHeaders(path); err != nil {
+			return false, err
+		}
+	}
+
+	var value interface{} = record
+	if len(headers) > 0 {
+		if len(headers) != len(record) {
+			return false, fmt.Errorf("CSV record in field %s has %d values, expected %d", src, len(record), len(headers))
+		}
+		fields := common.MapStr{}
+		for i, h := range headers {
+			fields[h] = record[i]
+		}
+		value = fields
+	}
+
+	if dest == "" {
+		dest = src
+	} else if dest != src && !f.OverwriteKeys {
+		if _, err = event.GetValue(dest); err == nil {
+			return false, fmt.Errorf("target field %s already has a value. Set the overwrite_keys flag or drop/rename the field first", dest)
+		}
+	}
+	if _, err = event.PutValue(dest, value); err != nil {
+		return false, errors.Wrapf(err, "failed setting field %s", dest)
+	}
+	return false, nil
+}
+
+func (f *decodeCSVFields) parse(text string) ([]string, error) {
+	reader := f.newReader(strings.NewReader(text))
+	record, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := reader.Read(); err == nil {
+		return nil, errors.New("multiple lines in CSV record")
+	}
+	return record, nil
+}
+
+func (f *decodeCSVFields) newReader(r *strings.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = f.separator
+	reader.TrimLeadingSpace = f.TrimLeadingSpace
+	reader.LazyQuotes = f.LazyQuotes
+	reader.FieldsPerRecord = -1
+	return reader
+}
+
+// fileHeaderLine returns the path of the file the event was read from, and
+// whether the event contains the first line of the file.
+func (f *decodeCSVFields) fileHeaderLine(event *beat.Event) (string, bool) {
+	var path string
+	if v, err := event.GetValue(csvFilePathField); err == nil {
+		path, _ = v.(string)
+	}
+
+	offset, err := event.GetValue(csvFileOffsetField)
+	if err != nil {
+		return path, false
+	}
+	n, ok := common.TryToInt64(offset)
+	return path, ok && n == 0
+}
+
+func (f *decodeCSVFields) setFileHeaders(path string, headers []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fileHeaders.CleanUp()
+	f.fileHeaders.Put(path, headers)
+}
+
+// readFileHeaders returns the headers for the given file. Headers are read
+// from the first line of the file the first time a file is seen.
+func (f *decodeCSVFields) readFileHeaders(path string) ([]string, error) {
+	if path == "" {
+		return nil, fmt.Errorf("field %s is required to read headers from file", csvFilePathField)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if headers, found := f.fileHeaders.Get(path).([]string); found {
+		return headers, nil
+	}
+	f.fileHeaders.CleanUp()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read CSV headers")
+	}
+	defer file.Close()
+
+	line, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil && line == "" {
+		return nil, errors.Wrapf(err, "failed to read CSV headers from %s", path)
+	}
+
+	headers, err := f.parse(strings.TrimRight(line, "\r\n"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse CSV headers from %s", path)
+	}
+	f.fileHeaders.Put(path, headers)
+	return headers, nil
+}
+
+func (f *decodeCSVFields) String() string {
+	return fmt.Sprintf("decode_csv_fields=[fields=%v, separator=%q, headers=%v, headers_from_file=%v]",
+		f.fields, f.separator, f.Headers, f.HeadersFromFile)
+}
@@ -0,0 +1,272 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestDecodeCSVField(t *testing.T) {
+	var testCases = map[string]struct {
+		config   common.MapStr
+		input    common.MapStr
+		expected common.MapStr
+		fail     bool
+	}{
+		"self target": {
+			config: common.MapStr{
+				"fields": common.MapStr{"message": ""},
+			},
+			input: common.MapStr{
+				"message": "17,192.168.33.1,8.8.8.8",
+			},
+			expected: common.MapStr{
+				"message": []string{"17", "192.168.33.1", "8.8.8.8"},
+			},
+		},
+		"alternative target": {
+			config: common.MapStr{
+				"fields": common.MapStr{"message": "my.field"},
+			},
+			input: common.MapStr{
+				"message": "17,192.168.33.1,8.8.8.8",
+			},
+			expected: common.MapStr{
+				"message": "17,192.168.33.1,8.8.8.8",
+				"my":      common.MapStr{"field": []string{"17", "192.168.33.1", "8.8.8.8"}},
+			},
+		},
+		"non-string field": {
+			config: common.MapStr{
+				"fields": common.MapStr{"message": "csv"},
+			},
+			input:    common.MapStr{"message": 42},
+			expected: common.MapStr{"message": 42, "error": common.MapStr{"message": "field message is not of string type"}},
+			fail:     true,
+		},
+		"custom separator and trimming": {
+			config: common.MapStr{
+				"fields":             common.MapStr{"message": "csv"},
+				"separator":          ";",
+				"trim_leading_space": true,
+			},
+			input: common.MapStr{
+				"message": "hello; world; ; \"quoted; value\"",
+			},
+			expected: common.MapStr{
+				"message": "hello; world; ; \"quoted; value\"",
+				"csv":     []string{"hello", "world", "", "quoted; value"},
+			},
+		},
+		"lazy quotes": {
+			config: common.MapStr{
+				"fields":      common.MapStr{"message": "csv"},
+				"lazy_quotes": true,
+			},
+			input: common.MapStr{"message": `a "quoted" word,b`},
+			expected: common.MapStr{
+				"message": `a "quoted" word,b`,
+				"csv":     []string{`a "quoted" word`, "b"},
+			},
+		},
+		"bare quote fails": {
+			config: common.MapStr{
+				"fields": common.MapStr{"message": "csv"},
+			},
+			input: common.MapStr{"message": `a "quoted" word,b`},
+			fail:  true,
+		},
+		"headers": {
+			config: common.MapStr{
+				"fields":  common.MapStr{"message": "fw"},
+				"headers": []string{"action", "src", "dst"},
+			},
+			input: common.MapStr{"message": "deny,10.0.0.1,10.0.0.2"},
+			expected: common.MapStr{
+				"message": "deny,10.0.0.1,10.0.0.2",
+				"fw":      common.MapStr{"action": "deny", "src": "10.0.0.1", "dst": "10.0.0.2"},
+			},
+		},
+		"headers count mismatch": {
+			config: common.MapStr{
+				"fields":  common.MapStr{"message": "fw"},
+				"headers": []string{"action", "src"},
+			},
+			input: common.MapStr{"message": "deny,10.0.0.1,10.0.0.2"},
+			fail:  true,
+		},
+		"ignore missing": {
+			config: common.MapStr{
+				"fields":         common.MapStr{"message": "csv"},
+				"ignore_missing": true,
+			},
+			input:    common.MapStr{"other": "a,b"},
+			expected: common.MapStr{"other": "a,b"},
+		},
+		"target exists": {
+			config: common.MapStr{
+				"fields": common.MapStr{"message": "csv"},
+			},
+			input: common.MapStr{"message": "a,b", "csv": "x"},
+			fail:  true,
+		},
+		"overwrite keys": {
+			config: common.MapStr{
+				"fields":         common.MapStr{"message": "csv"},
+				"overwrite_keys": true,
+			},
+			input:    common.MapStr{"message": "a,b", "csv": "x"},
+			expected: common.MapStr{"message": "a,b", "csv": []string{"a", "b"}},
+		},
+		"multiple lines": {
+			config: common.MapStr{
+				"fields": common.MapStr{"message": "csv"},
+			},
+			input: common.MapStr{"message": "a,b\nc,d"},
+			fail:  true,
+		},
+		"do not fail on error": {
+			config: common.MapStr{
+				"fields":        common.MapStr{"message": "csv", "other": "csv2"},
+				"fail_on_error": false,
+			},
+			input: common.MapStr{"message": 1, "other": "a,b"},
+			expected: common.MapStr{
+				"message": 1,
+				"other":   "a,b",
+				"csv2":    []string{"a", "b"},
+			},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			processor, err := NewDecodeCSVField(common.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+
+			event, err := processor.Run(&beat.Event{Fields: test.input.Clone()})
+			if test.fail {
+				assert.Error(t, err)
+				if test.expected == nil {
+					return
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestDecodeCSVFieldHeadersFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "decode_csv_fields")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fw.csv")
+	require.NoError(t, ioutil.WriteFile(path, []byte("action,src,dst\r\ndeny,10.0.0.1,10.0.0.2\r\n"), 0644))
+
+	newEvent := func(message string, offset int64) *beat.Event {
+		return &beat.Event{Fields: common.MapStr{
+			"message": message,
+			"log":     common.MapStr{"offset": offset, "file": common.MapStr{"path": path}},
+		}}
+	}
+
+	processor, err := NewDecodeCSVField(common.MustNewConfigFrom(common.MapStr{
+		"fields":            common.MapStr{"message": "fw"},
+		"headers_from_file": true,
+	}))
+	require.NoError(t, err)
+
+	// headers are read from the file if the first line has not been seen
+	event, err := processor.Run(newEvent("deny,10.0.0.1,10.0.0.2", 16))
+	require.NoError(t, err)
+	fw, _ := event.GetValue("fw")
+	assert.Equal(t, common.MapStr{"action": "deny", "src": "10.0.0.1", "dst": "10.0.0.2"}, fw)
+
+	// the header line updates the headers and is dropped
+	event, err = processor.Run(newEvent("verdict,from,to", 0))
+	require.NoError(t, err)
+	assert.Nil(t, event)
+
+	event, err = processor.Run(newEvent("allow,10.0.0.3,10.0.0.4", 16))
+	require.NoError(t, err)
+	fw, _ = event.GetValue("fw")
+	assert.Equal(t, common.MapStr{"verdict": "allow", "from": "10.0.0.3", "to": "10.0.0.4"}, fw)
+
+	// events without a file path can't be decoded
+	_, err = processor.Run(&beat.Event{Fields: common.MapStr{"message": "a,b,c"}})
+	assert.Error(t, err)
+}
+
+func TestDecodeCSVFieldHeadersExpiration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "decode_csv_fields")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	processor, err := newDecodeCSVFields(csvConfig{
+		Fields:          common.MapStr{"message": "fw"},
+		Separator:       ",",
+		HeadersFromFile: true,
+	})
+	require.NoError(t, err)
+	processor.fileHeaders = common.NewCache(10*time.Millisecond, 0)
+
+	for i, name := range []string{"a.csv", "b.csv"} {
+		if i > 0 {
+			time.Sleep(20 * time.Millisecond)
+		}
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, []byte("action\ndeny\n"), 0644))
+		_, err = processor.Run(&beat.Event{Fields: common.MapStr{
+			"message": "deny",
+			"log":     common.MapStr{"offset": int64(7), "file": common.MapStr{"path": path}},
+		}})
+		require.NoError(t, err)
+	}
+
+	// the headers of the first file expired and are removed
+	assert.Equal(t, 1, processor.fileHeaders.Size())
+}
+
+func TestDecodeCSVFieldInvalidConfig(t *testing.T) {
+	cases := map[string]common.MapStr{
+		"no fields":             {},
+		"long separator":        {"fields": common.MapStr{"message": "csv"}, "separator": ";;"},
+		"non-string target":     {"fields": common.MapStr{"message": 1}},
+		"headers and from file": {"fields": common.MapStr{"message": "csv"}, "headers": []string{"a"}, "headers_from_file": true},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDecodeCSVField(common.MustNewConfigFrom(settings))
+			assert.Error(t, err)
+		})
+	}
+}
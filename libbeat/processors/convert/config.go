@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package convert
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	Fields        []field `config:"fields" validate:"required"`
+	IgnoreMissing bool    `config:"ignore_missing"`
+	FailOnError   bool    `config:"fail_on_error"`
+}
+
+type field struct {
+	From string   `config:"from" validate:"required"`
+	To   string   `config:"to"`
+	Type dataType `config:"type"`
+}
+
+func defaultConfig() config {
+	return config{
+		FailOnError: true,
+	}
+}
+
+func (f *field) Validate() error {
+	if f.Type == unset {
+		return errors.Errorf("missing type for field '%v'", f.From)
+	}
+	return nil
+}
+
+type dataType uint8
+
+const (
+	unset dataType = iota
+	typeInteger
+	typeLong
+	typeFloat
+	typeDouble
+	typeBoolean
+	typeString
+	typeIP
+)
+
+var dataTypeNames = map[dataType]string{
+	typeInteger: "integer",
+	typeLong:    "long",
+	typeFloat:   "float",
+	typeDouble:  "double",
+	typeBoolean: "boolean",
+	typeString:  "string",
+	typeIP:      "ip",
+}
+
+func (t dataType) String() string {
+	if name, found := dataTypeNames[t]; found {
+		return name
+	}
+	return "unset"
+}
+
+func (t *dataType) Unpack(s string) error {
+	for dt, name := range dataTypeNames {
+		if strings.EqualFold(name, s) {
+			*t = dt
+			return nil
+		}
+	}
+	return errors.Errorf("invalid data type '%v'", s)
+}
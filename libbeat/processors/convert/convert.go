@@ -0,0 +1,188 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package convert
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const logName = "processor.convert"
+
+func init() {
+	processors.RegisterPlugin("convert", New)
+}
+
+type processor struct {
+	config
+	log *logp.Logger
+}
+
+// New constructs a new convert processor. The processor converts the values
+// of the configured fields to the given data types, optionally writing the
+// converted value to a different field.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the convert configuration")
+	}
+
+	return newFromConfig(c), nil
+}
+
+func newFromConfig(c config) *processor {
+	return &processor{config: c, log: logp.NewLogger(logName)}
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	// Creates a copy of the event to revert in case of failure
+	var backup common.MapStr
+	if p.FailOnError {
+		backup = event.Fields.Clone()
+	}
+
+	for _, f := range p.Fields {
+		err := p.convertField(event, f)
+		if err == nil {
+			continue
+		}
+
+		if p.FailOnError {
+			event.Fields = backup
+			event.PutValue("error.message", err.Error())
+			return event, err
+		}
+		p.log.Debugw("Failed to convert field.", "error", err)
+	}
+
+	return event, nil
+}
+
+func (p *processor) convertField(event *beat.Event, f field) error {
+	v, err := event.GetValue(f.From)
+	if err != nil {
+		if p.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "could not fetch value for key '%v'", f.From)
+	}
+
+	converted, err := convertValue(v, f.Type)
+	if err != nil {
+		return errors.Wrapf(err, "unable to convert field '%v' to %v", f.From, f.Type)
+	}
+
+	to := f.To
+	if to == "" {
+		to = f.From
+	}
+	if _, err := event.PutValue(to, converted); err != nil {
+		return errors.Wrapf(err, "could not put value in field '%v'", to)
+	}
+	return nil
+}
+
+func convertValue(v interface{}, t dataType) (interface{}, error) {
+	switch t {
+	case typeInteger:
+		i, ok := common.TryToInt64(v)
+		if !ok {
+			return nil, invalidValue(v)
+		}
+		if i > math.MaxInt32 || i < math.MinInt32 {
+			return nil, errors.Errorf("value '%v' out of range for integer", v)
+		}
+		return int32(i), nil
+	case typeLong:
+		i, ok := common.TryToInt64(v)
+		if !ok {
+			return nil, invalidValue(v)
+		}
+		return i, nil
+	case typeFloat:
+		f, ok := common.TryToFloat64(v)
+		if !ok {
+			return nil, invalidValue(v)
+		}
+		return float32(f), nil
+	case typeDouble:
+		f, ok := common.TryToFloat64(v)
+		if !ok {
+			return nil, invalidValue(v)
+		}
+		return f, nil
+	case typeBoolean:
+		b, ok := common.TryToBool(v)
+		if !ok {
+			return nil, invalidValue(v)
+		}
+		return b, nil
+	case typeString:
+		return toString(v)
+	case typeIP:
+		s, ok := v.(string)
+		if !ok {
+			return nil, invalidValue(v)
+		}
+		s = strings.TrimSpace(s)
+		if net.ParseIP(s) == nil {
+			return nil, errors.Errorf("value '%v' is not a valid IP address", v)
+		}
+		return s, nil
+	}
+	return nil, errors.Errorf("unsupported data type %v", t)
+}
+
+func toString(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case string:
+		return val, nil
+	case float32:
+		return strconv.FormatFloat(float64(val), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(val), nil
+	case fmt.Stringer:
+		return val.String(), nil
+	}
+	return "", invalidValue(v)
+}
+
+func invalidValue(v interface{}) error {
+	return errors.Errorf("invalid value '%v' of type %T", v, v)
+}
+
+func (p *processor) String() string {
+	fields := make([]string, len(p.Fields))
+	for i, f := range p.Fields {
+		fields[i] = fmt.Sprintf("{from=%v, to=%v, type=%v}", f.From, f.To, f.Type)
+	}
+	return fmt.Sprintf("convert=[fields=[%v], ignore_missing=%v, fail_on_error=%v]",
+		strings.Join(fields, ", "), p.IgnoreMissing, p.FailOnError)
+}
@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newTestProcessor(t *testing.T, settings map[string]interface{}) *processor {
+	c := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&c))
+	return newFromConfig(c)
+}
+
+func TestConvert(t *testing.T) {
+	cases := map[string]struct {
+		value    interface{}
+		typ      string
+		expected interface{}
+	}{
+		"string to integer":  {"42", "integer", int32(42)},
+		"float to integer":   {float64(42), "integer", int32(42)},
+		"string to long":     {"4294967296", "long", int64(4294967296)},
+		"string to float":    {"4.5", "float", float32(4.5)},
+		"integer to double":  {3, "double", float64(3)},
+		"string to boolean":  {"true", "boolean", true},
+		"integer to string":  {200, "string", "200"},
+		"double to string":   {1.25, "string", "1.25"},
+		"boolean to string":  {false, "string", "false"},
+		"ipv4":               {"192.168.1.1", "ip", "192.168.1.1"},
+		"ipv6 with spaces":   {" ::1 ", "ip", "::1"},
+		"case insensitive":   {"1", "Long", int64(1)},
+		"string is no-op":    {"abc", "string", "abc"},
+		"negative integer":   {"-12", "integer", int32(-12)},
+		"boolean from short": {"F", "boolean", false},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := newTestProcessor(t, map[string]interface{}{
+				"fields": []map[string]interface{}{
+					{"from": "source", "to": "target", "type": test.typ},
+				},
+			})
+
+			event, err := p.Run(&beat.Event{Fields: common.MapStr{"source": test.value}})
+			require.NoError(t, err)
+			v, err := event.GetValue("target")
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, v)
+			assert.Equal(t, test.value, event.Fields["source"])
+		})
+	}
+}
+
+func TestConvertInPlace(t *testing.T) {
+	p := newTestProcessor(t, map[string]interface{}{
+		"fields": []map[string]interface{}{
+			{"from": "http.status", "type": "long"},
+			{"from": "@metadata.retry", "type": "boolean"},
+		},
+	})
+
+	event, err := p.Run(&beat.Event{
+		Meta:   common.MapStr{"retry": "true"},
+		Fields: common.MapStr{"http": common.MapStr{"status": "404"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"http": common.MapStr{"status": int64(404)}}, event.Fields)
+	assert.Equal(t, common.MapStr{"retry": true}, event.Meta)
+}
+
+func TestFailOnError(t *testing.T) {
+	settings := map[string]interface{}{
+		"fields": []map[string]interface{}{
+			{"from": "a", "type": "integer"},
+			{"from": "b", "type": "ip"},
+		},
+	}
+
+	p := newTestProcessor(t, settings)
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"a": "1", "b": "not-an-ip"}})
+	assert.Error(t, err)
+	assert.Equal(t, "1", event.Fields["a"], "event must be restored on failure")
+	assert.Contains(t, event.Fields, "error")
+
+	settings["fail_on_error"] = false
+	p = newTestProcessor(t, settings)
+	event, err = p.Run(&beat.Event{Fields: common.MapStr{"a": "1", "b": "not-an-ip"}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{"a": int32(1), "b": "not-an-ip"}, event.Fields)
+}
+
+func TestIntegerOutOfRange(t *testing.T) {
+	_, err := convertValue("2147483648", typeInteger)
+	assert.Error(t, err)
+
+	v, err := convertValue("2147483648", typeLong)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2147483648), v)
+}
+
+func TestIgnoreMissing(t *testing.T) {
+	settings := map[string]interface{}{
+		"fields": []map[string]interface{}{{"from": "missing", "type": "long"}},
+	}
+
+	_, err := newTestProcessor(t, settings).Run(&beat.Event{Fields: common.MapStr{}})
+	assert.Error(t, err)
+
+	settings["ignore_missing"] = true
+	event, err := newTestProcessor(t, settings).Run(&beat.Event{Fields: common.MapStr{}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{}, event.Fields)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no fields":    {},
+		"missing from": {"fields": []map[string]interface{}{{"type": "long"}}},
+		"missing type": {"fields": []map[string]interface{}{{"from": "a"}}},
+		"invalid type": {"fields": []map[string]interface{}{{"from": "a", "type": "date"}}},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(common.MustNewConfigFrom(settings))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -21,11 +21,15 @@ type config struct {
 	Tokenizer    *tokenizer `config:"tokenizer" validate:"required"`
 	Field        string     `config:"field"`
 	TargetPrefix string     `config:"target_prefix"`
+	TrimValues   trimMode   `config:"trim_values"`
+	TrimChars    string     `config:"trim_chars"`
 }
 
 var defaultConfig = config{
 	Field:        "message",
 	TargetPrefix: "dissect",
+	TrimValues:   trimNone,
+	TrimChars:    " ",
 }
 
 // tokenizer add validation at the unpack level for this specific field.
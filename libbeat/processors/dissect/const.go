@@ -38,6 +38,7 @@ var (
 	indirectAppendPrefix = "&+"
 	greedySuffix         = "->"
 	pointerFieldPrefix   = "*"
+	dataTypeIndicator    = "|"
 
 	defaultJoinString = " "
 
@@ -47,4 +48,5 @@ var (
 	errMixedPrefixIndirectAppend = errors.New("mixed prefix `&+`")
 	errMixedPrefixAppendIndirect = errors.New("mixed prefix `&+`")
 	errEmptyKey                  = errors.New("empty key")
+	errDataTypeNotSupported      = errors.New("data type conversion is not supported on skip and pointer fields")
 )
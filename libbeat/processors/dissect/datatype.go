@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dissect
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// dataType is the type a key is converted to, defined with the following syntax: `%{key|type}`.
+//
+// dissect: %{bytes|integer} %{ok|boolean}
+// message: 1024 true
+// result:
+//	bytes: 1024 (int32)
+//	ok: true (bool)
+type dataType uint8
+
+const (
+	typeString dataType = iota
+	typeInteger
+	typeLong
+	typeFloat
+	typeDouble
+	typeBoolean
+	typeIP
+)
+
+var dataTypeNames = map[string]dataType{
+	"integer": typeInteger,
+	"long":    typeLong,
+	"float":   typeFloat,
+	"double":  typeDouble,
+	"boolean": typeBoolean,
+	"ip":      typeIP,
+}
+
+func newDataType(name string) (dataType, error) {
+	t, found := dataTypeNames[name]
+	if !found {
+		return typeString, fmt.Errorf("unknown data type '%s'", name)
+	}
+	return t, nil
+}
+
+// convert converts the extracted value into the data type.
+func (t dataType) convert(s string) (interface{}, error) {
+	switch t {
+	case typeInteger:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return int32(v), err
+	case typeLong:
+		return strconv.ParseInt(s, 10, 64)
+	case typeFloat:
+		v, err := strconv.ParseFloat(s, 32)
+		return float32(v), err
+	case typeDouble:
+		return strconv.ParseFloat(s, 64)
+	case typeBoolean:
+		return strconv.ParseBool(s)
+	case typeIP:
+		if net.ParseIP(s) == nil {
+			return nil, fmt.Errorf("'%s' is not a valid IP address", s)
+		}
+		return s, nil
+	default:
+		return s, nil
+	}
+}
+
+func (t dataType) String() string {
+	for name, id := range dataTypeNames {
+		if id == t {
+			return name
+		}
+	}
+	return "string"
+}
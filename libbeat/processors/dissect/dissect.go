@@ -22,6 +22,10 @@ import "fmt"
 // Map  represents the keys and their values extracted with the defined tokenizer.
 type Map = map[string]string
 
+// MapConverted represents the keys and their values extracted with the defined tokenizer, with
+// the values converted to the data types defined in the tokenizer.
+type MapConverted = map[string]interface{}
+
 // positions represents the start and end position of the keys found in the string.
 type positions []position
 
@@ -35,6 +39,7 @@ type position struct {
 type Dissector struct {
 	raw    string
 	parser *parser
+	trim   trimmer
 }
 
 // Dissect takes the raw string and will use the defined tokenizer to return a map with the
@@ -58,7 +63,61 @@ func (d *Dissector) Dissect(s string) (Map, error) {
 		return nil, errParsingFailure
 	}
 
-	return d.resolve(s, positions), nil
+	m := d.resolve(s, positions)
+	d.removeReferenceFields(m)
+	return m, nil
+}
+
+// DissectConvert works like Dissect, but converts the extracted values to the data types
+// defined in the tokenizer. Values of keys without data type are returned as strings.
+func (d *Dissector) DissectConvert(s string) (MapConverted, error) {
+	if len(s) == 0 {
+		return nil, errEmpty
+	}
+
+	positions, err := d.extract(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(positions) == 0 {
+		return nil, errParsingFailure
+	}
+
+	m := d.resolve(s, positions)
+
+	// Collect the data types before removing the reference fields, the names of indirect fields
+	// are only known from the extracted references.
+	types := make(map[string]dataType)
+	for _, f := range d.parser.fields {
+		if f.DataType() == typeString {
+			continue
+		}
+
+		key := f.Key()
+		if isIndirectField(f) {
+			key = m[key]
+		}
+		types[key] = f.DataType()
+	}
+
+	d.removeReferenceFields(m)
+
+	converted := make(MapConverted, len(m))
+	for k, v := range m {
+		t, found := types[k]
+		if !found {
+			converted[k] = v
+			continue
+		}
+
+		value, err := t.convert(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert key '%s' to %v: %v", k, t, err)
+		}
+		converted[k] = value
+	}
+	return converted, nil
 }
 
 // Raw returns the raw tokenizer used to generate the actual parser.
@@ -130,13 +189,20 @@ func (d *Dissector) resolve(s string, p positions) Map {
 	m := make(Map, len(p))
 	for _, f := range d.parser.fields {
 		pos := p[f.ID()]
-		f.Apply(s[pos.start:pos.end], m)
+		v := s[pos.start:pos.end]
+		if d.trim != nil {
+			v = d.trim(v)
+		}
+		f.Apply(v, m)
 	}
+	return m
+}
 
+// removeReferenceFields removes the fields only required for indirection from the result.
+func (d *Dissector) removeReferenceFields(m Map) {
 	for _, f := range d.parser.referenceFields {
 		delete(m, f.Key())
 	}
-	return m
 }
 
 // New creates a new Dissector from a tokenized string.
@@ -89,6 +89,130 @@ func TestDissect(t *testing.T) {
 	}
 }
 
+func TestDissectConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		tok      string
+		msg      string
+		expected MapConverted
+	}{
+		{
+			name: "all types",
+			tok:  "%{a|integer} %{b|long} %{c|float} %{d|double} %{e|boolean} %{f|ip} %{g}",
+			msg:  "42 9000000000 1.5 2.25 true 10.0.0.1 text",
+			expected: MapConverted{
+				"a": int32(42),
+				"b": int64(9000000000),
+				"c": float32(1.5),
+				"d": float64(2.25),
+				"e": true,
+				"f": "10.0.0.1",
+				"g": "text",
+			},
+		},
+		{
+			name:     "append field converted after joining",
+			tok:      "%{+version|double}.%{+version}",
+			msg:      "4.2",
+			expected: MapConverted{"version": float64(4.2)},
+		},
+		{
+			name:     "indirect field",
+			tok:      "%{*key} %{&key|integer}",
+			msg:      "bytes 1024",
+			expected: MapConverted{"bytes": int32(1024)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := New(test.tok)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			r, err := d.DissectConvert(test.msg)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, test.expected, r)
+		})
+	}
+}
+
+func TestDissectConvertFailure(t *testing.T) {
+	tests := map[string]string{
+		"integer": "%{a|integer}",
+		"boolean": "%{a|boolean}",
+		"ip":      "%{a|ip}",
+	}
+
+	for name, tok := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := New(tok)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			_, err = d.DissectConvert("hello")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestInvalidDataType(t *testing.T) {
+	tests := map[string]string{
+		"skip field":    "%{|integer} %{b}",
+		"named skip":    "%{?a|integer} %{&a}",
+		"pointer field": "%{*a|integer} %{&a}",
+	}
+
+	for name, tok := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tok)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestKeyWithDataTypeIndicator(t *testing.T) {
+	// only known type names are data types
+	d, err := New("%{a|int} %{b|} %{c|d|long}")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	r, err := d.DissectConvert("1 2 3")
+	if assert.NoError(t, err) {
+		assert.Equal(t, MapConverted{"a|int": "1", "b|": "2", "c|d": int64(3)}, r)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	tests := []struct {
+		mode     trimMode
+		expected Map
+	}{
+		{mode: trimNone, expected: Map{"a": "  hello ", "b": " world"}},
+		{mode: trimLeft, expected: Map{"a": "hello ", "b": "world"}},
+		{mode: trimRight, expected: Map{"a": "  hello", "b": " world"}},
+		{mode: trimAll, expected: Map{"a": "hello", "b": "world"}},
+	}
+
+	for _, test := range tests {
+		d, err := New("[%{a}]|%{b}")
+		if !assert.NoError(t, err) {
+			return
+		}
+		d.trim = newTrimmer(test.mode, " ")
+
+		r, err := d.Dissect("[  hello ]| world")
+		if assert.NoError(t, err) {
+			assert.Equal(t, test.expected, r, "trim mode: %v", test.mode)
+		}
+	}
+}
+
 var results Map
 var o [][]string
 
@@ -32,13 +32,15 @@ type field interface {
 	Apply(b string, m Map)
 	String() string
 	IsSaveable() bool
+	DataType() dataType
 }
 
 type baseField struct {
-	id      int
-	key     string
-	ordinal int
-	greedy  bool
+	id       int
+	key      string
+	ordinal  int
+	greedy   bool
+	dataType dataType
 }
 
 func (f baseField) IsGreedy() bool {
@@ -65,8 +67,12 @@ func (f baseField) IsSaveable() bool {
 	return true
 }
 
+func (f baseField) DataType() dataType {
+	return f.dataType
+}
+
 func (f baseField) String() string {
-	return fmt.Sprintf("field: %s, ordinal: %d, greedy: %v", f.key, f.ordinal, f.IsGreedy())
+	return fmt.Sprintf("field: %s, ordinal: %d, greedy: %v, type: %v", f.key, f.ordinal, f.IsGreedy(), f.dataType)
 }
 
 // normalField is a simple key reference like this: `%{key}`
@@ -193,6 +199,12 @@ func newField(id int, rawKey string, previous delimiter) (field, error) {
 		return newSkipField(id), nil
 	}
 
+	rawKey, dataType := extractDataType(rawKey)
+
+	if len(rawKey) == 0 {
+		return nil, errDataTypeNotSupported
+	}
+
 	key, ordinal, greedy := extractKeyParts(rawKey)
 
 	// Conflicting prefix used.
@@ -204,6 +216,12 @@ func newField(id int, rawKey string, previous delimiter) (field, error) {
 		return nil, errMixedPrefixAppendIndirect
 	}
 
+	if strings.HasPrefix(key, skipFieldPrefix) || strings.HasPrefix(key, pointerFieldPrefix) {
+		if dataType != typeString {
+			return nil, errDataTypeNotSupported
+		}
+	}
+
 	if strings.HasPrefix(key, skipFieldPrefix) {
 		return newNamedSkipField(id, key[1:]), nil
 	}
@@ -213,14 +231,14 @@ func newField(id int, rawKey string, previous delimiter) (field, error) {
 	}
 
 	if strings.HasPrefix(key, appendFieldPrefix) {
-		return newAppendField(id, key[1:], ordinal, greedy, previous), nil
+		return newAppendField(id, key[1:], ordinal, greedy, dataType, previous), nil
 	}
 
 	if strings.HasPrefix(key, indirectFieldPrefix) {
-		return newIndirectField(id, key[1:]), nil
+		return newIndirectField(id, key[1:], dataType), nil
 	}
 
-	return newNormalField(id, key, ordinal, greedy), nil
+	return newNormalField(id, key, ordinal, greedy, dataType), nil
 }
 
 func newSkipField(id int) skipField {
@@ -239,38 +257,57 @@ func newPointerField(id int, key string) pointerField {
 	}
 }
 
-func newAppendField(id int, key string, ordinal int, greedy bool, previous delimiter) appendField {
+func newAppendField(id int, key string, ordinal int, greedy bool, dataType dataType, previous delimiter) appendField {
 	return appendField{
 		baseField: baseField{
-			id:      id,
-			key:     key,
-			ordinal: ordinal,
-			greedy:  greedy,
+			id:       id,
+			key:      key,
+			ordinal:  ordinal,
+			greedy:   greedy,
+			dataType: dataType,
 		},
 		previous: previous,
 	}
 }
 
-func newIndirectField(id int, key string) indirectField {
+func newIndirectField(id int, key string, dataType dataType) indirectField {
 	return indirectField{
 		baseField{
-			id:  id,
-			key: key,
+			id:       id,
+			key:      key,
+			dataType: dataType,
 		},
 	}
 }
 
-func newNormalField(id int, key string, ordinal int, greedy bool) normalField {
+func newNormalField(id int, key string, ordinal int, greedy bool, dataType dataType) normalField {
 	return normalField{
 		baseField{
-			id:      id,
-			key:     key,
-			ordinal: ordinal,
-			greedy:  greedy,
+			id:       id,
+			key:      key,
+			ordinal:  ordinal,
+			greedy:   greedy,
+			dataType: dataType,
 		},
 	}
 }
 
+// extractDataType splits the optional data type suffix from the key, e.g. `bytes|integer`.
+// The suffix is only a data type if it is a known type name, such that keys
+// containing the indicator are kept as is.
+func extractDataType(rawKey string) (string, dataType) {
+	i := strings.LastIndex(rawKey, dataTypeIndicator)
+	if i == -1 {
+		return rawKey, typeString
+	}
+
+	dataType, err := newDataType(rawKey[i+1:])
+	if err != nil {
+		return rawKey, typeString
+	}
+	return rawKey[:i], dataType
+}
+
 func extractKeyParts(rawKey string) (key string, ordinal int, greedy bool) {
 	m := suffixRE.FindAllStringSubmatch(rawKey, -1)
 
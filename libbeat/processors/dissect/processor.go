@@ -44,6 +44,7 @@ func NewProcessor(c *common.Config) (processors.Processor, error) {
 	if err != nil {
 		return nil, err
 	}
+	config.Tokenizer.trim = newTrimmer(config.TrimValues, config.TrimChars)
 	p := &processor{config: config}
 
 	return p, nil
@@ -61,7 +62,7 @@ func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
 		return event, fmt.Errorf("field is not a string, value: `%v`, field: `%s`", v, p.config.Field)
 	}
 
-	m, err := p.config.Tokenizer.Dissect(s)
+	m, err := p.config.Tokenizer.DissectConvert(s)
 	if err != nil {
 		if err := common.AddTagsWithKey(
 			event.Fields,
@@ -113,7 +114,7 @@ func (p *processor) String() string {
 		",target_prefix=" + p.config.TargetPrefix
 }
 
-func mapToMapStr(m Map) common.MapStr {
+func mapToMapStr(m MapConverted) common.MapStr {
 	newMap := make(common.MapStr, len(m))
 	for k, v := range m {
 		newMap[k] = v
@@ -31,19 +31,19 @@ func TestProcessor(t *testing.T) {
 		name   string
 		c      map[string]interface{}
 		fields common.MapStr
-		values map[string]string
+		values map[string]interface{}
 	}{
 		{
 			name:   "default field/default target",
 			c:      map[string]interface{}{"tokenizer": "hello %{key}"},
 			fields: common.MapStr{"message": "hello world"},
-			values: map[string]string{"dissect.key": "world"},
+			values: map[string]interface{}{"dissect.key": "world"},
 		},
 		{
 			name:   "default field/target root",
 			c:      map[string]interface{}{"tokenizer": "hello %{key}", "target_prefix": ""},
 			fields: common.MapStr{"message": "hello world"},
-			values: map[string]string{"key": "world"},
+			values: map[string]interface{}{"key": "world"},
 		},
 		{
 			name: "specific field/target root",
@@ -53,7 +53,7 @@ func TestProcessor(t *testing.T) {
 				"field":         "new_field",
 			},
 			fields: common.MapStr{"new_field": "hello world"},
-			values: map[string]string{"key": "world"},
+			values: map[string]interface{}{"key": "world"},
 		},
 		{
 			name: "specific field/specific target",
@@ -63,7 +63,7 @@ func TestProcessor(t *testing.T) {
 				"field":         "new_field",
 			},
 			fields: common.MapStr{"new_field": "hello world"},
-			values: map[string]string{"new_target.key": "world"},
+			values: map[string]interface{}{"new_target.key": "world"},
 		},
 		{
 			name: "extract to already existing namespace not conflicting",
@@ -73,7 +73,35 @@ func TestProcessor(t *testing.T) {
 				"field":         "message",
 			},
 			fields: common.MapStr{"message": "hello world super", "extracted": common.MapStr{"not": "hello"}},
-			values: map[string]string{"extracted.key": "world", "extracted.key2": "super", "extracted.not": "hello"},
+			values: map[string]interface{}{"extracted.key": "world", "extracted.key2": "super", "extracted.not": "hello"},
+		},
+		{
+			name: "convert data types",
+			c: map[string]interface{}{
+				"tokenizer":     "%{status|integer} %{bytes|long} %{ok|boolean}",
+				"target_prefix": "",
+			},
+			fields: common.MapStr{"message": "200 1024 true"},
+			values: map[string]interface{}{"status": int32(200), "bytes": int64(1024), "ok": true},
+		},
+		{
+			name: "trim values",
+			c: map[string]interface{}{
+				"tokenizer":   "|%{name}|%{count|integer}|",
+				"trim_values": "all",
+			},
+			fields: common.MapStr{"message": "|  nginx |   12|"},
+			values: map[string]interface{}{"dissect.name": "nginx", "dissect.count": int32(12)},
+		},
+		{
+			name: "trim custom characters",
+			c: map[string]interface{}{
+				"tokenizer":   "%{key}",
+				"trim_values": "right",
+				"trim_chars":  ".-",
+			},
+			fields: common.MapStr{"message": "hello.--"},
+			values: map[string]interface{}{"dissect.key": "hello"},
 		},
 	}
 
@@ -107,6 +135,29 @@ func TestProcessor(t *testing.T) {
 	}
 }
 
+func TestConversionFailure(t *testing.T) {
+	c, err := common.NewConfigFrom(map[string]interface{}{"tokenizer": "%{key|integer}"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	processor, err := NewProcessor(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	e := beat.Event{Fields: common.MapStr{"message": "hello"}}
+	newEvent, err := processor.Run(&e)
+	if !assert.Error(t, err) {
+		return
+	}
+
+	flags, err := newEvent.GetValue(beat.FlagField)
+	if assert.NoError(t, err) {
+		assert.Contains(t, flags, flagParsingError)
+	}
+}
+
 func TestFieldDoesntExist(t *testing.T) {
 	c, err := common.NewConfigFrom(map[string]interface{}{"tokenizer": "hello %{key}"})
 	if !assert.NoError(t, err) {
@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dissect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// trimMode selects which side of the extracted values padding characters are
+// removed from. Padding is often left behind by delimiters, when values are
+// aligned in columns.
+type trimMode uint8
+
+const (
+	trimNone trimMode = iota
+	trimLeft
+	trimRight
+	trimAll
+)
+
+var trimModeNames = map[string]trimMode{
+	"none":  trimNone,
+	"left":  trimLeft,
+	"right": trimRight,
+	"all":   trimAll,
+}
+
+// trimmer removes padding characters from extracted values.
+type trimmer func(string) string
+
+// Unpack unpacks a trim mode from its name.
+func (t *trimMode) Unpack(v string) error {
+	mode, found := trimModeNames[strings.ToLower(v)]
+	if !found {
+		return fmt.Errorf("unknown trim mode '%s'", v)
+	}
+	*t = mode
+	return nil
+}
+
+func newTrimmer(mode trimMode, chars string) trimmer {
+	if chars == "" {
+		return nil
+	}
+
+	switch mode {
+	case trimLeft:
+		return func(s string) string { return strings.TrimLeft(s, chars) }
+	case trimRight:
+		return func(s string) string { return strings.TrimRight(s, chars) }
+	case trimAll:
+		return func(s string) string { return strings.Trim(s, chars) }
+	default:
+		return nil
+	}
+}
@@ -32,7 +32,7 @@ func TestValidate(t *testing.T) {
 		{
 			name: "when we find reference field for all indirect field",
 			p: &parser{
-				fields:          []field{newIndirectField(1, "hello"), newNormalField(0, "hola", 1, false)},
+				fields:          []field{newIndirectField(1, "hello", typeString), newNormalField(0, "hola", 1, false, typeString)},
 				referenceFields: []field{newPointerField(2, "hello")},
 			},
 			expectError: false,
@@ -40,7 +40,7 @@ func TestValidate(t *testing.T) {
 		{
 			name: "when we cannot find all the reference field for all indirect field",
 			p: &parser{
-				fields:          []field{newIndirectField(1, "hello"), newNormalField(0, "hola", 1, false)},
+				fields:          []field{newIndirectField(1, "hello", typeString), newNormalField(0, "hola", 1, false, typeString)},
 				referenceFields: []field{newPointerField(2, "okhello")},
 			},
 			expectError: true,
@@ -0,0 +1,46 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	Fields        []string `config:"fields" validate:"required"`
+	Target        string   `config:"target_field"`
+	Method        method   `config:"method"`
+	Encoding      encoding `config:"encoding"`
+	Key           string   `config:"key"`
+	IgnoreMissing bool     `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		Target:   "fingerprint",
+		Method:   methods["sha256"],
+		Encoding: encodings["hex"],
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Key != "" && !c.Method.keyed {
+		return errors.Errorf("method '%v' does not support a key", c.Method.name)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+func init() {
+	processors.RegisterPlugin("fingerprint", New)
+}
+
+type processor struct {
+	config
+	fields []string
+	hash   func() hash.Hash
+}
+
+// New constructs a new fingerprint processor. The processor computes a hash
+// of the configured fields and stores it in the target field. Setting the
+// target field to `@metadata._id` configures the document ID used by the
+// Elasticsearch output.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the fingerprint configuration")
+	}
+
+	return newFromConfig(c), nil
+}
+
+func newFromConfig(c config) *processor {
+	// Fields are sorted, such that the fingerprint does not depend on the
+	// order of the fields in the configuration.
+	fields := append([]string(nil), c.Fields...)
+	sort.Strings(fields)
+
+	newHash := c.Method.new
+	if c.Key != "" {
+		key := []byte(c.Key)
+		newHash = func() hash.Hash { return hmac.New(c.Method.new, key) }
+	}
+
+	return &processor{config: c, fields: fields, hash: newHash}
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	h := p.hash()
+	if err := p.writeFields(h, event); err != nil {
+		return event, errors.Wrap(err, "failed to compute fingerprint")
+	}
+
+	if _, err := event.PutValue(p.Target, p.Encoding.encode(h.Sum(nil))); err != nil {
+		return event, errors.Wrapf(err, "failed to set fingerprint in field '%v'", p.Target)
+	}
+	return event, nil
+}
+
+// writeFields writes the field names and values to the hash.
+func (p *processor) writeFields(w io.Writer, event *beat.Event) error {
+	for _, field := range p.fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			if p.IgnoreMissing {
+				continue
+			}
+			return errors.Errorf("field '%v' not found", field)
+		}
+
+		if _, err := fmt.Fprintf(w, "|%v|%v", field, fingerprintValue(v)); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "|")
+	return err
+}
+
+// fingerprintValue returns a string representation of v that does not depend
+// on the timezone or map iteration order.
+func fingerprintValue(v interface{}) string {
+	switch val := v.(type) {
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case common.Time:
+		return time.Time(val).UTC().Format(time.RFC3339Nano)
+	case common.MapStr:
+		return fingerprintValue(map[string]interface{}(val))
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			parts[i] = k + ":" + fingerprintValue(val[k])
+		}
+		return "{" + strings.Join(parts, ",") + "}"
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("fingerprint=[method=%v, fields=[%v], target_field=%v, encoding=%v]",
+		p.Method.name, strings.Join(p.fields, ","), p.Target, p.Encoding.name)
+}
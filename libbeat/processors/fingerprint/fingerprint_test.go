@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func testEvent() *beat.Event {
+	return &beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"field1": "foo",
+			"field2": 42,
+			"nested": common.MapStr{"a": 1, "b": "x"},
+		},
+	}
+}
+
+func runProcessor(t *testing.T, settings map[string]interface{}) *beat.Event {
+	p, err := New(common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+
+	event, err := p.Run(testEvent())
+	require.NoError(t, err)
+	return event
+}
+
+func TestMethods(t *testing.T) {
+	cases := map[string]struct {
+		settings map[string]interface{}
+		expected string
+	}{
+		"sha256 default": {
+			settings: map[string]interface{}{},
+			expected: "53dfa2a1614febb8180d7c5360b4ee524084fe2d61751fa0ca646096ad1cd019",
+		},
+		"sha1": {
+			settings: map[string]interface{}{"method": "sha1"},
+			expected: "b60a03d615880ee4530dc10a4f5109b0766e3ea7",
+		},
+		"md5": {
+			settings: map[string]interface{}{"method": "md5"},
+			expected: "faf02712911751ff8e7712573d93e052",
+		},
+		"hmac sha256": {
+			settings: map[string]interface{}{"key": "secret"},
+			expected: "0a6413a95f6a2e470754114dca08150d75dfbb562bf3449164872af506e93c1a",
+		},
+		"sha1 base64": {
+			settings: map[string]interface{}{"method": "sha1", "encoding": "base64"},
+			expected: "tgoD1hWIDuRTDcEKT1EJsHZuPqc=",
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			settings := map[string]interface{}{"fields": []string{"field2", "field1"}}
+			for k, v := range test.settings {
+				settings[k] = v
+			}
+
+			event := runProcessor(t, settings)
+			v, err := event.GetValue("fingerprint")
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, v)
+		})
+	}
+}
+
+func TestXXHash(t *testing.T) {
+	settings := map[string]interface{}{
+		"fields": []string{"field1", "nested"},
+		"method": "xxhash",
+	}
+
+	first, _ := runProcessor(t, settings).GetValue("fingerprint")
+	second, _ := runProcessor(t, settings).GetValue("fingerprint")
+	assert.Len(t, first, 16)
+	assert.Equal(t, first, second)
+}
+
+func TestMetadataTarget(t *testing.T) {
+	event := runProcessor(t, map[string]interface{}{
+		"fields":       []string{"field1"},
+		"target_field": "@metadata._id",
+	})
+
+	require.NotNil(t, event.Meta)
+	assert.NotEmpty(t, event.Meta["_id"])
+	assert.NotContains(t, event.Fields, "fingerprint")
+}
+
+func TestMissingField(t *testing.T) {
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"field1", "missing"},
+	}))
+	require.NoError(t, err)
+	_, err = p.Run(testEvent())
+	assert.Error(t, err)
+
+	event := runProcessor(t, map[string]interface{}{
+		"fields":         []string{"field1", "missing"},
+		"ignore_missing": true,
+	})
+	assert.Contains(t, event.Fields, "fingerprint")
+}
+
+func TestMapValuesAreStable(t *testing.T) {
+	a := fingerprintValue(common.MapStr{"a": 1, "b": common.MapStr{"c": "x", "d": 2}})
+	b := fingerprintValue(map[string]interface{}{"b": map[string]interface{}{"d": 2, "c": "x"}, "a": 1})
+	assert.Equal(t, "{a:1,b:{c:x,d:2}}", a)
+	assert.Equal(t, a, b)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no fields":       {},
+		"invalid method":  {"fields": []string{"a"}, "method": "crc32"},
+		"invalid encode":  {"fields": []string{"a"}, "encoding": "ascii85"},
+		"key with xxhash": {"fields": []string{"a"}, "method": "xxhash", "key": "secret"},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(common.MustNewConfigFrom(settings))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	"github.com/OneOfOne/xxhash"
+	"github.com/pkg/errors"
+)
+
+// method is a hashing method supported by the fingerprint processor.
+type method struct {
+	name  string
+	new   func() hash.Hash
+	keyed bool // method supports HMAC
+}
+
+// encoding converts the hash sum into the target field value.
+type encoding struct {
+	name   string
+	encode func([]byte) string
+}
+
+var methods = map[string]method{
+	"md5":    {name: "md5", new: md5.New, keyed: true},
+	"sha1":   {name: "sha1", new: sha1.New, keyed: true},
+	"sha256": {name: "sha256", new: sha256.New, keyed: true},
+	"xxhash": {name: "xxhash", new: func() hash.Hash { return xxhash.New64() }},
+}
+
+var encodings = map[string]encoding{
+	"hex":    {name: "hex", encode: hex.EncodeToString},
+	"base32": {name: "base32", encode: base32.StdEncoding.EncodeToString},
+	"base64": {name: "base64", encode: base64.StdEncoding.EncodeToString},
+}
+
+// Unpack selects the hashing method by name.
+func (m *method) Unpack(v string) error {
+	found, exists := methods[strings.ToLower(v)]
+	if !exists {
+		return errors.Errorf("invalid fingerprint method '%v'", v)
+	}
+	*m = found
+	return nil
+}
+
+// Unpack selects the encoding by name.
+func (e *encoding) Unpack(v string) error {
+	found, exists := encodings[strings.ToLower(v)]
+	if !exists {
+		return errors.Errorf("invalid fingerprint encoding '%v'", v)
+	}
+	*e = found
+	return nil
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import "time"
+
+// bucket implements a token bucket. The bucket is refilled with `rate` tokens
+// per second, holding at most `burst` tokens. Each event consumes one token.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(burst float64, now time.Time) *bucket {
+	return &bucket{tokens: burst, last: now}
+}
+
+// allow refills the bucket and tries to consume one token. It returns false
+// if the bucket is empty.
+func (b *bucket) allow(rate, burst float64, now time.Time) bool {
+	b.refill(rate, burst, now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *bucket) refill(rate, burst float64, now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * rate
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+		b.last = now
+	}
+}
+
+// full returns true if the bucket has been refilled completely. A full bucket
+// is equivalent to a new bucket and can be removed.
+func (b *bucket) full(rate, burst float64, now time.Time) bool {
+	b.refill(rate, burst, now)
+	return b.tokens >= burst
+}
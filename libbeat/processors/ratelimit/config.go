@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	ID     string   `config:"id"`
+	Limit  rate     `config:"limit" validate:"required"`
+	Burst  int      `config:"burst" validate:"min=0"`
+	Fields []string `config:"fields"`
+	Action action   `config:"action"`
+	Tags   []string `config:"tags"`
+}
+
+// rate is the number of events allowed per time unit. It is configured as
+// `<count>/<unit>`, with unit being one of `s`, `m` or `h`.
+type rate struct {
+	count  float64
+	period time.Duration
+}
+
+// action selects the behavior for events exceeding the configured rate.
+type action uint8
+
+const (
+	actionDrop action = iota
+	actionTag
+)
+
+var actionNames = map[action]string{
+	actionDrop: "drop",
+	actionTag:  "tag",
+}
+
+var ratePeriods = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+}
+
+func defaultConfig() config {
+	return config{
+		Action: actionDrop,
+		Tags:   []string{"rate_limited"},
+	}
+}
+
+// Unpack parses a rate configured as `<count>/<unit>`.
+func (r *rate) Unpack(v string) error {
+	parts := strings.SplitN(v, "/", 2)
+	if len(parts) != 2 {
+		return errors.Errorf("invalid rate '%v', expected format is <count>/<unit>", v)
+	}
+
+	count, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || count <= 0 {
+		return errors.Errorf("invalid rate '%v', count must be a positive number", v)
+	}
+
+	period, found := ratePeriods[strings.TrimSpace(parts[1])]
+	if !found {
+		return errors.Errorf("invalid rate '%v', unit must be one of s, m, h", v)
+	}
+
+	*r = rate{count: count, period: period}
+	return nil
+}
+
+// perSecond returns the number of events allowed per second.
+func (r rate) perSecond() float64 {
+	return r.count / r.period.Seconds()
+}
+
+func (r rate) String() string {
+	unit := "s"
+	for name, period := range ratePeriods {
+		if period == r.period {
+			unit = name
+		}
+	}
+	return strconv.FormatFloat(r.count, 'f', -1, 64) + "/" + unit
+}
+
+// Unpack parses the action name.
+func (a *action) Unpack(v string) error {
+	for id, name := range actionNames {
+		if strings.ToLower(v) == name {
+			*a = id
+			return nil
+		}
+	}
+	return errors.Errorf("invalid rate_limit action '%v'", v)
+}
+
+func (a action) String() string {
+	if name, found := actionNames[a]; found {
+		return name
+	}
+	return "unknown (" + strconv.Itoa(int(a)) + ")"
+}
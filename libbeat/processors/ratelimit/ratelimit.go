@@ -0,0 +1,196 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const logName = "processor.rate_limit"
+
+// anonymousID is used to report the metrics of processors without id.
+const anonymousID = "anonymous"
+
+// gcInterval is the minimum time between removing buckets of keys that have
+// not seen any events long enough for the bucket to be refilled completely.
+const gcInterval = time.Minute
+
+func init() {
+	processors.RegisterPlugin("rate_limit", New)
+}
+
+type processor struct {
+	config
+	rate  float64
+	burst float64
+	log   *logp.Logger
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	lastGC  time.Time
+	clock   func() time.Time
+
+	dropped *monitoring.Int
+	tagged  *monitoring.Int
+}
+
+// New constructs a new rate_limit processor. Events exceeding the configured
+// rate are dropped or tagged. If fields are configured, a separate limit is
+// applied per distinct combination of field values.
+func New(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the rate_limit configuration")
+	}
+
+	log := logp.NewLogger(logName)
+	id := anonymousID
+	if c.ID != "" {
+		log = log.With("id", c.ID)
+		id = c.ID
+	}
+	return newFromConfig(c, getMetricsRegistry(id), log), nil
+}
+
+var metricsMu sync.Mutex
+
+// getMetricsRegistry returns the monitoring registry of the processor id. The
+// registry and its counters are shared by the processors with the same id,
+// including processors recreated on config reloads.
+func getMetricsRegistry(id string) *monitoring.Registry {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	namespace := logName + "." + id
+	if reg := monitoring.Default.GetRegistry(namespace); reg != nil {
+		return reg
+	}
+	return monitoring.Default.NewRegistry(namespace)
+}
+
+// getCounter returns the counter of the registry, creating it if needed.
+func getCounter(reg *monitoring.Registry, name string) *monitoring.Int {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	if counter, ok := reg.Get(name).(*monitoring.Int); ok {
+		return counter
+	}
+	return monitoring.NewInt(reg, name)
+}
+
+func newFromConfig(c config, metrics *monitoring.Registry, log *logp.Logger) *processor {
+	burst := float64(c.Burst)
+	if burst <= 0 {
+		burst = c.Limit.count
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &processor{
+		config:  c,
+		rate:    c.Limit.perSecond(),
+		burst:   burst,
+		log:     log,
+		buckets: map[string]*bucket{},
+		clock:   time.Now,
+		dropped: getCounter(metrics, "dropped"),
+		tagged:  getCounter(metrics, "tagged"),
+	}
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	if p.allow(p.key(event)) {
+		return event, nil
+	}
+
+	switch p.Action {
+	case actionTag:
+		p.tagged.Inc()
+		if err := common.AddTags(event.Fields, p.Tags); err != nil {
+			return event, err
+		}
+		return event, nil
+	default:
+		p.dropped.Inc()
+		return nil, nil
+	}
+}
+
+func (p *processor) allow(key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.clock()
+	p.gc(now)
+
+	b, found := p.buckets[key]
+	if !found {
+		b = newBucket(p.burst, now)
+		p.buckets[key] = b
+	}
+	return b.allow(p.rate, p.burst, now)
+}
+
+// gc removes buckets that have been refilled completely, such that keys not
+// seen anymore are not kept forever.
+func (p *processor) gc(now time.Time) {
+	if now.Sub(p.lastGC) < gcInterval {
+		return
+	}
+	p.lastGC = now
+
+	for key, b := range p.buckets {
+		if b.full(p.rate, p.burst, now) {
+			delete(p.buckets, key)
+		}
+	}
+}
+
+// key builds the bucket key from the configured fields. Missing fields are
+// treated as empty values.
+func (p *processor) key(event *beat.Event) string {
+	if len(p.Fields) == 0 {
+		return ""
+	}
+
+	values := make([]string, len(p.Fields))
+	for i, field := range p.Fields {
+		if v, err := event.GetValue(field); err == nil {
+			values[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(values, "\x00")
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("rate_limit=[limit=%v, burst=%v, fields=[%v], action=%v]",
+		p.Limit, p.burst, strings.Join(p.Fields, ","), p.Action)
+}
@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time              { return c.now }
+func (c *testClock) Advance(d time.Duration)     { c.now = c.now.Add(d) }
+func newTestClock() *testClock                   { return &testClock{now: time.Unix(1554200000, 0)} }
+func testEvent(fields common.MapStr) *beat.Event { return &beat.Event{Fields: fields} }
+
+func newTestProcessor(t *testing.T, settings map[string]interface{}) (*processor, *testClock) {
+	c := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&c))
+
+	clock := newTestClock()
+	p := newFromConfig(c, monitoring.NewRegistry(), logp.NewLogger(logName))
+	p.clock = clock.Now
+	return p, clock
+}
+
+func runEvents(t *testing.T, p *processor, n int, fields common.MapStr) int {
+	passed := 0
+	for i := 0; i < n; i++ {
+		event, err := p.Run(testEvent(fields.Clone()))
+		require.NoError(t, err)
+		if event != nil {
+			passed++
+		}
+	}
+	return passed
+}
+
+func TestNewDefaults(t *testing.T) {
+	_, err := New(common.MustNewConfigFrom(map[string]interface{}{"limit": "10/s"}))
+	assert.NoError(t, err)
+
+	_, err = New(common.NewConfig())
+	assert.Error(t, err)
+}
+
+func TestMetricsRegistry(t *testing.T) {
+	settings := map[string]interface{}{"limit": "1/h", "burst": 1, "id": "test_metrics"}
+	namespace := logName + ".test_metrics"
+	defer monitoring.Default.Remove(namespace)
+
+	for i := 1; i <= 2; i++ {
+		p, err := New(common.MustNewConfigFrom(settings))
+		require.NoError(t, err)
+		p.Run(&beat.Event{Fields: common.MapStr{}})
+		p.Run(&beat.Event{Fields: common.MapStr{}})
+
+		// recreating the processor keeps counting in the same registry
+		dropped := monitoring.Default.Get(namespace + ".dropped")
+		require.NotNil(t, dropped)
+		assert.Equal(t, int64(i), dropped.(*monitoring.Int).Get())
+	}
+}
+
+func TestMetricsRegistryWithoutID(t *testing.T) {
+	settings := map[string]interface{}{"limit": "1/h", "burst": 1}
+	namespace := logName + "." + anonymousID
+
+	var before int64
+	if dropped, ok := monitoring.Default.Get(namespace + ".dropped").(*monitoring.Int); ok {
+		before = dropped.Get()
+	}
+
+	p, err := New(common.MustNewConfigFrom(settings))
+	require.NoError(t, err)
+	p.Run(&beat.Event{Fields: common.MapStr{}})
+	p.Run(&beat.Event{Fields: common.MapStr{}})
+
+	dropped := monitoring.Default.Get(namespace + ".dropped")
+	require.NotNil(t, dropped)
+	assert.Equal(t, before+1, dropped.(*monitoring.Int).Get())
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"missing unit":   {"limit": "10"},
+		"invalid unit":   {"limit": "10/d"},
+		"invalid count":  {"limit": "ten/s"},
+		"negative count": {"limit": "-1/s"},
+		"invalid action": {"limit": "10/s", "action": "delay"},
+	}
+
+	for name, settings := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(common.MustNewConfigFrom(settings))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDropExceedingEvents(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{"limit": "10/s"})
+
+	assert.Equal(t, 10, runEvents(t, p, 15, common.MapStr{}))
+	assert.Equal(t, int64(5), p.dropped.Get())
+
+	// half a second refills 5 tokens
+	clock.Advance(500 * time.Millisecond)
+	assert.Equal(t, 5, runEvents(t, p, 10, common.MapStr{}))
+	assert.Equal(t, int64(10), p.dropped.Get())
+}
+
+func TestBurst(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{"limit": "60/m", "burst": 3})
+
+	assert.Equal(t, 3, runEvents(t, p, 5, common.MapStr{}))
+
+	clock.Advance(time.Second)
+	assert.Equal(t, 1, runEvents(t, p, 5, common.MapStr{}))
+
+	// the bucket never holds more than burst tokens
+	clock.Advance(time.Hour)
+	assert.Equal(t, 3, runEvents(t, p, 5, common.MapStr{}))
+}
+
+func TestLimitPerKey(t *testing.T) {
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"limit":  "2/s",
+		"fields": []string{"kubernetes.pod.name"},
+	})
+
+	noisy := common.MapStr{"kubernetes": common.MapStr{"pod": common.MapStr{"name": "noisy"}}}
+	quiet := common.MapStr{"kubernetes": common.MapStr{"pod": common.MapStr{"name": "quiet"}}}
+
+	assert.Equal(t, 2, runEvents(t, p, 10, noisy))
+	assert.Equal(t, 2, runEvents(t, p, 2, quiet))
+	assert.Equal(t, 2, runEvents(t, p, 3, common.MapStr{}))
+	assert.Len(t, p.buckets, 3)
+}
+
+func TestTagExceedingEvents(t *testing.T) {
+	p, _ := newTestProcessor(t, map[string]interface{}{
+		"limit":  "1/s",
+		"action": "tag",
+	})
+
+	event, err := p.Run(testEvent(common.MapStr{}))
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{}, event.Fields)
+
+	event, err = p.Run(testEvent(common.MapStr{}))
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, common.MapStr{"tags": []string{"rate_limited"}}, event.Fields)
+	assert.Equal(t, int64(1), p.tagged.Get())
+	assert.Equal(t, int64(0), p.dropped.Get())
+}
+
+func TestRemoveIdleBuckets(t *testing.T) {
+	p, clock := newTestProcessor(t, map[string]interface{}{
+		"limit":  "1/s",
+		"fields": []string{"host.name"},
+	})
+
+	for _, host := range []string{"a", "b", "c"} {
+		runEvents(t, p, 1, common.MapStr{"host": common.MapStr{"name": host}})
+	}
+	assert.Len(t, p.buckets, 3)
+
+	clock.Advance(gcInterval)
+	runEvents(t, p, 1, common.MapStr{"host": common.MapStr{"name": "a"}})
+	assert.Len(t, p.buckets, 1)
+}
@@ -68,6 +68,19 @@ const (
 	flagGuaranteed uint8 = 1 << 0
 )
 
+func (c codecID) String() string {
+	switch c {
+	case codecJSON:
+		return "json"
+	case codecUBJSON:
+		return "ubjson"
+	case codecCBORL:
+		return "cbor"
+	default:
+		return fmt.Sprintf("%d", uint8(c))
+	}
+}
+
 func newEncoder(codec codecID) (*encoder, error) {
 	switch codec {
 	case codecJSON, codecCBORL, codecUBJSON:
@@ -0,0 +1,258 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package spool
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/go-txfile"
+	"github.com/elastic/go-txfile/pq"
+)
+
+// Inspector provides readonly access to the events stored in a spool file.
+// The inspector never modifies the spool file. Events are not ACKed or
+// removed from the queue.
+type Inspector struct {
+	path      string
+	file      *txfile.File
+	delegate  *readonlyDelegate
+	fileStats txfile.FileStats
+}
+
+// Stats reports the state of a spool file and the events pending in the
+// queue.
+type Stats struct {
+	Path string
+
+	// file and page usage
+	FileSize   uint64
+	MaxSize    uint64
+	PageSize   uint
+	DataPages  uint
+	MetaPages  uint
+	TotalPages uint
+
+	// pending events
+	Pending uint
+	Invalid uint
+	Bytes   uint64
+	Oldest  time.Time
+	Newest  time.Time
+	Codecs  map[string]uint
+}
+
+// readonlyDelegate implements pq.Delegate for accessing an existing queue
+// without starting any write transactions.
+type readonlyDelegate struct {
+	file *txfile.File
+	root txfile.PageID
+}
+
+// fileObserver captures the file stats reported by txfile on open.
+type fileObserver struct {
+	stats txfile.FileStats
+}
+
+var errReadonly = errors.New("spool file is opened in readonly mode")
+
+// ConfiguredPath returns the path of the spool file configured in the
+// `queue.spool` settings.
+func ConfiguredPath(cfg *common.Config) (string, error) {
+	config := defaultConfig()
+	if cfg != nil {
+		if err := cfg.Unpack(&config); err != nil {
+			return "", err
+		}
+	}
+	return spoolPath(config), nil
+}
+
+// OpenInspector opens the spool file at path in readonly mode.
+// The file is locked while being inspected. The beat owning the spool file
+// should be stopped first, as OpenInspector blocks until the lock is
+// released.
+func OpenInspector(path string) (*Inspector, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	observer := &fileObserver{}
+	f, err := txfile.Open(path, os.ModePerm, txfile.Options{
+		Readonly: true,
+		Observer: observer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ok := false
+	defer ifNotOK(&ok, ignoreErr(f.Close))
+
+	tx, err := f.BeginReadonly()
+	if err != nil {
+		return nil, err
+	}
+	root := tx.Root()
+	tx.Close()
+
+	inspector := &Inspector{path: path, file: f, fileStats: observer.stats}
+
+	// The queue root is created on first use. A file without queue root holds
+	// no events.
+	if root != 0 {
+		inspector.delegate = &readonlyDelegate{file: f, root: root}
+	}
+
+	ok = true
+	return inspector, nil
+}
+
+// Close closes the spool file.
+func (i *Inspector) Close() error {
+	return i.file.Close()
+}
+
+// Stats reads all pending events from the spool file and reports the
+// current file and queue state.
+func (i *Inspector) Stats() (Stats, error) {
+	fs := i.fileStats
+	stats := Stats{
+		Path:      i.path,
+		FileSize:  fs.Size,
+		MaxSize:   fs.MaxSize,
+		PageSize:  uint(fs.PageSize),
+		DataPages: fs.DataAllocated,
+		MetaPages: fs.MetaAllocated,
+		Codecs:    map[string]uint{},
+	}
+	if sz := fs.MaxSize; sz > 0 && fs.PageSize > 0 {
+		stats.TotalPages = uint(sz / uint64(fs.PageSize))
+	}
+
+	err := i.read(func(buf []byte, dec *decoder) bool {
+		stats.Pending++
+		stats.Bytes += uint64(len(buf))
+		if len(buf) > 0 {
+			stats.Codecs[codecID(buf[0]).String()]++
+		}
+
+		event, err := dec.Decode()
+		if err != nil {
+			stats.Invalid++
+			return true
+		}
+
+		ts := event.Content.Timestamp
+		if stats.Oldest.IsZero() || ts.Before(stats.Oldest) {
+			stats.Oldest = ts
+		}
+		if ts.After(stats.Newest) {
+			stats.Newest = ts
+		}
+		return true
+	})
+	return stats, err
+}
+
+// Each calls fn for every pending event in the queue, oldest event first.
+// Events that can not be decoded are skipped. The iteration stops early if fn
+// returns false.
+func (i *Inspector) Each(fn func(event publisher.Event) bool) error {
+	return i.read(func(buf []byte, dec *decoder) bool {
+		event, err := dec.Decode()
+		if err != nil {
+			return true
+		}
+		return fn(event)
+	})
+}
+
+// read iterates all pending events within a single read transaction.
+// The queue reader keeps track of the events already read. A new queue
+// instance is created on each call, such that reading always starts at the
+// oldest pending event.
+func (i *Inspector) read(fn func(buf []byte, dec *decoder) bool) error {
+	if i.delegate == nil {
+		return nil
+	}
+
+	queue, err := pq.New(i.delegate, pq.Settings{})
+	if err != nil {
+		return err
+	}
+	defer queue.Close()
+
+	reader := queue.Reader()
+	if err := reader.Begin(); err != nil {
+		return err
+	}
+	defer reader.Done()
+
+	dec := newDecoder()
+	for {
+		sz, err := reader.Next()
+		if sz <= 0 || err != nil {
+			return err
+		}
+
+		buf := dec.Buffer(sz)
+		if _, err := reader.Read(buf); err != nil {
+			return err
+		}
+
+		if !fn(buf, dec) {
+			return nil
+		}
+	}
+}
+
+func (d *readonlyDelegate) PageSize() int {
+	return d.file.PageSize()
+}
+
+func (d *readonlyDelegate) Root() (txfile.PageID, uintptr) {
+	return d.root, 0
+}
+
+func (d *readonlyDelegate) Offset(id txfile.PageID, offset uintptr) uintptr {
+	return d.file.Offset(id, offset)
+}
+
+func (d *readonlyDelegate) SplitOffset(offset uintptr) (txfile.PageID, uintptr) {
+	return d.file.SplitOffset(offset)
+}
+
+func (d *readonlyDelegate) BeginWrite() (*txfile.Tx, error) {
+	return nil, errReadonly
+}
+
+func (d *readonlyDelegate) BeginRead() (*txfile.Tx, error) {
+	return d.file.BeginReadonly()
+}
+
+func (d *readonlyDelegate) BeginCleanup() (*txfile.Tx, error) {
+	return nil, errReadonly
+}
+
+func (o *fileObserver) OnOpen(stats txfile.FileStats)                  { o.stats = stats }
+func (o *fileObserver) OnTxBegin(readonly bool)                        {}
+func (o *fileObserver) OnTxClose(_ txfile.FileStats, _ txfile.TxStats) {}
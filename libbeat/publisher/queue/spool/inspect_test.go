@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package spool
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/publisher"
+	"github.com/elastic/go-txfile"
+	"github.com/elastic/go-txfile/pq"
+	"github.com/elastic/go-txfile/txfiletest"
+)
+
+func TestInspectorStats(t *testing.T) {
+	start := time.Date(2019, 4, 2, 10, 0, 0, 0, time.UTC)
+	path, teardown := writeTestSpool(t, codecJSON, start, 10)
+	defer teardown()
+
+	inspector, err := OpenInspector(path)
+	require.NoError(t, err)
+	defer inspector.Close()
+
+	stats, err := inspector.Stats()
+	require.NoError(t, err)
+
+	assert.Equal(t, uint(10), stats.Pending)
+	assert.Equal(t, uint(0), stats.Invalid)
+	assert.Equal(t, map[string]uint{"json": 10}, stats.Codecs)
+	assert.Equal(t, uint(4*humanize.KiByte), stats.PageSize)
+	assert.True(t, stats.DataPages > 0)
+	assert.True(t, stats.TotalPages > stats.DataPages)
+	assert.True(t, start.Equal(stats.Oldest))
+	assert.True(t, start.Add(9*time.Second).Equal(stats.Newest))
+}
+
+func TestInspectorEach(t *testing.T) {
+	start := time.Date(2019, 4, 2, 10, 0, 0, 0, time.UTC)
+	path, teardown := writeTestSpool(t, codecCBORL, start, 5)
+	defer teardown()
+
+	inspector, err := OpenInspector(path)
+	require.NoError(t, err)
+	defer inspector.Close()
+
+	var messages []interface{}
+	err = inspector.Each(func(event publisher.Event) bool {
+		msg, _ := event.Content.Fields.GetValue("message")
+		messages = append(messages, msg)
+		return len(messages) < 3
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"event 0", "event 1", "event 2"}, messages)
+
+	// inspecting the spool must not remove any events
+	stats, err := inspector.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), stats.Pending)
+}
+
+func TestInspectorMissingFile(t *testing.T) {
+	_, err := OpenInspector("does-not-exist.dat")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func writeTestSpool(t *testing.T, codec codecID, start time.Time, n int) (string, func()) {
+	path, teardown := txfiletest.SetupPath(t, "")
+
+	f, err := txfile.Open(path, 0600, txfile.Options{
+		MaxSize:  uint64(128 * humanize.KiByte),
+		PageSize: uint32(4 * humanize.KiByte),
+	})
+	require.NoError(t, err)
+	defer f.Close()
+
+	delegate, err := pq.NewStandaloneDelegate(f)
+	require.NoError(t, err)
+	queue, err := pq.New(delegate, pq.Settings{WriteBuffer: 4 * humanize.KiByte})
+	require.NoError(t, err)
+	defer queue.Close()
+
+	writer, err := queue.Writer()
+	require.NoError(t, err)
+
+	enc, err := newEncoder(codec)
+	require.NoError(t, err)
+
+	for i := 0; i < n; i++ {
+		buf, err := enc.encode(&publisher.Event{
+			Content: beat.Event{
+				Timestamp: start.Add(time.Duration(i) * time.Second),
+				Fields:    common.MapStr{"message": fmt.Sprintf("event %v", i)},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = writer.Write(buf)
+		require.NoError(t, err)
+		require.NoError(t, writer.Next())
+	}
+	require.NoError(t, writer.Flush())
+
+	return path, teardown
+}
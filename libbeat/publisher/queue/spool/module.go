@@ -47,10 +47,7 @@ func create(eventer queue.Eventer, logp *logp.Logger, cfg *common.Config) (queue
 		return nil, err
 	}
 
-	path := config.File.Path
-	if path == "" {
-		path = paths.Resolve(paths.Data, "spool.dat")
-	}
+	path := spoolPath(config)
 
 	flushEvents := uint(0)
 	if count := config.Write.FlushEvents; count > 0 {
@@ -78,3 +75,10 @@ func create(eventer queue.Eventer, logp *logp.Logger, cfg *common.Config) (queue
 		},
 	})
 }
+
+func spoolPath(config config) string {
+	if path := config.File.Path; path != "" {
+		return path
+	}
+	return paths.Resolve(paths.Data, "spool.dat")
+}
@@ -45,9 +45,11 @@ type Reader struct {
 	flushMatcher *match.Matcher
 	maxBytes     int // bytes stored in content
 	maxLines     int
+	linesCount   int // number of lines per event in count mode
 	separator    []byte
 	last         []byte
-	numLines     int
+	numLines     int // number of lines added to content
+	readLines    int // number of lines read, including lines over the limits
 	truncated    int
 	err          error // last seen error
 	state        func(*Reader) (reader.Message, error)
@@ -78,27 +80,13 @@ func New(
 	maxBytes int,
 	config *Config,
 ) (*Reader, error) {
-	types := map[string]func(match.Matcher) (matcher, error){
-		"before": beforeMatcher,
-		"after":  afterMatcher,
-	}
-
-	matcherType, ok := types[config.Match]
-	if !ok {
-		return nil, fmt.Errorf("unknown matcher type: %s", config.Match)
-	}
-
-	matcher, err := matcherType(*config.Pattern)
+	matcher, err := newMatcher(config)
 	if err != nil {
 		return nil, err
 	}
 
 	flushMatcher := config.FlushPattern
 
-	if config.Negate {
-		matcher = negatedMatcher(matcher)
-	}
-
 	maxLines := defaultMaxLines
 	if config.MaxLines != nil {
 		maxLines = *config.MaxLines
@@ -120,6 +108,7 @@ func New(
 		reader:       r,
 		pred:         matcher,
 		flushMatcher: flushMatcher,
+		linesCount:   config.LinesCount,
 		state:        (*Reader).readFirst,
 		maxBytes:     maxBytes,
 		maxLines:     maxLines,
@@ -149,13 +138,16 @@ func (mlr *Reader) readFirst() (reader.Message, error) {
 			return message, err
 		}
 
-		if message.Bytes == 0 {
+		if !hasLine(message) {
 			continue
 		}
 
 		// Start new multiline event
 		mlr.clear()
 		mlr.load(message)
+		if mlr.countReached() {
+			return mlr.finalize(), nil
+		}
 		mlr.setState((*Reader).readNext)
 		return mlr.readNext()
 	}
@@ -181,8 +173,8 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 				return msg, nil
 			}
 
-			// handle error without any bytes returned from reader
-			if message.Bytes == 0 {
+			// handle error without any line returned from reader
+			if !hasLine(message) {
 				// no lines buffered -> return error
 				if mlr.numLines == 0 {
 					return reader.Message{}, err
@@ -197,7 +189,7 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 
 			// handle error with some content being returned by reader and
 			// line matching multiline criteria or no multiline started yet
-			if mlr.message.Bytes == 0 || mlr.pred(mlr.last, message.Content) {
+			if mlr.readLines == 0 || mlr.pred(mlr.last, message.Content) {
 				mlr.addLine(message)
 
 				// return multiline and error on next read
@@ -230,7 +222,7 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 		}
 
 		// if predicate does not match current multiline -> return multiline event
-		if mlr.message.Bytes > 0 && !mlr.pred(mlr.last, message.Content) {
+		if mlr.readLines > 0 && !mlr.pred(mlr.last, message.Content) {
 			msg := mlr.finalize()
 			mlr.load(message)
 			return msg, nil
@@ -238,9 +230,22 @@ func (mlr *Reader) readNext() (reader.Message, error) {
 
 		// add line to current multiline event
 		mlr.addLine(message)
+
+		// return the event once the configured number of lines is read
+		if mlr.countReached() {
+			msg := mlr.finalize()
+			mlr.resetState()
+			return msg, nil
+		}
 	}
 }
 
+// countReached returns true if the number of lines of the current event
+// reached the configured count in count mode.
+func (mlr *Reader) countReached() bool {
+	return mlr.linesCount > 0 && mlr.readLines >= mlr.linesCount
+}
+
 // readFailed returns empty message and error and resets line reader
 func (mlr *Reader) readFailed() (reader.Message, error) {
 	err := mlr.err
@@ -263,6 +268,7 @@ func (mlr *Reader) clear() {
 	mlr.message = reader.Message{}
 	mlr.last = nil
 	mlr.numLines = 0
+	mlr.readLines = 0
 	mlr.truncated = 0
 	mlr.err = nil
 }
@@ -288,7 +294,7 @@ func (mlr *Reader) finalize() reader.Message {
 // The content is only added if maxBytes and maxLines is not exceed. In case one of the
 // two is exceeded, addLine keeps processing but does not add it to the content.
 func (mlr *Reader) addLine(m reader.Message) {
-	if m.Bytes <= 0 {
+	if !hasLine(m) {
 		return
 	}
 
@@ -327,6 +333,7 @@ func (mlr *Reader) addLine(m reader.Message) {
 	}
 
 	mlr.last = m.Content
+	mlr.readLines++
 	mlr.message.Bytes += m.Bytes
 	mlr.message.AddFields(m.Fields)
 }
@@ -343,6 +350,62 @@ func (mlr *Reader) setState(next func(mlr *Reader) (reader.Message, error)) {
 
 // matchers
 
+// newMatcher creates the predicate deciding if a line belongs to the current
+// multiline event, depending on the multiline type.
+func newMatcher(config *Config) (matcher, error) {
+	switch config.Type {
+	case countMode:
+		// lines are grouped until the count is reached
+		return func(last, current []byte) bool { return true }, nil
+	case whilePatternMode:
+		return whilePatternMatcher(*config.Pattern, config.Negate), nil
+	}
+
+	pattern, negate, matchType := config.Pattern, config.Negate, config.Match
+	if config.Preset != "" {
+		p, ok := presets[config.Preset]
+		if !ok {
+			return nil, fmt.Errorf("unknown multiline preset: %s", config.Preset)
+		}
+		compiled, err := match.Compile(p.pattern)
+		if err != nil {
+			return nil, err
+		}
+		pattern, negate, matchType = &compiled, p.negate, p.match
+	}
+
+	types := map[string]func(match.Matcher) (matcher, error){
+		"before": beforeMatcher,
+		"after":  afterMatcher,
+	}
+
+	matcherType, ok := types[matchType]
+	if !ok {
+		return nil, fmt.Errorf("unknown matcher type: %s", matchType)
+	}
+
+	m, err := matcherType(*pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if negate {
+		m = negatedMatcher(m)
+	}
+	return m, nil
+}
+
+// whilePatternMatcher groups consecutive lines matching the pattern. Lines not
+// matching the pattern are returned as single line events.
+func whilePatternMatcher(pat match.Matcher, negate bool) matcher {
+	matches := func(line []byte) bool {
+		return pat.Match(line) != negate
+	}
+	return func(last, current []byte) bool {
+		return matches(last) && matches(current)
+	}
+}
+
 func afterMatcher(pat match.Matcher) (matcher, error) {
 	return genPatternMatcher(pat, func(last, current []byte) []byte {
 		return current
@@ -371,3 +434,10 @@ func genPatternMatcher(
 	}
 	return matcher, nil
 }
+
+// hasLine returns true if the message contains a line. Readers joining lines
+// can report the bytes of a line with a later message, such that lines
+// without bytes are still added if they have content.
+func hasLine(m reader.Message) bool {
+	return m.Bytes > 0 || !m.IsEmpty()
+}
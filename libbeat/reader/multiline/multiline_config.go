@@ -24,20 +24,87 @@ import (
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
+type multilineType uint8
+
+const (
+	patternMode multilineType = iota
+	countMode
+	whilePatternMode
+)
+
+var multilineTypes = map[string]multilineType{
+	"pattern":       patternMode,
+	"count":         countMode,
+	"while_pattern": whilePatternMode,
+}
+
+// preset is a predefined pattern configuration.
+type preset struct {
+	pattern string
+	negate  bool
+	match   string
+}
+
+var presets = map[string]preset{
+	// Stack trace lines start with `at` or `...` after some indentation,
+	// nested exceptions start with `Caused by:` or `Suppressed:`.
+	"java_stacktrace": {
+		pattern: `^[[:space:]]+(at|\.{3})[[:space:]]|^[[:space:]]*(Caused by|Suppressed):`,
+		negate:  false,
+		match:   "after",
+	},
+}
+
 // Config holds the options of multiline readers.
 type Config struct {
+	Type         multilineType  `config:"type"`
+	Preset       string         `config:"preset"`
 	Negate       bool           `config:"negate"`
-	Match        string         `config:"match" validate:"required"`
+	Match        string         `config:"match"`
 	MaxLines     *int           `config:"max_lines"`
-	Pattern      *match.Matcher `config:"pattern" validate:"required"`
+	Pattern      *match.Matcher `config:"pattern"`
 	Timeout      *time.Duration `config:"timeout" validate:"positive"`
 	FlushPattern *match.Matcher `config:"flush_pattern"`
+	LinesCount   int            `config:"count_lines" validate:"min=0"`
 }
 
 // Validate validates the Config option for multiline reader.
 func (c *Config) Validate() error {
-	if c.Match != "after" && c.Match != "before" {
-		return fmt.Errorf("unknown matcher type: %s", c.Match)
+	switch c.Type {
+	case patternMode:
+		if c.Preset != "" {
+			if _, found := presets[c.Preset]; !found {
+				return fmt.Errorf("unknown multiline preset: %s", c.Preset)
+			}
+			if c.Pattern != nil {
+				return fmt.Errorf("multiline pattern can't be used with preset %s", c.Preset)
+			}
+			return nil
+		}
+		if c.Match != "after" && c.Match != "before" {
+			return fmt.Errorf("unknown matcher type: %s", c.Match)
+		}
+		if c.Pattern == nil {
+			return fmt.Errorf("multiline.pattern cannot be empty when pattern based matching is selected")
+		}
+	case countMode:
+		if c.LinesCount == 0 {
+			return fmt.Errorf("multiline.count_lines cannot be zero when count based is selected")
+		}
+	case whilePatternMode:
+		if c.Pattern == nil {
+			return fmt.Errorf("multiline.pattern cannot be empty when while_pattern based matching is selected")
+		}
+	}
+	return nil
+}
+
+// Unpack unpacks the multiline type from its name.
+func (t *multilineType) Unpack(value string) error {
+	mode, found := multilineTypes[value]
+	if !found {
+		return fmt.Errorf("unknown multiline type: %s", value)
 	}
+	*t = mode
 	return nil
 }
@@ -22,6 +22,7 @@ package multiline
 import (
 	"bytes"
 	"errors"
+	"io"
 	"os"
 	"strings"
 	"testing"
@@ -185,6 +186,145 @@ func TestMultilineAfterTruncated(t *testing.T) {
 	)
 }
 
+func TestMultilineCount(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Type:       countMode,
+			LinesCount: 2,
+		},
+		3,
+		"line1\nline1.1\n",
+		"line2\nline2.1\n",
+		"line3\n",
+	)
+}
+
+func TestMultilineCountSingleLine(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Type:       countMode,
+			LinesCount: 1,
+		},
+		2,
+		"line1\n",
+		"line2\n",
+	)
+}
+
+func TestMultilineWhilePattern(t *testing.T) {
+	pattern := match.MustCompile(`^{`)
+	testMultilineOK(t,
+		Config{
+			Type:    whilePatternMode,
+			Pattern: &pattern,
+		},
+		3,
+		"{line1\n{line1.1\n",
+		"line2\n",
+		"{line3\n{line3.1\n",
+	)
+}
+
+func TestMultilineWhilePatternNegate(t *testing.T) {
+	pattern := match.MustCompile(`^{`)
+	testMultilineOK(t,
+		Config{
+			Type:    whilePatternMode,
+			Pattern: &pattern,
+			Negate:  true,
+		},
+		3,
+		"{line1\n",
+		"line2\nline2.1\n",
+		"{line3\n",
+	)
+}
+
+func TestMultilineJavaStacktracePreset(t *testing.T) {
+	testMultilineOK(t,
+		Config{
+			Preset: "java_stacktrace",
+		},
+		2,
+		"Exception in thread \"main\" java.lang.IllegalStateException: A book has a null property\n"+
+			"\tat com.example.myproject.Author.getBookIds(Author.java:38)\n"+
+			"\tat com.example.myproject.Bootstrap.main(Bootstrap.java:14)\n"+
+			"Caused by: java.lang.NullPointerException\n"+
+			"\tat com.example.myproject.Book.getId(Book.java:22)\n"+
+			"\t... 1 more\n",
+		"2019-04-01 12:00:00 INFO next event\n",
+	)
+}
+
+type messagesReader struct{ messages []reader.Message }
+
+func (r *messagesReader) Next() (reader.Message, error) {
+	if len(r.messages) == 0 {
+		return reader.Message{}, io.EOF
+	}
+	message := r.messages[0]
+	r.messages = r.messages[1:]
+	return message, nil
+}
+
+func (r *messagesReader) Close() error { return nil }
+
+func TestMultilineLinesWithoutBytes(t *testing.T) {
+	// the bytes of the second line are reported with the last line, like
+	// the container reader does for interleaved partial lines
+	in := &messagesReader{messages: []reader.Message{
+		{Ts: time.Now(), Content: []byte("line1"), Bytes: 6},
+		{Ts: time.Now(), Content: []byte("line2"), Bytes: 0},
+		{Ts: time.Now(), Content: []byte("line3"), Bytes: 12},
+	}}
+	pattern := match.MustCompile(`^line`)
+	r, err := New(in, "\n", 1<<20, &Config{
+		Type:    patternMode,
+		Pattern: &pattern,
+		Match:   "after",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	message, err := r.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "line1\nline2\nline3", string(message.Content))
+	assert.Equal(t, 18, message.Bytes)
+}
+
+func TestMultilineConfigValidate(t *testing.T) {
+	pattern := match.MustCompile(`^{`)
+	tests := map[string]struct {
+		config Config
+		valid  bool
+	}{
+		"pattern":                 {config: Config{Pattern: &pattern, Match: "after"}, valid: true},
+		"pattern without pattern": {config: Config{Match: "after"}},
+		"pattern with bad match":  {config: Config{Pattern: &pattern, Match: "around"}},
+		"preset":                  {config: Config{Preset: "java_stacktrace"}, valid: true},
+		"unknown preset":          {config: Config{Preset: "cobol"}},
+		"preset and pattern":      {config: Config{Preset: "java_stacktrace", Pattern: &pattern}},
+		"count":                   {config: Config{Type: countMode, LinesCount: 3}, valid: true},
+		"count without lines":     {config: Config{Type: countMode}},
+		"while_pattern":           {config: Config{Type: whilePatternMode, Pattern: &pattern}, valid: true},
+		"while_pattern no regexp": {config: Config{Type: whilePatternMode}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func testMultilineOK(t *testing.T, cfg Config, events int, expected ...string) {
 	_, buf := createLineBuffer(expected...)
 	r := createMultilineTestReader(t, buf, cfg)
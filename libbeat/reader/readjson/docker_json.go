@@ -38,15 +38,29 @@ type DockerJSONReader struct {
 	// join partial lines
 	partial bool
 
-	// Force log format: json-file | cri
-	forceCRI bool
+	// log format: auto | docker | cri
+	format ContainerFormat
 
 	// parse CRI flags
 	criflags bool
 
+	// pending partial lines per stream
+	pending map[string]partialLine
+
+	// bytes read from the underlying reader and bytes reported in returned
+	// messages
+	offset, reported int64
+
 	stripNewLine func(msg *reader.Message)
 }
 
+// partialLine is a partial line waiting for its continuation. offset is the
+// position of its first part in the underlying reader.
+type partialLine struct {
+	message reader.Message
+	offset  int64
+}
+
 type logLine struct {
 	Partial   bool      `json:"-"`
 	Timestamp time.Time `json:"-"`
@@ -57,12 +71,28 @@ type logLine struct {
 
 // New creates a new reader renaming a field
 func New(r reader.Reader, stream string, partial bool, forceCRI bool, CRIFlags bool) *DockerJSONReader {
+	return NewContainerParser(r, &ContainerJSONConfig{
+		Stream:   stream,
+		Partial:  partial,
+		ForceCRI: forceCRI,
+		CRIFlags: CRIFlags,
+	})
+}
+
+// NewContainerParser creates a new reader parsing container log files in the
+// Docker json-file or CRI format.
+func NewContainerParser(r reader.Reader, config *ContainerJSONConfig) *DockerJSONReader {
 	reader := DockerJSONReader{
-		stream:   stream,
-		partial:  partial,
+		stream:   config.Stream,
+		partial:  config.Partial,
 		reader:   r,
-		forceCRI: forceCRI,
-		criflags: CRIFlags,
+		format:   config.Format,
+		criflags: config.CRIFlags,
+		pending:  map[string]partialLine{},
+	}
+
+	if config.ForceCRI {
+		reader.format = CRI
 	}
 
 	if runtime.GOOS == "windows" {
@@ -150,17 +180,20 @@ func (p *DockerJSONReader) parseDockerJSONLog(message *reader.Message, msg *logL
 	})
 	message.Content = []byte(msg.Log)
 	message.Ts = ts
-	msg.Partial = message.Content[len(message.Content)-1] != byte('\n')
+	msg.Partial = len(message.Content) > 0 && message.Content[len(message.Content)-1] != byte('\n')
 
 	return nil
 }
 
 func (p *DockerJSONReader) parseLine(message *reader.Message, msg *logLine) error {
-	if p.forceCRI {
+	switch p.format {
+	case Docker:
+		return p.parseDockerJSONLog(message, msg)
+	case CRI:
 		return p.parseCRILog(message, msg)
 	}
 
-	// If froceCRI isn't set, autodetect file type
+	// Autodetect the format of every line
 	if len(message.Content) > 0 && message.Content[0] == '{' {
 		return p.parseDockerJSONLog(message, msg)
 	}
@@ -169,49 +202,78 @@ func (p *DockerJSONReader) parseLine(message *reader.Message, msg *logLine) erro
 }
 
 // Next returns the next line.
+//
+// Partial lines are joined per stream, such that interleaved lines of the
+// other stream do not break the reassembly. The bytes count of the returned
+// message never goes past the beginning of a pending partial line, so that the
+// offset can be persisted without losing the partial line on restart. The
+// remaining bytes are reported with the message completing the partial line,
+// such that complete lines of the other stream can have no bytes.
 func (p *DockerJSONReader) Next() (reader.Message, error) {
-	var bytes int
 	for {
 		message, err := p.reader.Next()
 
-		// keep the right bytes count even if we return an error
-		bytes += message.Bytes
-		message.Bytes = bytes
+		start := p.offset
+		p.offset += int64(message.Bytes)
 
 		if err != nil {
+			message.Bytes = p.errorBytes()
 			return message, err
 		}
 
 		var logLine logLine
 		err = p.parseLine(&message, &logLine)
 		if err != nil {
+			message.Bytes = p.errorBytes()
 			return message, err
 		}
 
-		// Handle multiline messages, join partial lines
-		for p.partial && logLine.Partial {
-			next, err := p.reader.Next()
-
-			// keep the right bytes count even if we return an error
-			bytes += next.Bytes
-			message.Bytes = bytes
+		if p.stream != "all" && p.stream != logLine.Stream {
+			continue
+		}
 
-			if err != nil {
-				return message, err
+		if p.partial {
+			if pending, found := p.pending[logLine.Stream]; found {
+				pending.message.Content = append(pending.message.Content, message.Content...)
+				message = pending.message
+				start = pending.offset
 			}
-			err = p.parseLine(&next, &logLine)
-			if err != nil {
-				return message, err
+
+			if logLine.Partial {
+				// Content can point into the buffer of the underlying reader.
+				message.Content = append([]byte(nil), message.Content...)
+				p.pending[logLine.Stream] = partialLine{message: message, offset: start}
+				continue
 			}
-			message.Content = append(message.Content, next.Content...)
+			delete(p.pending, logLine.Stream)
 		}
 
-		if p.stream != "all" && p.stream != logLine.Stream {
-			continue
-		}
+		message.Bytes = p.flushBytes()
+		return message, nil
+	}
+}
 
-		return message, err
+// flushBytes returns the number of bytes read since the last returned message
+// up to the oldest pending partial line.
+func (p *DockerJSONReader) flushBytes() int {
+	end := p.offset
+	for _, pending := range p.pending {
+		if pending.offset < end {
+			end = pending.offset
+		}
 	}
+
+	bytes := end - p.reported
+	p.reported = end
+	return int(bytes)
+}
+
+// errorBytes drops the pending partial lines and returns all bytes read since
+// the last returned message, to keep the right bytes count even if we return
+// an error.
+func (p *DockerJSONReader) errorBytes() int {
+	p.pending = map[string]partialLine{}
+	return p.flushBytes()
 }
 
 func stripNewLine(msg *reader.Message) {
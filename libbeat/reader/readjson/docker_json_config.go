@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package readjson
+
+import "fmt"
+
+// ContainerFormat is the format of container log files.
+type ContainerFormat uint8
+
+const (
+	// Auto detects the format of every line.
+	Auto ContainerFormat = iota
+	// Docker is the json-file format of the Docker logging driver.
+	Docker
+	// CRI is the format of CRI runtimes like containerd and CRI-O.
+	CRI
+)
+
+var containerFormats = map[string]ContainerFormat{
+	"auto":   Auto,
+	"docker": Docker,
+	"cri":    CRI,
+}
+
+// ContainerJSONConfig holds the options of the container logs reader.
+type ContainerJSONConfig struct {
+	// Stream filter, `all`, `stderr` or `stdout`
+	Stream string `config:"stream"`
+
+	// Partial joins partial lines
+	Partial bool `config:"partial"`
+
+	// Format of the log files
+	Format ContainerFormat `config:"format"`
+
+	// ForceCRI forces the CRI format, kept for the docker input
+	ForceCRI bool `config:"force_cri_logs"`
+
+	// CRIFlags enables parsing the tags of CRI log lines
+	CRIFlags bool `config:"cri_flags"`
+}
+
+// Unpack unpacks the container format from its name.
+func (f *ContainerFormat) Unpack(value string) error {
+	format, found := containerFormats[value]
+	if !found {
+		return fmt.Errorf("unknown container log format '%s', must be one of auto, docker or cri", value)
+	}
+	*f = format
+	return nil
+}
@@ -18,6 +18,7 @@
 package readjson
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -308,6 +309,135 @@ func TestDockerJSON(t *testing.T) {
 	}
 }
 
+func TestContainerParserInterleavedPartialLines(t *testing.T) {
+	r := &mockReader{messages: [][]byte{
+		[]byte(`2017-10-12T13:32:21.232861448Z stdout P first `),
+		[]byte(`{"log":"error\n","stream":"stderr","time":"2017-11-09T13:27:36.277747246Z"}`),
+		[]byte(`2017-10-12T13:32:21.232861449Z stdout P second `),
+		[]byte(`2017-10-12T13:32:21.232861450Z stdout F third`),
+	}}
+	p := NewContainerParser(r, &ContainerJSONConfig{Stream: "all", Partial: true, CRIFlags: true})
+
+	message, err := p.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "error\n", string(message.Content))
+	assert.Equal(t, common.MapStr{"stream": "stderr"}, message.Fields)
+	assert.Equal(t, 0, message.Bytes)
+
+	message, err = p.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "first second third", string(message.Content))
+	assert.Equal(t, common.MapStr{"stream": "stdout"}, message.Fields)
+	assert.Equal(t, time.Date(2017, 10, 12, 13, 32, 21, 232861448, time.UTC), message.Ts)
+	assert.Equal(t, 213, message.Bytes)
+}
+
+func TestContainerParserInterleavedPartialLinesOffset(t *testing.T) {
+	lines := [][]byte{
+		[]byte(`2017-10-12T13:32:21.232861448Z stdout F one`),
+		[]byte(`2017-10-12T13:32:21.232861449Z stderr P two `),
+		[]byte(`2017-10-12T13:32:21.232861450Z stdout F three`),
+		[]byte(`2017-10-12T13:32:21.232861451Z stderr P four `),
+		[]byte(`2017-10-12T13:32:21.232861452Z stdout P five `),
+		[]byte(`2017-10-12T13:32:21.232861453Z stderr F six`),
+		[]byte(`2017-10-12T13:32:21.232861454Z stdout F seven`),
+	}
+	r := &mockReader{messages: lines}
+	p := NewContainerParser(r, &ContainerJSONConfig{Stream: "all", Partial: true, CRIFlags: true})
+
+	offsetAfter := func(n int) int {
+		offset := 0
+		for _, line := range lines[:n] {
+			offset += len(line)
+		}
+		return offset
+	}
+
+	expected := []struct {
+		content string
+		offset  int
+	}{
+		// the offset must not move past the pending stderr line
+		{"one", offsetAfter(1)},
+		{"three", offsetAfter(1)},
+		// the offset must not move past the pending stdout line
+		{"two four six", offsetAfter(4)},
+		{"five seven", offsetAfter(7)},
+	}
+
+	offset := 0
+	for _, e := range expected {
+		message, err := p.Next()
+		if !assert.NoError(t, err) {
+			return
+		}
+		offset += message.Bytes
+		assert.Equal(t, e.content, string(message.Content))
+		assert.Equal(t, e.offset, offset, e.content)
+		assert.False(t, message.IsEmpty(), e.content)
+	}
+}
+
+func TestDockerJSONInterleavedPartialLinesNotEmpty(t *testing.T) {
+	lines := [][]byte{
+		[]byte(`{"log":"partial ","stream":"stdout","time":"2017-11-09T13:27:36.277747246Z"}`),
+		[]byte(`{"log":"complete err\n","stream":"stderr","time":"2017-11-09T13:27:36.277747247Z"}`),
+		[]byte(`{"log":"out\n","stream":"stdout","time":"2017-11-09T13:27:36.277747248Z"}`),
+	}
+	r := &mockReader{messages: lines}
+	p := NewContainerParser(r, &ContainerJSONConfig{Stream: "all", Partial: true, Format: Docker})
+
+	// the stderr line does not move the offset past the pending stdout line,
+	// but still must be published
+	message, err := p.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "complete err\n", string(message.Content))
+	assert.Equal(t, 0, message.Bytes)
+	assert.False(t, message.IsEmpty())
+
+	message, err = p.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "partial out\n", string(message.Content))
+	assert.Equal(t, len(lines[0])+len(lines[1])+len(lines[2]), message.Bytes)
+	assert.False(t, message.IsEmpty())
+}
+
+func TestContainerParserFormat(t *testing.T) {
+	cri := []byte(`2017-09-12T22:32:21.212861448Z stdout F message`)
+	docker := []byte(`{"log":"message\n","stream":"stdout","time":"2017-11-09T13:27:36.277747246Z"}`)
+
+	tests := []struct {
+		format        ContainerFormat
+		input         []byte
+		expectedError bool
+	}{
+		{format: Auto, input: cri},
+		{format: Auto, input: docker},
+		{format: CRI, input: cri},
+		{format: CRI, input: docker, expectedError: true},
+		{format: Docker, input: docker},
+		{format: Docker, input: cri, expectedError: true},
+	}
+
+	for _, test := range tests {
+		r := &mockReader{messages: [][]byte{test.input}}
+		p := NewContainerParser(r, &ContainerJSONConfig{Stream: "all", Format: test.format, CRIFlags: true})
+
+		message, err := p.Next()
+		if test.expectedError {
+			assert.Error(t, err)
+			continue
+		}
+		if assert.NoError(t, err) {
+			assert.Equal(t, "message", string(bytes.TrimRight(message.Content, "\n")))
+		}
+	}
+}
+
 type mockReader struct {
 	messages [][]byte
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,6 +43,7 @@ import (
 	"github.com/elastic/beats/packetbeat/protos/udp"
 	"github.com/elastic/beats/packetbeat/publish"
 	"github.com/elastic/beats/packetbeat/sniffer"
+	"github.com/elastic/beats/packetbeat/tunnel"
 
 	// Add packetbeat default processors
 	_ "github.com/elastic/beats/packetbeat/processor/add_kubernetes_metadata"
@@ -57,6 +59,7 @@ type packetbeat struct {
 	pipeline beat.Pipeline
 	transPub *publish.TransactionPublisher
 	flows    *flows.Flows
+	tunnels  *tunnel.Registry
 }
 
 type flags struct {
@@ -122,12 +125,17 @@ func (pb *packetbeat) init(b *beat.Beat) error {
 		logp.Info("Process watcher disabled when file input is used")
 	}
 
+	if cfg.Interfaces.Tunnels.Enabled {
+		pb.tunnels = tunnel.NewRegistry()
+	}
+
 	pb.pipeline = b.Publisher
 	pb.transPub, err = publish.NewTransactionPublisher(
 		b.Info.Name,
 		b.Publisher,
 		pb.config.IgnoreOutgoing,
 		pb.config.Interfaces.File == "",
+		pb.tunnels,
 	)
 	if err != nil {
 		return err
@@ -160,12 +168,38 @@ func (pb *packetbeat) setupSniffer() error {
 	filter := config.Interfaces.BpfFilter
 	if filter == "" && !config.Flows.IsEnabled() {
 		filter = protos.Protos.BpfFilter(withVlans, withICMP)
+		if config.Interfaces.Tunnels.Enabled {
+			filter = withTunnelsBpfFilter(filter, config.Interfaces.Tunnels, withVlans)
+		}
 	}
 
 	pb.sniff, err = sniffer.New(false, filter, pb.createWorker, config.Interfaces)
 	return err
 }
 
+// withTunnelsBpfFilter extends the filter to also capture the tunnel packets,
+// as the filter generated from the protocols only matches the inner packets.
+func withTunnelsBpfFilter(filter string, cfg config.TunnelsConfig, withVlans bool) string {
+	if filter == "" {
+		return filter
+	}
+
+	ports := cfg.VXLANPorts
+	if len(ports) == 0 {
+		ports = []int{decoder.DefaultVXLANPort}
+	}
+
+	expressions := []string{"proto gre"}
+	for _, port := range ports {
+		expressions = append(expressions, fmt.Sprintf("udp dst port %d", port))
+	}
+	tunnels := strings.Join(expressions, " or ")
+	if withVlans {
+		tunnels = fmt.Sprintf("%s or (vlan and (%s))", tunnels, tunnels)
+	}
+	return fmt.Sprintf("%s or %s", filter, tunnels)
+}
+
 func (pb *packetbeat) setupFlows() error {
 	config := &pb.config
 	if !config.Flows.IsEnabled() {
@@ -187,7 +221,7 @@ func (pb *packetbeat) setupFlows() error {
 		return err
 	}
 
-	pb.flows, err = flows.NewFlows(client.PublishAll, config.Flows)
+	pb.flows, err = flows.NewFlows(publish.NewFlowsReporter(client.PublishAll, pb.tunnels), config.Flows)
 	if err != nil {
 		return err
 	}
@@ -283,6 +317,9 @@ func (pb *packetbeat) createWorker(dl layers.LinkType) (sniffer.Worker, error) {
 	if err != nil {
 		return nil, err
 	}
+	if pb.tunnels != nil {
+		worker.DecapsulateTunnels(pb.config.Interfaces.Tunnels.VXLANPorts, pb.tunnels)
+	}
 
 	return worker, nil
 }
@@ -36,19 +36,27 @@ type Config struct {
 }
 
 type InterfacesConfig struct {
-	Device       string `config:"device"`
-	Type         string `config:"type"`
-	File         string `config:"file"`
-	WithVlans    bool   `config:"with_vlans"`
-	BpfFilter    string `config:"bpf_filter"`
-	Snaplen      int    `config:"snaplen"`
-	BufferSizeMb int    `config:"buffer_size_mb"`
+	Device       string        `config:"device"`
+	Type         string        `config:"type"`
+	File         string        `config:"file"`
+	WithVlans    bool          `config:"with_vlans"`
+	BpfFilter    string        `config:"bpf_filter"`
+	Snaplen      int           `config:"snaplen"`
+	BufferSizeMb int           `config:"buffer_size_mb"`
+	Tunnels      TunnelsConfig `config:"tunnels"`
 	TopSpeed     bool
 	Dumpfile     string
 	OneAtATime   bool
 	Loop         int
 }
 
+// TunnelsConfig configures decapsulating the traffic tunneled in VXLAN, GRE
+// and ERSPAN.
+type TunnelsConfig struct {
+	Enabled    bool  `config:"enabled"`
+	VXLANPorts []int `config:"vxlan_ports"`
+}
+
 type Flows struct {
 	Enabled       *bool                   `config:"enabled"`
 	Timeout       string                  `config:"timeout"`
@@ -26,6 +26,7 @@ import (
 	"github.com/elastic/beats/packetbeat/protos/icmp"
 	"github.com/elastic/beats/packetbeat/protos/tcp"
 	"github.com/elastic/beats/packetbeat/protos/udp"
+	"github.com/elastic/beats/packetbeat/tunnel"
 
 	"github.com/tsg/gopacket"
 	"github.com/tsg/gopacket/layers"
@@ -48,8 +49,17 @@ type Decoder struct {
 	icmp6     layers.ICMPv6
 	tcp       layers.TCP
 	udp       layers.UDP
+	vxlan     vxlan
+	gre       gre
+	erspan    erspan
 	truncated bool
 
+	// tunnel decapsulation
+	vxlanPorts map[uint16]bool
+	tunnels    *tunnel.Registry
+	tunnel     tunnel.Info
+	tunneled   bool
+
 	stD1Q, stIP4, stIP6 multiLayer
 
 	icmp4Proc icmp.ICMPv4Processor
@@ -144,6 +154,23 @@ func New(
 	return &d, nil
 }
 
+// DecapsulateTunnels enables decoding the packets tunneled in GRE, ERSPAN
+// and VXLAN. VXLAN is decoded in UDP packets sent to one of the given ports,
+// or to the default VXLAN port if no port is given. The tunnels of the
+// decapsulated packets are recorded in the registry.
+func (d *Decoder) DecapsulateTunnels(vxlanPorts []int, tunnels *tunnel.Registry) {
+	d.tunnels = tunnels
+	if len(vxlanPorts) == 0 {
+		vxlanPorts = []int{DefaultVXLANPort}
+	}
+	d.vxlanPorts = make(map[uint16]bool, len(vxlanPorts))
+	for _, port := range vxlanPorts {
+		d.vxlanPorts[uint16(port)] = true
+	}
+
+	d.AddLayers([]gopacket.DecodingLayer{&d.vxlan, &d.gre, &d.erspan})
+}
+
 func (d *Decoder) SetTruncated() {
 	d.truncated = true
 }
@@ -164,6 +191,7 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket.CaptureInfo) {
 	defer logp.Recover("packet decoding failed")
 
 	d.truncated = false
+	d.tunneled = false
 
 	current := d.linkLayerDecoder
 	currentType := d.linkLayerType
@@ -199,6 +227,10 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket.CaptureInfo) {
 		if processed {
 			break
 		}
+		if currentType == layers.LayerTypeUDP {
+			// unprocessed UDP packets carry VXLAN
+			nextType = layerTypeVXLAN
+		}
 
 		// choose next decoding layer
 		next, ok := d.decoders[nextType]
@@ -279,6 +311,12 @@ func (d *Decoder) process(
 		return true, nil
 
 	case layers.LayerTypeUDP:
+		if d.vxlanPorts[uint16(d.udp.DstPort)] {
+			debugf("VXLAN packet")
+			d.enterTunnel(packet, tunnel.TypeVXLAN)
+			return false, nil
+		}
+
 		debugf("UDP packet")
 		d.onUDP(packet)
 		return true, nil
@@ -287,11 +325,46 @@ func (d *Decoder) process(
 		debugf("TCP packet")
 		d.onTCP(packet)
 		return true, nil
+
+	case layerTypeVXLAN:
+		d.tunnel.ID, d.tunnel.HasID = d.vxlan.VNI, true
+
+	case layers.LayerTypeGRE:
+		debugf("GRE packet")
+		switch d.gre.Protocol {
+		case ethernetTypeERSPANTypeII, ethernetTypeERSPANTypeIII:
+			d.enterTunnel(packet, tunnel.TypeERSPAN)
+		default:
+			d.enterTunnel(packet, tunnel.TypeGRE)
+			d.tunnel.ID, d.tunnel.HasID = d.gre.Key, d.gre.KeyPresent
+		}
+
+	case layerTypeERSPAN:
+		d.tunnel.ID, d.tunnel.HasID = uint32(d.erspan.SessionID), true
 	}
 
 	return false, nil
 }
 
+// enterTunnel remembers the outer endpoints of the tunnel the remaining
+// layers are decapsulated from.
+func (d *Decoder) enterTunnel(packet *protos.Packet, typ string) {
+	d.tunneled = true
+	d.tunnel = tunnel.Info{
+		Type:          typ,
+		SourceIP:      packet.Tuple.SrcIP,
+		DestinationIP: packet.Tuple.DstIP,
+	}
+}
+
+// recordTunnel registers the tunnel of a decapsulated packet, such that its
+// events can be enriched with the tunnel endpoints.
+func (d *Decoder) recordTunnel(packet *protos.Packet) {
+	if d.tunneled {
+		d.tunnels.Add(&packet.Tuple, d.tunnel, packet.Ts)
+	}
+}
+
 func (d *Decoder) onICMPv4(packet *protos.Packet) {
 	if d.flowID != nil {
 		flow := d.flows.Get(d.flowID)
@@ -301,6 +374,7 @@ func (d *Decoder) onICMPv4(packet *protos.Packet) {
 	if d.icmp4Proc != nil {
 		packet.Payload = d.icmp4.Payload
 		packet.Tuple.ComputeHashables()
+		d.recordTunnel(packet)
 		d.icmp4Proc.ProcessICMPv4(d.flowID, &d.icmp4, packet)
 	}
 }
@@ -314,6 +388,7 @@ func (d *Decoder) onICMPv6(packet *protos.Packet) {
 	if d.icmp6Proc != nil {
 		packet.Payload = d.icmp6.Payload
 		packet.Tuple.ComputeHashables()
+		d.recordTunnel(packet)
 		d.icmp6Proc.ProcessICMPv6(d.flowID, &d.icmp6, packet)
 	}
 }
@@ -331,6 +406,7 @@ func (d *Decoder) onUDP(packet *protos.Packet) {
 	packet.Tuple.DstPort = dst
 	packet.Payload = d.udp.Payload
 	packet.Tuple.ComputeHashables()
+	d.recordTunnel(packet)
 
 	d.udpProc.Process(id, packet)
 }
@@ -354,5 +430,6 @@ func (d *Decoder) onTCP(packet *protos.Packet) {
 		return
 	}
 	packet.Tuple.ComputeHashables()
+	d.recordTunnel(packet)
 	d.tcpProc.Process(id, &d.tcp, packet)
 }
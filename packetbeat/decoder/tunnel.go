@@ -0,0 +1,181 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+)
+
+// DefaultVXLANPort is the IANA assigned UDP port of VXLAN.
+const DefaultVXLANPort = 4789
+
+// Ethernet types of the GRE payloads decapsulated by the decoder.
+const (
+	ethernetTypeTransparentBridging layers.EthernetType = 0x6558
+	ethernetTypeERSPANTypeII        layers.EthernetType = 0x88be
+	ethernetTypeERSPANTypeIII       layers.EthernetType = 0x22eb
+)
+
+var (
+	layerTypeVXLAN  = gopacket.RegisterLayerType(1850, gopacket.LayerTypeMetadata{Name: "VXLAN"})
+	layerTypeERSPAN = gopacket.RegisterLayerType(1851, gopacket.LayerTypeMetadata{Name: "ERSPAN"})
+)
+
+var (
+	errShortTunnelHeader       = errors.New("tunnel header too short")
+	errUnsupportedTunnelHeader = errors.New("unsupported tunnel header")
+)
+
+// vxlan decodes the VXLAN header (RFC 7348). The payload is an Ethernet frame.
+type vxlan struct {
+	VNI     uint32
+	payload []byte
+}
+
+func (v *vxlan) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 8 {
+		return errShortTunnelHeader
+	}
+	// the I flag must be set for the VNI to be valid
+	if data[0]&0x08 == 0 {
+		return errUnsupportedTunnelHeader
+	}
+	v.VNI = binary.BigEndian.Uint32(data[4:8]) >> 8
+	v.payload = data[8:]
+	return nil
+}
+
+func (v *vxlan) CanDecode() gopacket.LayerClass    { return layerTypeVXLAN }
+func (v *vxlan) NextLayerType() gopacket.LayerType { return layers.LayerTypeEthernet }
+func (v *vxlan) LayerPayload() []byte              { return v.payload }
+
+// gre decodes the GRE header (RFC 2784, RFC 2890). The GRE decoder of
+// gopacket does not support headers without all optional fields.
+type gre struct {
+	Protocol   layers.EthernetType
+	Key        uint32
+	KeyPresent bool
+	SeqPresent bool
+	payload    []byte
+}
+
+func (g *gre) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 4 {
+		return errShortTunnelHeader
+	}
+
+	checksumPresent := data[0]&0x80 != 0
+	routingPresent := data[0]&0x40 != 0
+	g.KeyPresent = data[0]&0x20 != 0
+	g.SeqPresent = data[0]&0x10 != 0
+	version := data[1] & 0x07
+	if routingPresent || version != 0 {
+		return errUnsupportedTunnelHeader
+	}
+	g.Protocol = layers.EthernetType(binary.BigEndian.Uint16(data[2:4]))
+
+	offset := 4
+	if checksumPresent {
+		offset += 4
+	}
+	if g.KeyPresent {
+		if len(data) < offset+4 {
+			return errShortTunnelHeader
+		}
+		g.Key = binary.BigEndian.Uint32(data[offset:])
+		offset += 4
+	}
+	if g.SeqPresent {
+		offset += 4
+	}
+	if len(data) < offset {
+		return errShortTunnelHeader
+	}
+	g.payload = data[offset:]
+	return nil
+}
+
+func (g *gre) CanDecode() gopacket.LayerClass { return layers.LayerTypeGRE }
+
+func (g *gre) NextLayerType() gopacket.LayerType {
+	switch g.Protocol {
+	case ethernetTypeTransparentBridging:
+		return layers.LayerTypeEthernet
+	case ethernetTypeERSPANTypeII:
+		// ERSPAN type I has no ERSPAN header and no sequence number
+		if !g.SeqPresent {
+			return layers.LayerTypeEthernet
+		}
+		return layerTypeERSPAN
+	case ethernetTypeERSPANTypeIII:
+		return layerTypeERSPAN
+	default:
+		return g.Protocol.LayerType()
+	}
+}
+
+func (g *gre) LayerPayload() []byte { return g.payload }
+
+// erspan decodes the ERSPAN type II and type III headers. The payload is an
+// Ethernet frame.
+type erspan struct {
+	SessionID uint16
+	payload   []byte
+}
+
+func (e *erspan) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 8 {
+		return errShortTunnelHeader
+	}
+
+	version := data[0] >> 4
+	e.SessionID = binary.BigEndian.Uint16(data[2:4]) & 0x03ff
+
+	switch version {
+	case 1: // type II
+		e.payload = data[8:]
+	case 2: // type III
+		if len(data) < 12 {
+			return errShortTunnelHeader
+		}
+		frameType := (data[11] >> 2) & 0x1f
+		if frameType != 0 {
+			return errUnsupportedTunnelHeader
+		}
+		offset := 12
+		// optional platform specific sub-header
+		if data[11]&0x01 != 0 {
+			offset += 8
+		}
+		if len(data) < offset {
+			return errShortTunnelHeader
+		}
+		e.payload = data[offset:]
+	default:
+		return errUnsupportedTunnelHeader
+	}
+	return nil
+}
+
+func (e *erspan) CanDecode() gopacket.LayerClass    { return layerTypeERSPAN }
+func (e *erspan) NextLayerType() gopacket.LayerType { return layers.LayerTypeEthernet }
+func (e *erspan) LayerPayload() []byte              { return e.payload }
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsg/gopacket"
+
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/tunnel"
+)
+
+var (
+	outerSrcIP = net.IPv4(10, 0, 0, 1).To4()
+	outerDstIP = net.IPv4(10, 0, 0, 2).To4()
+)
+
+// encapsulate wraps the payload in an Ethernet frame and an IPv4 packet with
+// the given protocol, sent between the outer tunnel endpoints.
+func encapsulate(protocol byte, payload []byte) []byte {
+	eth := []byte{
+		0x00, 0x0c, 0x29, 0x00, 0x00, 0x02, 0x00, 0x0c, 0x29, 0x00, 0x00, 0x01, 0x08, 0x00,
+	}
+	ip4 := []byte{
+		0x45, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x00, 0x40, protocol, 0x00, 0x00,
+	}
+	binary.BigEndian.PutUint16(ip4[2:], uint16(20+len(payload)))
+	ip4 = append(ip4, outerSrcIP...)
+	ip4 = append(ip4, outerDstIP...)
+
+	data := append(eth, ip4...)
+	return append(data, payload...)
+}
+
+func vxlanPacket(dstPort uint16, vni uint32, frame []byte) []byte {
+	udp := make([]byte, 16)
+	binary.BigEndian.PutUint16(udp[0:], 50000)
+	binary.BigEndian.PutUint16(udp[2:], dstPort)
+	binary.BigEndian.PutUint16(udp[4:], uint16(16+len(frame)))
+	udp[8] = 0x08
+	binary.BigEndian.PutUint32(udp[12:], vni<<8)
+	return encapsulate(17, append(udp, frame...))
+}
+
+func greKeyPacket(key uint32, ipPacket []byte) []byte {
+	gre := []byte{0x20, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00}
+	binary.BigEndian.PutUint32(gre[4:], key)
+	return encapsulate(47, append(gre, ipPacket...))
+}
+
+func erspanTypeIIPacket(session uint16, frame []byte) []byte {
+	gre := []byte{0x10, 0x00, 0x88, 0xbe, 0x00, 0x00, 0x00, 0x01}
+	erspan := []byte{0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	binary.BigEndian.PutUint16(erspan[2:], session)
+	return encapsulate(47, append(append(gre, erspan...), frame...))
+}
+
+func decodeTunneled(d *Decoder, data []byte) {
+	ci := gopacket.CaptureInfo{Timestamp: time.Now(), Length: len(data), CaptureLength: len(data)}
+	d.OnPacket(data, &ci)
+}
+
+func assertTunnel(t *testing.T, d *Decoder, typ string, id uint32, pkt *protos.Packet) {
+	if !assert.NotNil(t, pkt, "packet not received") {
+		return
+	}
+	info, found := d.tunnels.Lookup(pkt.Tuple.DstIP, pkt.Tuple.DstPort, pkt.Tuple.SrcIP, pkt.Tuple.SrcPort)
+	if assert.True(t, found, "tunnel not recorded") {
+		assert.Equal(t, typ, info.Type)
+		assert.True(t, info.HasID)
+		assert.Equal(t, id, info.ID)
+		assert.Equal(t, "10.0.0.1", info.SourceIP.String())
+		assert.Equal(t, "10.0.0.2", info.DestinationIP.String())
+	}
+}
+
+// Test that the Ethernet frame in a VXLAN packet is passed to the UDP processor.
+func TestDecodePacketData_vxlan(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	d.DecapsulateTunnels(nil, tunnel.NewRegistry())
+	decodeTunneled(d, vxlanPacket(DefaultVXLANPort, 42, ipv4UdpDNS))
+
+	assert.NotNil(t, udp.pkt, "UDP packet not received")
+	assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(32795), udp.pkt.Tuple.SrcPort)
+	assert.Equal(t, "192.168.170.20", udp.pkt.Tuple.DstIP.String())
+	assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+	assertTunnel(t, d, tunnel.TypeVXLAN, 42, udp.pkt)
+}
+
+// Test that flows seen in multiple VXLAN networks are not matched to a tunnel.
+func TestDecodePacketData_vxlanOverlappingNetworks(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	d.DecapsulateTunnels(nil, tunnel.NewRegistry())
+
+	decodeTunneled(d, vxlanPacket(DefaultVXLANPort, 42, ipv4UdpDNS))
+	assertTunnel(t, d, tunnel.TypeVXLAN, 42, udp.pkt)
+
+	decodeTunneled(d, vxlanPacket(DefaultVXLANPort, 43, ipv4UdpDNS))
+	_, found := d.tunnels.Lookup(udp.pkt.Tuple.SrcIP, udp.pkt.Tuple.SrcPort, udp.pkt.Tuple.DstIP, udp.pkt.Tuple.DstPort)
+	assert.False(t, found, "flow in multiple tunnels matched to a tunnel")
+}
+
+// Test that VXLAN is decoded on the configured port only.
+func TestDecodePacketData_vxlanPorts(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	d.DecapsulateTunnels([]int{8472}, tunnel.NewRegistry())
+
+	decodeTunneled(d, vxlanPacket(DefaultVXLANPort, 1, ipv4UdpDNS))
+	assert.NotNil(t, udp.pkt, "UDP packet not received")
+	assert.Equal(t, "10.0.0.1", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(DefaultVXLANPort), udp.pkt.Tuple.DstPort)
+
+	decodeTunneled(d, vxlanPacket(8472, 1, ipv4UdpDNS))
+	assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+}
+
+// Test that tunnels are not decoded unless enabled.
+func TestDecodePacketData_tunnelsDisabled(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	decodeTunneled(d, vxlanPacket(DefaultVXLANPort, 42, ipv4UdpDNS))
+
+	assert.NotNil(t, udp.pkt, "UDP packet not received")
+	assert.Equal(t, "10.0.0.1", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(DefaultVXLANPort), udp.pkt.Tuple.DstPort)
+}
+
+// Test that the IP packet in a GRE packet is passed to the TCP processor.
+func TestDecodePacketData_gre(t *testing.T) {
+	d, tcp, _ := newTestDecoder(t)
+	d.DecapsulateTunnels(nil, tunnel.NewRegistry())
+	decodeTunneled(d, greKeyPacket(7, ipv4TcpDNS[14:]))
+
+	assert.NotNil(t, tcp.pkt, "TCP packet not received")
+	assert.Equal(t, "172.16.16.164", tcp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(1108), tcp.pkt.Tuple.SrcPort)
+	assert.Equal(t, "172.16.16.139", tcp.pkt.Tuple.DstIP.String())
+	assert.Equal(t, uint16(53), tcp.pkt.Tuple.DstPort)
+	assertTunnel(t, d, tunnel.TypeGRE, 7, tcp.pkt)
+}
+
+// Test that the mirrored frame in an ERSPAN packet is passed to the TCP processor.
+func TestDecodePacketData_erspan(t *testing.T) {
+	d, tcp, _ := newTestDecoder(t)
+	d.DecapsulateTunnels(nil, tunnel.NewRegistry())
+	decodeTunneled(d, erspanTypeIIPacket(5, ipv6TcpHTTPGet))
+
+	assert.NotNil(t, tcp.pkt, "TCP packet not received")
+	assert.Equal(t, "2001:6f8:102d:0:2d0:9ff:fee3:e8de", tcp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(80), tcp.pkt.Tuple.DstPort)
+	assertTunnel(t, d, tunnel.TypeERSPAN, 5, tcp.pkt)
+}
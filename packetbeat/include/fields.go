@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvW13GzeSKPw9vwKPcs4je5eiJFt2HO2Z3euRnUR3bEdjyZud3dkjgt0giagb6ABo0cw997/fg6oCGv1CSnJEj31W82FitrqBQqFQVajXb9kvL9+/O3334//HXmmmtGMil465hbRsJgvBcmlE5orViEnHltyyuVDCcCdyNl0xtxDs9ck5q4z+VWRu9M23bMqtyJlW8PxaGCu1Yofjg/HB+Jtv2VkhuBXsWlrp2MK5yh7v78+lW9TTcabLfVFw62S2LzLLnGa2ns+FdSxbcDUX8MgPO5OiyO34m2/22JVYHTOR2W8Yc9IV4ti/8A1jubCZkZWTWsEj9gN9w+jr428Y22OKl+KY7f4vJ0thHS+r3W8YY6wQ16I4Zpk2An4b8VstjciPmTM1PnKrShyznDv82Zpv9xV3Yt+PyZYLoQBN4loox7SRc6k8+sbfwHeMXXhcSwsv5fE78dEZnnk0z4wumxFGfmKZ8aJYMSMqI6xQTqo5TEQjNtMNbpjVtclEnP90lnyAf2MLbpnSAdqCRfSMkDSueVELADoCU+mqLvw0NCxNNpPGOvi+A5YRmZDXDVSVrEQhVQPXe8I57hebacN4UeAIdoz7JD7ysvKbvvvk4PD53sGzvSdPLw5eHB88O356NH7x7Ol/7ibbXPCpKOzgBuNu6qmnYniA/7zE51ditdQmH9jok9o6XfoX9hEnFZfGxjWccMWmgtX+SDjNeJ6zUjjOpJppU3I/iH9Oa2LnC10XORzDTCvHpWJKWL91CA6Qr//fy6LAPbCMG8Gs0x5R3AZIIwCvA4Imuc6uhJkwrnI2uXphJ4SODibpO15Vhcw4rnKm9d6UG/qTUNfH/sDndeb/nOC3FNbyudiAYCc+ugEs/qANK/Sc8ADkQGPR5hM28E/+TfrziOnKyVL+HsnOk8m1FEt/JKRiHN72D4SJSPHTWWfqzNUebYWeW7aUbqFrx7hqqL4Fw4hptxCGuAfLcGczrTLuhEoI32kPRMk4W9QlV3tG8JxPC8FsXZbcrJhODlx6Csu6cLIq4totEx+l9Sd+IVbNhOVUKpEzqZxmWsW3uyfiJ1EUmv2iTZEnW+T4fNMBSAldzpU24pJP9bU4ZocHT476O/dGWufXQ9/ZSOmOz5ng2SKssn1Y/2unoZ+dEdsR6vrJzn+nR5XPhUJKIa7+Mj6YG11Xx+zJAB1dLAR+GXeJThHxVs741G8ycsGZW/rD4/mn8/JtFmhfrTzOuT+EReGP3YjlwuE/tGF6aoW59tuD5Ko9mS203yltmONXwrJScFsbUfoXaNj4WvdwWiZVVtS5YH8W3LMBWKtlJV8xXljNTK381zSvsWMQaLDQ8T/RUmlIu/A8cioadgyU7eHnsrCB9hBJplbKnxONCPKwJesL5325ECZl3gteVcJToF8snNS4VGDsHgGKqHGmtVPa+T0Piz1mpzhd5hUBPcNFw7n1B3HUwDf2pMBIEZkK7sbJ+X159hZUEhKc7QXRjvOq2vdLkZkYs4Y2UuabaxFQB1wX9AwmZ0gt0jIvXplbGF3PF+y3WtR+fLuyTpSWFfJKsL/w2RUfsfcil0gfldGZsFaqedgUet3W2cIz6Td6bh23C4brYOeAbkIZHkQgckRh1Faa0yGqhSiF4cWlDFyHzrP46ITKG17UO9Vrz3X3LL0OczCZ+yMyk8Ig+UhLiHwkZ8CBgE3Zx5Gug07jJZkpQTsIChzPjLZe+FvHjT9P09qxCW63zCewH34nCBkJ03jBj2bPDg5mLUR0lx/Z2R9a+gclf/Pqzd3XHcWtJ1EkbPhuCXJ9KhiQsczXLi9vLc///zYWSFoLnK+UI/R20DKObyE7RBE0l9cC1Bau6DN8m/68EEU1qwt/iPyhphXGgd1Ssx/oQDOprOMqIzWmw4+snxiYkicSEqesEaei4oaTCkLLt0wJkeP9Y7mQ2aI/VTzZmS79ZF69TtZ9OvOKb+A8sFRkSeGRnjmhWCFmjomycqv+Vs60bu2i36ht7OLFqtqwfYHb+QmYdXxlGS+W/j8Rt14VtItAmritpI3jt16ajxvUqMizI1abd5HEaYqpaF4BESZnrY1vdqxLAK3NL3m28FeCPorTcQKe6bK5BVT/O11j28juwPTc33H3TPYkUWOyQnb0mJPmyQZF5iV96QkuFzNQ+DjunFTSSe40MCXOlHBLba68pqMEKFT+1AXYUEExYs5NDoLLyyWt7Ch5H4XWVOJNX2qv+c4KvfQ3NK/TtdTmi5MzGhVPRQNmDzb/wL+eQAZcxAoV1RX/zvnf3rGKZ1fCPbKPxzALatqV0U5nuuhNhTdaL1ZakwY9y8B1XfhLUdAEApac4cpyAGbMznUpomyuLeo4TpiS7YRrujY7jVZvxEyYFiiqs0CLagb9mXRQ3NmpiDoY6KAJAhAE5sFS87DNzRQp/KhNExGFCfzJqW3tEUKjNsqfVB68X2uFGwC6IGp3wYjCBkZrEKy0643puTpu2B4csnB9jZdeHG8/TBTNFMCsUU74m7AVJVdOZqCli4+ORIr4iMrCCDn4N5G1B8HiNLuWfr3yd9Fo9n6lwoC2b6WrOe3H6YytdG3iHDNeFIH6pApyzYm5NquRfzVwROtkUTChvG5LhIu2Ec81c2Gdpw+PU4+wmSyKqHTxqjK6MpI7UazuoNXxPDfC2m0pdEDuqMITcdGExHwjnymncl7r2hYrJGf4JnLspUeL1aUAmxAr/A2QK3Z6NmKc5br0G6AN46xW8iOz2tPJmLG/NZglGQFGi0YtWAhm+DLAFAh/MqYHE0RZW8QpfwNoJFheo9ECr6CTsawmHpTJGMGa+GtcJVROOgYqCFo1QMB9gnYs7Mp05YS9QaYUOur6eLVof9bahz/7P+C1Ilr2aD/8vdnzA7wOdOXL4YujFmC4qC1IOzq/OP64Nedc6HEm3epyS5rpiXQrmKq3+rdaOSN40QdHKyeVUG5bML1LtOQ4WQ++d9q4BXtZCiMzPgBkrZxZXUqrLzOdbwV1OAU7Pf+Z+Sl6EJ68XAvWtnaTQBrc0BOueN7HVKGzVKdfB85c6MtKy8iX2lYprebS1Tny6oI7+NGDYPf/sJ1Cq51jtvfd0/Hzw6MXTw9GbKfgbueYHT0bPzt49v3hC/Z/d3tA9vF1f2z6gxVmL/Di5E+o7gX0jBgp3yiB9YzNDVd1wY10q5SprljmmTvoHAnzPAk8M15tkMKlQWmaCeWEIc1rVmhtmKrLqTAjUOUXstFrbBwUwStYtVhZ6f8RTGtZONY2AeGddon7AAyHUjFeO10CC58LHVbbvwBMtXVa7eVZb2+MmEuttnnS3sMMmw7a3l9P1sG1paNGMA2etL/WYiraiJLVDTDEF9rEeXoWBXTgiCAsUspCK4BWwsveaNM+Pbs+8g9Oz66fN4pHR9aWPNsCbt6+PFkHdTo5qrR3EPWtSc7w608S7E/acGjjPhUIbdymJdZWmLEouSy2xL0882IwQcD4AACzuigGzsG9ArFrmZ8GpgWWxa+5LPi06B+Pl8VUGMdeS2WdIIWqBS9o7eOtWVr71sYZWdZh4mgQgVviflVw53XMAbwinFtEbKoJ4WR9IBbcLrYmGhFTfh7m5/HnKtPGCH8vbZn1Z3gD8S96maK0WqVOQlTTE6b1wQoyWU5gFTLHmwP88KubRFdSptUM94oXrTm9rpFx1dyYWXD9drgczbAFTvdzh+nWXdKKDBBg6EO1Jel0vvCMCdUMcPNI1QckOZIcjmTLjqZrnDKa0cKD9VY0jPhgSB55YMIwFAPT0Mzw6AZuHFx4G0brcLjUgY2YrXVozdhb4YzM0NBsU0M2V+z1yRM0Y3sKmQmXLYQFLSsZnUlnyYfYAOmpq+36bvkwpY0G0jYINK6pFTknjSi1i+ZUpmtnZS6SmbqQIUyckfcsLChsumo+JQ2x7aXHQZuBwE1IkwdB6IeVtgGVEHYXe0kG95ftcebdiwZBOBe4R82cK/k7HnqZR5c3nbIVy+VsJkxqMwE9WIKjl3E8nntOKK4cE+paGq3KthLV0NbLX87j5DIfsR+1nhcC6Z/9/P5HdpqjUxpMpr0D39ecnz9//t1337148eL7779voxMlpCz8/f73xixy31h9mczD/DweK2iLAZqGo9Icoh5zqO2e4NbtHXZUWvIkbI8cToMH6fRV4F4AaziEXUDl3uGTp0fPnn/34vsDPs1yMTsYhniLIjvCnPr6+lAnCjg87Lus7g2it4EPJN6rjWh0T8alyGVdtrVko69lHoMUtqnqIAcIE47D4UwDsPjSjhj/vTZixOZZNYoHWRuWy7l0vNCZ4Kov6Za2tSy8JW5pUXRJ/MTjlopjZPSE/SCSWw83OLfii20HBnkWevFxSchOJTI5k+GOGKFA8zz5oMhKr2fpIEmwpbAizLsQRZUokCCvMHw1Dm1JEqqVR5CTpbiDgNqKjkdKcLN4mbfPsCz5fKs8JT0bMFk0jSJAS27ZtJaF8+J8ADTH51uCrKEsgovP2wAkEaCbZ08iQTfEgnaZLUxKYZWtebe4G82aG+NP5CZIsttiJzg6K7nic6+9AT+JdNDjJBiBmrCRxIuWMpJXnccbWEny6mZ3K2rPydtgTUWTz347EnNgzMTDepNvFbkP+Va/RN9fy3V5Kwdgo8Zi8PY9OQDjsOAI/J/tAEw3JRgLKUq/c4g+mxcwPQYPrsAHV+D9gPTgCrw9zh5cgQ+uwK/JFZgIsa/NH9gCnW3ZKXgHYb8Vz+DaxT64Bx/cgw/uQfbgHvza3IOY/93JAN9kOHgrHN9LdyeYFinDHKe8zcX9pqSDgczxP5aWlWTVg+5FEb0aFmOZ02M2EZkd00sTTOIJYDQUDh47T5RlbR2mMsFhKHrx3Iz94m/av9XCrCBCHXO4IhlJlctMWLa3Rzfqkq8CQJDEX8j5whVDjrFkNfA91R3woBVecErlxNxQ3DjPf/WgBpGZLUTJO/hnreRa21cWoRBBSjnG6JYV+3V8sDnPtLEiZ5CURCHuOCCcI65W7EqqxmLxAVMMSkyLwvfAco0ZlR55hUA3rEdzyC4FHpVxK2yTihmWBXsvnRXFrPG+coWj38H8tCX1GJAJg4crApoJBQHYVkS3aC0fkJ4DEKT56+vBiDnsg4sN2dgpjV13coBeX98ylxn3d8hLEtIZhh0lhQ5KIDpUjMxatBJJ8iWkx7eTjDz5BJ7iCcpvWZI+DJa/Be4jb7KBA5N+06TxA2MJqc2QWyNL4S+rwfvkn/qB4hhNRrSeJYug8cJQPGTYMkgiDYEWFD7RpESh7s6mAjOfSAWnMXkw1TrNeKoSj9B4OZBXNRVuKYSfKeRPqJxiJKIfEiejlCTMkc4K7YU8exl24mZ042WJhiy1Ef7GDeakAkbEfBX4mSaaA0DDiE5eo2GbVO0W1lNqaVBeilKbFfNMDvJhaLg8QXxDcNd1oYRBD79scuHpZeuVIJFjJvxdgj1uYQr65CAPHJ1lvMKSEJQF2XYMUFJsNHZQ9llzAGVS6WXMTsElCbvXaBcLrtgEXwhZR5MmwzJuhD/rE0DIHs/zyYhNiOT3gOQFPJrJQuxlRnhCm2CqTqjLEkeMCdiB4mhl0s9TgmWnLyS90rVXcWs9MvcwG6stLgj0bWzHazwMNEMX+VHILeR8QelnwzwQOCQI0FlvV+KYsDuQ7dbZHCSIySjsqRXKUhpYY6jiEcwIVzNy0I54yAz8hRt/uKH+wayGmLOo+uiZV4VGbClYVXAwC1C8AeNxyIKKbfAsE5WDHGgKQUCZFlSnEauwylJtBXqlMl4P285gp8F/17CGuMlIWTfscSyA1N1HInIcpBfFNlwdyfMkKBgU12wEB5oNqeaYq7rCnL5eySAiElQg/VGVnq1nZHtpijzFzL/kUbOtBGscM3LUgZpMsVZMl1WcKlZq65JcRDCgeiJa6qaekkV32lQMaMl4pMPPrPFSZe2qQhkvMnBJknWn4KsoqwBPJOmoEBSo8CR0mkCVluiAbYFPQzUVY12QuiJnspPyHyAptZJNIi5LhtjdBU027Jj/GULAnGZXQlSsrpBY4aO0GlUbq5CCDpC28ehZJqp5GS9G6c42/sGB23bOHbfiJrPaJ3Gy1B5C03Qy9DOt/FFGe/6E3pmwR56zW+HYPoljK9xjT8/BMo6VJbzywGw9bcCH60+p87oQFlhd69ilfBI1A7+DtfG0VqxCESmpmknTCz+SSPMnnMZvKkELL/dZjHXctWOc8trcxq8z4FPtfClVVbvL8EfFlbYi0012ua5d+gK3b2VRyMF3KiMyaWHfDgc38xVN3RInHlnJtO0yEsgRQF4D6vC38DqjEexK6aVKi6k1VOqGT3040jC7wrs7jp6EJcU7h7qNPXId825A7fHtLsuGQT0VxOde4F2nrifP1QvuZRcWFurEK23RJPgTtwv2qBJmwSsL5YWg7M5MqrkwlZHKPfb7afiSZIbTfgNAtDodF5CLUivrjF8+3JfAKiHdasBgHwI+h/718s8nrz7blff0lV9NjIZJ1NkOzIOVZ67krQjokxVuP/5wITSS4XN5DfHSXdVuSSpYN8IvIclAs41wC8Xd6CqY2Po2aIodbRyeTpoxJ56xCa+H84KbcvJlKngAZNvIAXx72/KOpAN6hzcW3MFCQ+ktqvVmMlpX/mkTK2n1F16u7G/tCJGgqm1j6e/5EuxCsWSgnoHH20Rq+kAq0gZeskaJVdrLmVx8FMjzc51dJqHHubSeUnKU9+BgAHVScJMtRN4Q7LR2TMYiTsYLcnEddNnJJepakz4mz0XFDr9nBy+Onzw/PjzAgOGT1z8cH/z/3x4+OfqXc5HVfgH4i7mFV/nxTmHw2eGYXj08oH80J1Obktk684rlrC5QDakqkYcP8L/WZH86PIAisocst+5PT8aH4yfjJ7Zyfzp88rTtJtW1y/T2ojI8+6Ip1nGwVknVxl7gLzEZ2piaw2zbMrY1clIoKRStaWw1+CJxJ0IhlfeccVnURgzypDjirXjT7XlSHPf2vAlhbu2dkfbq0iaHct0xnRWaD5ph30t7xWAErMUntSfOttr2SIznY2aJcJnVBYBoHzemmA9W0OUJHKtwfaGrHuprC2G60bYR9kulTXkL+lu7iN13YLeRv4schr1hQaNoWvMa+Swu4sDv5eHBwUBdt5JLhbE25Nlc6Rr2rMRgTK7ACkm1ieCyzK2Vc2UTgGz7/uiHWHLMd7bCU49qloFYI98RL4pQeamjuFpxLZLApbvGOZzT5x0rXdy7MHxH1v+ywBiqRuULl/DmCyL7UnAFTPRamOSyHtVzj0Pw1niGvNsYhOoq6BuJ7Q0uzfxKMLCq0lRShBREZaV1YGlGtAXHXOcg7X7XwaG/Ffxh9R/vFjdeAMggmV4BWkzLXwUaw86aO4C/wWwx5Ww3kajNPSspkdpa0u6ubQwLaYVQRrKYPBoEc1tJLYzg+Yo4TC5mvC4cO19ZL+sba0XCaE7RNgKQ8gLz+JbSplaPlw3vjZPilEAox2CIVFqBQ+D0FU2+87o2uhL7L0vrhMl5ufM4Oa7TqRHX6KMIr59f7DwG54diP/10XJYNcUtehLf2Dp4dHxzsPO4c223VOHwvkFxA2pBSXaODLa6Fasrzaw3ZmDEToakbDpEeXg0dpzWGZ5L0YHLL/RB+byzMB1XxOy4cZoXr30fAO2bZ1HOFtjGVvEz+r+B4D74RsKQAW2yK7vnpqPp30N24tTqTTXFf0MhCVb5WqTg78ox5n4w0gW+gbwc21Gsi2gqq543+AZjyNOil7C0a9Txa/+uH07f/HWp/28ZFRfm8UL4PfNio2AQtop+JwWczgYZU/3pnPYFqkqL5ZHe6i0f7lokv63jgGx7K1gOIpXAco2HBG9JhX7nwy98S83oFg6/JccPk66KjicDc/bCU++OnsMtxlq56EdM8Cr1kgtuVB9EJIKHpChEaPx4I0qhItseY2a0F150ZCSXZMZTOs84fT189Xo/Yhua2DUuar9uHQ6pewMY9pgzrXLR7SwQggjcs5VOsbVvYWtqwByrBhwdFZ44XnfKSPeXo6PB5G8b7ZQxkPAINp9S5nMkuc9BLtbU0ZZQOfoJdsI6Yfg5gxd22zKtn3C2CUtunUSt/vw2e12nysDQ/ht9pSKZij6JNRPu7C8/zoLtN/FgQ6gZe8cnjjnrJzVy4yy2i4gJmAGSDxmFXZSHVVSe+eYtp9YAusIuC92jEcmlAySBIOhipt8ZSLyhqE7jpB+CmprlqJ4FYj847rBYJOY2cmgudKmg/0s8N+tmPQqdxeRk3/pLWVE3hjfU3ZJSkBWK4SnWkdoueJAmlpeiRUpYLI6M5zYlsAWb4pui/h+z0LAmTQX+k2bN1VRUyOiZvpdx8OXl3X3zO3ReYb/eF5dp98Xl2Dzl2X2aO3ZeYX/cF5Nb1LwtBfsUH6yXYRUzsScJ+S0FW1SbOHN6h+HFonSAKcc3j4SStLPH4fkrBki8qielzZy7F+ARtW9HbP4XfG81EoaxOy0xEdfVZpsuqdhgpTDWgYk+ok3MMjQ2NnYYNlmlPp8asgh2cmvI+7TyBEGYNaiGoKYPxwWlksF8r4DWGAtOIC27yJTdixK6lcTUvQvkmO2KvoM5HUkMHjFDsL/VUGCUcNPjJxZ2qY5hsIZ3IEv/VveZFVSEuLrRiSObrnfOPL55fPm8XYXiohfBQC+HuID3UQrg9zh70tIdaCNuvheDl55Yg2f2Jxk5rHqYhIy5plhd8rktyS7NJgGzidYfSn18jXG2wwGuvhOLuRq3uXpvkoZ6TlmV6aSMeQ/gSdXzBfOMRuMjJmx71V6/iSjWHYASKPd9YGhU1ZYpeRpegx+wEGuwBprpY+LQ6F6AByWq4XsF26lP8RFs5POe26PPdRtoEYxqluANVJhSZUOIHKPmFgR3EJCGo67eaF2Aaj2NSoTAswIAZdx4Ass41iUqQAA57bb0kMSwXmcwhF9brrkBGDWPX/v3Oxms7nvFSFqstiaafzxmOzx4FW58R+YK7EcvFVHI1YjMjxNTmI7aUKtfLxv3f1MaDN3tw18W2SnH0dF4qhQFafvD5hETzkMQ7rILyzOPgrf6VX4vuCq68yv/Z1oCzRbDhzmX4kllnhkqbHo2Pxgd7h4dP9igFrAv9FhWaNfgPkcoJ9tch/D+60IZr8+eCOMxHdO91I21HrJ7WytWbaJ2bpezR+mAhhe0Bf1saOTwYHx6ND1vQbivYJTT07LDfH7Shet+hBjF1lSXPQ6u6uh8C2hJPYt3kCZSHvy5HiQIMQdaJrhsv66O0aWtSWTz1eDSyOo44JLMHypo8FBdqU9dDcaGH4kIPxYW+7OJCC+daVvyfLi7O4PddOo/4j2I47DiUgmGT2hSTEJgqMHA6aYsJQJoiwEttbW9vzw8fTHW+Gg/Usb0pIOPGWrbnrfiMNpgMZu2i98WL79aDSME0WzrDF3Qdwc3YCOVPoig0W2pT5MPQbgGXF9rxohPx0sHoIw8sHPaF4F4P6CtXh0dPhxFcCrfQW8vpa6EUp+rkOiORYxYAVIaZijQ9wGlW6KUwkN7tWWgoNzVm54JyYnVWlyHOK45tqTrLzmkIq/da3uuT852+eWwu3IhVUCamqt0gmqDJs9lawNZ7Gr7Jnkkx19tNz3vs8f7+tNDzMT0dZ7rc78BuK62s+OznHKe97UFPgfy8J30TnOuPeoD3c591gvbTDjsBbR13tR0w9d4pBq+NPhxz2Lh7dND2iG33NgdwrbseH47TViWhihQJ7zf080bZjeYl3ireoyFjM03CuY0QhsVv47r4c0hq8lBFhwfV/+rlJGILgFZK85IbNRmxCZRC8/+QA+mfwpjWcraZRhuS01opW34xIa2Wd0sSwClP3kjU3xlWXiqkQ0+7YzUUfokaasVNq8rhKZo4DW+KDE5o2KCjIVWkxlBoWB/KwvgR0/y7sBc0Spr22cn6pMWOegsKab1xzAW/FjHNyPpNxbDjLFRJxGhCNAIIlWnsdmCYEktWSCUstIO7Ti4k/ipTCK4gR60N8h/NSmZWU9Lx7i6IfC/WUzvwNBi7QDH4w8nJ4GkDn8TbFZ39aDjHxJiUG7xLHt1Qii+k1bRDOtB0Upa1IvxjBLC+FiZwkCZ+hOEuJOk5FJJh0/ZE4Y1PCgAJo3dqcHQThkL5n7uEYFTYWmOLSSUv8ZY2l9dCYTBuOitxuMpopzNdtAsQcTOVznDTWPkZpatS6hgUGrR4KEqZGR1SlkZAgbywGiZb4clvXrZXq0o0ljOZ/TZiM56JqdZXI+aW0jl0UEjLlmmdIc9qmuJPTelOdi1UntRIguhobIcYI4m9iM1j5HAsg4CnYD/3OvbpGYZL2xGUBbcjloy5lCZkCH6BWjiX7VZu991gZRe1K9SqnOHKgs4NOzLV/txII6gqWytnf0L1puBLSqVPi6WH56F8z4hNwmGlP6Hsks1O2LrsI+Dp8xctBBAHcavL7bWyfIlWKyjgCcljwLSTSvSnZ1g/kqiJW7YURUFMLq4nHL8mMKHN/8YxwZwzp3Wxx+dKWyczrz2qnJtWq8w47KzQy3Qz3ghuFKaicxdvQXPpFvUU7j+eQKBg2n5E3p7M97yuNlD093jx8z/bd0c//fPbH5+9/dv+i8Wp+Y+z37Kj//zr7wd/am1FJI0tqDc7r8LgQU8L7NoZPpvJbPx39V749WBRpUacHv9dsb9H5Pyd/ROTaqprlf9dMfZPTNcu+SWVE0bxAn95Cmp+1QoI9+/q7+qXhVDpmCWvqqTsMDWA9cJrD3vilU0eKFWfHUWBlCg26ZiRc/lhdi2D0CS/+GsplmOEYc3EATXasEoYWQonDALSAvp2MDWAtCDw/wWvBU2WjhwnHe90yYlw36KbmTZLbnKRX/6ROIOkp0ZMSafjmvyJFOTK6I8DFai+fzI+HB+O2yVRJFf8EiOVtsRgTl++e8nOAnd4B1OxR+HkLpfLsYdhrM18HwUzVKzdD/xkD4HrPxh/XLiySPLlz4mPgLwK1UnCV5b4Dy+gUgVwMNB43gn3Q6GXWDQN/kXG2Thuoefh1leTdXZoTT2Et7MLt+0BQeVoumIaHJpQQlwH6WubaLUgl7rQ/ggGul/kTLbA/mNtTkjg0iCfJHLp2wGh2/xlQOyGPzb6GQngYcH7pG2kCFSzjavsm+/C7aKRmRA+wcTHMUi0ESuAon7lmdckPdK87G003C9Pc4uukOgJD1BvA4XnnuC5jbScMDHU2sFrypuaD4L9BedJj2FsCdBguOArz5zqvBoxl1UjJqvr53syK6sREy4bP/7yMO+yDuK3FIJwikLn5/NTyLguUIgu01CBQNZvPBbHHndHiMHkllRZkY1YJUtA6JeHTg90YhqgojStRhA/p882pXqo+Hm/LEglMsmLQMGjmAeLIW+9KzXWkYjldHPhROZGYXz4CAuJ3DziXlu+kXKVlHBtJ7fGYBDOsto6XcYMDxwUeoiDY5uW2ilvotVMzuumwYjTzNTq9ghgVs+cny6pcNbOOJlJI5a8KOzIa7imhugdxJDUar8ysEQYKsQfBh0y0RKtUFabWLdqKaYtKJJJIN670NayoaE9Il+evSVs2LRPaqCG1IDDscbzGvsNMSgcHCNG1GqU1n/DddpICjaUdUFysI3CvAHFoZgKjUklVdhbsq3+VosaB2avL95AjpJWQDXhrkcFoNvNSYicgqXJCDANQu2qXEDVf8IHtHR9fXJ+B6PTQ17NQ17N3UF6yKu5Pc4e8moe8mq+6ryablpNlL5t+8enGWX6PU6Hh/9sfUpbiupDgsNDgsNDgsNDgsP9JzhYYSQvtmswDvdrmozk/U31su6v5VfoIZCy1diqZVO5emEor9FfDIPmFAzRzUirStjxUNRNcBWYtJlAuHhCFE5u4T+VpcZfH1fwD10UAsJ08BLr/9VcQQdiI8KYLZS2vM/3idS4cpwhDU8fdyDY3DH1HkgqYSxN2NKcK/l7o+wHM0/3+Q1xIOk44X4vlJHZAgkHLvbrOpKVFVdBSmtD+mqL6DqRGmlgSNNxdCGKCoptc2O4mocmPI6K3CadfLjCIB3wGLQD9CMYzXruUpLjH5CSkoL62UrDpPQR1YOGq7dIKbLgc2DBN5DTBdhZO00A1pCO7nD320cffpWa4VeuFn7FOuFXpBB+xdrgF68KJh7S2KKDuNxZ8ujWLbLXMrfYy3dY0mVcNdKuSbcjm3O7ox0ENsbWwDLfT2iZgkpacbXAgENf1XEFaXczJxSzjq9sKHUcevZij20eu2KBglhJdNRAUmKhp7xIis4HcBuD0u1KXc1vk2zwaTFgxvAVhUsAkriZgyMttZO9he6RpE/g8iqjncgcOE+kk9etfMee3kk/95iN2Zh7bK+I/6xtvFPssdDUpx1FIT6KrIaGB1tCxcsp9HwRGK5LOxiw0szeOyH7tTX7U6n2w9o+R4lKOnEkheJG+asFdJRgGS8KAdnhc8PLmOtoZSkLPtDftwt8dWNC6LrIj7N42jpFp3tD3invJAxbcaju0h39j/Y3uQh9TtNdpz4mfbP9k4PD53sHz/aePL04eHF88Oz46dH4xbOn/9lpgLEwgue3y9Ret+wLGIOdvuoL7SdH7YAuYMbbJjiYpBOG4tEFz0eYfIAUCO5LCteoUnJlJ1xhdPW0aWrpjuOQSbEBxtnU6KUFk0DI2SAgwhFdiimr+FwkbUs1to5v78ZSmyup5pcYdtTrVH2viWY0F4tzBatClGxdJrLQpdjnBbaMaFK3Gn89idr3yaONorZpbiOw6XioFzrjmSyk8zKzktcae/8aXUPj+kqKLGkXBf1RwmaD3QJesN3GJhSlboWAjuclVyuvG2Xgsfc3ztcn56Gv0kUKAg2NnenAtIIXu3KEN1YI+A8iCjpE+SlCoShN/iIQq7bSymvrQbxjVopiE8LieBJX8hK67Brhoh3GY6ix7As7StJ6poLVUGYIetpHo8aIwjBHDRGEALURywoJPbjCq1zlMWYpjQuFMhxwba8qaOBaFOz0LEh7pxvoZTUZocrDQQtRhDSqLYBBgKdnzBl5LXlRrEZMaVZy5yDvRETuLR1Mxo3IR2y6irE06VTHfDwdZ+N8cpfb/22aYAz7VF4WMU3t9MziHmuVdH1OL9j9sJzz2wXl0HsD6TpEPFSdIcaIZFopCiCaRfsYRTkYMecmx/ARa7GXd/O+xZ7kMoY4ei0QI0wzbZKuwD9owy5OzmJnHmCaEUyELRPS/yYESSWh1MP5395RdOUjG0rmB3X55CyBZQyTYMWWGBPbnYmq0BarHj7C9rVD05UNzQeBK1AMDOOZq4MvFQPshCnZThxvBwsWz6K2l0KhOoDbUOML/kzaf3D59hOdAiuhcq0ZMjbbmSJdBzGk89YEHLpJwSpoxCZCB8tt/FqrrLle4Emnr4cGa1DblOJohvSnF7dxD/3oIZWU3jzB4ffDEtqdTfA2xHPP5UuunMxCzDslS4mP2JyI+FlzUfE3qFld+NeupV+u/F0kVkfFMmHgftbkKwVeZeIcM14UgVeF9vkZd2KuzQqZFeWpWSeLggkFLe3gtTUZJx5hM+lVVxqWV5XRlZHciWJ1lzsTcvJtqUNow8dmd7gxUXRgrmNgMOVUzmtd22KF1AzfRFUHGv3bqLSDx4B7Nj5iPJTDw9IxUERPezoZM/a3BrNURjGtEIKnyt/pY3YA0v1kTA8odbWtxikvGZq8wrzGKDG87k28/IESNGMEazJiufAiCzJJQ3nppl0fyBnZ7eR432ldf4Z8Lih+3mTEkbOFGjnD+embNV60w75xUTdA9kmlZhAaHL/TOOohku0hku0hku0hku0hku2rjmT7xECy3X4kWYgjaygLr58dNy07Pbs+8g9Oz66fN4pHR9Z+tgC0oei3P5Y8dkZZY58i2Ns2sVvkIa0FQkPhjrVLfChe+VC88qF4JXsoXvm1Fa+k0iLwXmJBC49uCHYKhUm69hiX/k2bgX5CXhci4JbcskwXBTR8viGgaSZVTkWeAnVCXjaSZazEFeb2b4aYgdubC0S1EKUwvNhiuY3XYY6UPWlSAAP4j+QMxD30ALePu7WWZJ60hADLjmU8M9paZgS4q6h6zYQGhNOXa2iw5Pqq3wt+NHt2cDBrKzTbOE67fdYcqtvVSqEhFSHuL5msEngCi9gxdNVCHaX5l/xKWCYdq7S1cop+okg6cWggoST1EWlWiR5BDbWZCDZ74/epEkYKlYFvytpaWLQL+rGMyP0CqJ9XY75HR3ocN3SGlzkm7jfBDHDlCsSOdjOp5tDpmHqE9XY0f/qdeCamM3HAxfPs6PvvnuRT8f3s4PC7I374/Ol30+mLJ0ffzW4qUXD/DSQChTextHT+B8Jp01tU/BACbIn2QRqBzyNWdyj00sJ9aqkjeprrVBgLGkoEVmEa4guKgf97LJyONz7V8lPKVoUI6kgRTxuIt7TxSYHFzgg8v425tM7Iae1XHipO4d6aGtweUeIstHV2mHzRSh+s0rRYhkVZaCmd0ADK4oYUaj1jrwtunczIh5SgGZZAub9BTKO+XVsnTOtWhP6LPwvubH8IaT12cjHjdeGgJlAV3aARXw56NANHjmPKGVOahTFi94+BMoTpGvbSpNMkKsBtxRhDPWZg/A6d/mPC1e90uuDD4NqkxHLUjwfkbItJeokOXDJRGMJK1nBKGKRJCoZT14auTYyjDnXEQWPFgUlr44fqU6Z/b23H9gLNd/89BIi2NyT6VFo6T39XGh4G1Q70FeP+1GDwtnDY3ryj81w3U/JIfv3SYuMn47SyAbpeWupf82SD9odv3eyIC74dgAoNAfvtyqPtkRKP2w2+ttRTRA63L9IjRL6tB4/QF+IRwv0gw1FaSKhnPfpsbiEE6cEt9OAWuh+QHtxCt8fZg1vowS30VbmFsB7e1+YWIqjZtt1Ct5fu2/ENDazzwTf04Bt68A2xB9/Q1+Ybqg1yLDIMfHj/Bn6utwp8eP8m3OOpEyWzdQUlNTHhzU/kAJyKG9jLD+/fULU8ejOGuy8EmxrBMXVCLxWTymlms4XwzAUvSyPIz6LvNQts/jYWgKHb3P0dmld0OSd0m2IUq/XvLJfLMRmlxpneaZtlIWcm42AoAHyWfIVB0hTE6zUCLO0HeMWg8mLV5Mny9tIY5dmAyRcaIlgxouj6ppg0aKdzHdua0C2eDAE9bbC9hBZeZ4bPy+11btr10jaxrNWmYHzmqDTH5NtJgminq52OsXPy7SQ0J6FeLKhwE9AdnrHFNPPTGYpKT/9gEpKl309Ky4HA6tqKZrdWie0FyzfEdUkFbQJBwk9GbLkQEN7vWu1YjMi0ss7UYHD01IOR48H40zY8pWrMQLex9vYfHx093Ufz6r/99qeWufVbp9tlaYebA92nsMJmN7BG6g8EJGJjPlJcbV+VfqcdRaRLNVAcdJTWgsnj6YSiqGEzR5hew226PTyDhLdCz+mC5z+VltKJf62ta0L5Q2lYz9jWNteJ+VvxszgsB3/nktsI6KjFeAc9v5+0sX60NX/u6PnWJjt533t+RsMPNsFsYHDbUpDOoKFPa+6EBxGCdsY33Dbulv6a3Dh6Ux4dPe2nhx49bc0PaV7bOoOez8IERK/RbgHw4l+wwMDgGiLJe/R16KrHzv8N2Ln4CIWAkzYO6SyQqoLCNPbUUtp/C4cxMYxj1aYEdvjUhYpOHOab1i6+NUomw8ViqEYcMXZTKivXwAOg45sT+rrjgGt5mNlUuKUQjUSHZKqlRj2hI7NQQdrW3p7D6OvJHRjJToelYhrs5HhQ9CK8a1hST1fe8gU2jTRI+EgKQUsjtjdnGl6Qut1zlQ0X8oFXUQRBf2BxzaNcJuWs7T77ISmEwa/RDiTACpzeSfwTKSwdhXCXwwY6bsEVfCbzkL4atPeYcEtCEY4Z+CYJS+Vdwqr+gSaQr8j68RUYPv7RNo8Hc8eN5o4vztLxxRo5rDCXfB5uPwlnZ83TW/B3HCNw+SYu09/nqbpQqF4RJQsBd+Gvd1RaaKGX1IZ0KaYxbgTCZpJ6k1g+ghuvLdQR1KBf3J4lYz+Jz3WSabbulsizRQgM+FxdkhIKQdT1gDrnM27k57y7flC0odft2KGGuAZ89L/LouD7z8YH7BGi8V/YydkHQin7+ZwdPrk8xEaVoUbaY/ayqgrxi5j+Rbr95wfPxofjw2eRnTz6y08Xb9+M8JsfRXalHzOKZto/fDI+YG/1VBZi//DZ68OjF4Sn/ecH3RKxD0WnB6F+KDr9UHT6j0H8P7bo9HZB/fc+110jGjwX/GbPT3LMpgJa8JDW8Gf81Rr3X+Hzk2B4yHRZagXfxZDHcE0ANbKgqh9UIPqbNfGLAFmnbcLQ4jf2QqD1tUb2kI2dLMXvTbQeDswLGc2aFXeLY7qJdl4u5dxwnM+ZWrRHx7W0htXTX0UWG2DDj8sbV/KvUV5FzMKOhT5TgE6KCm1DAL3sWwA0KtLaSV77jzrFKqGiTJ5LqujjtXSIU6WYepgn1vZK95ANR4Sv28ENYDWgJSHXrY3sUUd/Ez0Rpe9t3D8YdJDs+gMP0mh3dDpHWaHrvDlIJ/5nsEJAtDinhLEBTLylv6JmnLU+tX6LRB5SM3ieX8ILl2HIUIRNm/SotdYMH4wroz1pNhfzyA/oL3sfN9NQqnjSJ55eftR6XghcMe3gt+ylRyZmIRV5emhi4I5wfBwBg6XesBuDL2/c62SOkFXSJMRtniZmJMX37zzTLQisM9dtaTiZjZJ7LpNjuHky+mCcfHDbuYjNy0K61eUtmOvmr247K1HabTeuR+W3nQej7W41R+vVNfwg19kVUCkxhFfh98Dhwr9B+k03qYL+5o+2XWjjLlE+HLMZL6xHJVfZQpsw315kBmvEbgSLDUqPdVyeJEYagDKMpgRVw58MbseaqUo+78uWG2fzX6VH6Y6zdr683aSfPl3Bp6KwnmVe/PzqZ6/hLJnTrOSV57NW/FsPlpa6wTarHGyz6D31uGIIwjhQrpd3Dd3+hL8GBjn1+kJCrWSE9Z+HnMNxQqDQZ32IPElivD45T1NoZMyJEZkdr8piTO9hWjU3FIis1V7zZcfIiqBvpvT1W9OyhIYhploXgqtbonfWYAS8b8229+fVdjytZdGfsr+jUXDvHL54dXjw/c7twPn5nMEM7cYltOtX9dRfgjEPhfb+L+mzgYGbv0cFp62tNIOydOc3c7Lmoxu5WQvozfvcRXel8+GjfqcDlGCg0tSUeXCqeoBvfupMZzpnH05f9SeCePmKZ/e3qGbE/mQ677HZPzhZMBX1J0MWdTMrvN1ExHNLXvVnAtcEVoi8r+mSIYfnNAJS0axw94vQZtw1aM1FVegVxI3d68TNuGsmhkzjWV3c+5KTgddMfYOk/9SJ47A3Tjus1vzxeXFcYudNW4teU4uBcUM59MjF44VtiOumLTPuwnLFx9sqVqGueK9LAluvcP+qC30l+R6vnc6lzfR1qn7/b/wre0V/WbH0PZbcKm+8nw8Mlco8giMOuc4ARu+N0cjQNg3ewXoUrH6Ya+Wv5wGAxPY3PKfcZHNcZ0fi2YJ8dQswgUYParvEuJChQrNHQs7yGpuTO25cXbXMd6DqaVNiulq0f4G3uOKGl8IJKMAzFWCx8vsGzcIFRjfhA/8Tg5lkDqBZcQ3VaSpunMUAntOzEUsbIsh8BB5y8FG0QOIqx9L8YJUaQiHVUKuMzuvM3R2RF5QbimeXhmFyxuLaNk37yeTSmnbXRnP2o2TmxzdMnbTXu+PM1DgvSY3F5Se0YGMNk24mcYAjBPXfefYP79+whb9eQXUCmI6oFSDZhPQsbfw/cBFYM+svMZI5rA/LJiCJ06WJ124hlAs97CnCNdoVwdyeGBbD78EpB3sSoV4eA7SEupZGq5KOXqxoFZbXlEOHqleFXiLUvHIe5nX8LDEobdiDtMhHOtOjpE3GiP10cXE2Ym9X5399M2LvRS6xAv37D28fN939GdvxwO2kNmv/IJqsjfitlkbkQ4af5viCoElUgQ3Aq7YBFDuIQBkjjKfuLGq8ccqkDdLGs1qWXOV7hVT3N3VPrK4BYLCHENWtHGoltGnOdf1KNqx9bduRNgib50076Wyiy9Asp7O82DOnNQdV67wn6sEeCO7GXezMek8E9Imz/yEaoi4DN9JQZ877pKE2CJvnvSsNdZbXpiHyjYi2O8QIXlzGFOp15uehniv0Qlcr3gjtaescEQWE4I2ULYPVCvrzjpJ0nDhOjEaHtJKQrkLLb7Kz2gW2kLm3+H0nmUt8dIY3JnDecorGsfw4bCF4Lsworc01+Y+9HwJ+/L/SAmYfVIGEn+ai5NL6gfMRljaDejOo3EJnnhFpg6zkLlskxQsgXwsXeymrCSxJeaXV44uw0KUsQG6SPHHTTnffv9M2X4TdhES70C0FQ/SDubbV/eR0BtHtsUgZ7EwStZ9V1MG3LoGeSUcBqXsZErBIUdn5wWsOr/3DnWF15TbKCqgf2JpwneLhXxnPkri2OyhopyqHGkBQag+jFqRlBbcu/FI4PqS7AXV7yuFFSEFsZ50ZAQmg2JTYCVOKHNIfYBVUXU0Vqw5NwATyBgX21I8XG++cvmqq4yV9ffz9nTCo8ub09We7Lnj78rAuUSe5aL55+a4VdB2iOF8cPBkf/sZmJmRpBmWMY5ztnuPzOZzl1N6SHEEoEjVNYvUh5wRYSg15kXy+a3vzF9KmpQtn0sSA1GEW6xd+sxexvRk3hIJ0aW7NcOmLG0fsbcuaAZP3No5H+YHgWBwr4S6h0NSl0+5GwOnTtDLV3aai4hh3maxdT2PjdLmw7pPWlVbUuPXiOrPdZWnpfDesj7gpcOQeN71IpPIfZaotCb+Rt/o117fQMhdyvqDCQvjJwB0Ps8aWfIUJCWVVQ66qbAQqiFsqu2ZDHkcQW1gIGPOarJfzKKJLwVXTJo9BM3j/vWfejboLI6y5F9JuUX3dS+zGl1ovf/5L8uN1En3lf1NHnO5janSFj1soLYVb6FtZaUBx378WZrqPHw0itVGpgKVin7U4En0Id49HP76+GLGzn8/9/3+4SIoOPEZ97Pyvb9JBmJ86jvTo/PWb1ycXI/bh7NXLi9cj9ur1m9f+v80oHUljRFKbceNaCz2H6k/hC7yZACgprUIVAcucHlh1Syv78P4N3jfqKlw5QKbbgtsFe7T/GAeI+qecJUnNcaTJfm2FsfuHmGbZQCdt+NsEB/Lny8tj23uxASvWrYIdhPA+CIsGBERd1Ktf2DEMbUNFkWIgHU10BXuSITxI4Rvwj3ezDmfYhO2ArrZm7+mnhYrm3XTB/tUrsdrD4w61AOjt5hTjV1eiqyuleb93NP9hmiq0RFzUJfcL5DmGWYI3PF2mdKiVNLs2bc4U1LOE9p6QYjD58fUFI1K5pGxyD+yfnLCOCIRMWVAycu04eMCYpGsPjIjJ+CwZr7vphpdtV4wTH29xWaUUnsbCbtvbnAZZeJbBtGF+ocn7rb2/WBg5c3vvz066XzdfNDpjO4GocSl3PfkDEbueo46pxfnmZb7Fl2haLLcFYcsk89KSBKFia7zRihZDL+NQ2qBqXxkRb8yGL7G3LJV477eSpf6Ejbbqb19G19NC2IWGrrXNdcrwZSP438OP1goHRXyAIz3B2O92WLLTDtyRcvxOQ6/EVuvN5pgHquJN21P/eCmTHPVHvMLKqx7Egq+EgUsR8eSpVNysmvHj8Lo26T0raRvZy3fuSqFKKyvufaU47D96qS2lsRTc1kZAw/tEd3ybPGaPEk3SPr6LFpmOHsu1k3ht2Q3bFDd8G0ONXd503Rm4hmwysUYnKHzQxVZSHgVbLcjfRVd16O8YnX1WCDV3i3aqDT5ruv2m3omLk2Ce6kpqXLuubzICrburfAoGkFr/kSj4fwEAAP//7kfLOA=="
+	return "eJzsff1z3Day4O/5K3BK1Y2zb0R9WP6Irrb2tLaTqNZ2tJb88nZfXnkwJDiDiAQYANR4cnX/+1U3GiA4HH1Y0Xidd1NbtbE4ZKPRaHQ3+gtfs59O3r09ffv9/2AvNVPaMVFIx9xcWlbKSrBCGpG7ajlm0rEFt2wmlDDciYJNl8zNBXv14pw1Rv8icjf+6ms25VYUTCt8fiWMlVqxg2w/28+++pqdVYJbwa6klY7NnWvs8d7eTLp5O81yXe+Jilsn8z2RW+Y0s+1sJqxj+ZyrmcBHALaUoips9tVXu+xSLI+ZyO1XjDnpKnEM437FWCFsbmTjpFb4iH1H3zD6+vgrxnaZ4rU4ZqP/7WQtrON1M/qKMcYqcSWqY5ZrI/BvI35tpRHFMXOm9Y/cshHHrODO/9kbb/SSO7EHMNliLhSSSVwJ5Zg2ciYVkC/7Cr9j7AJoLS2+VMTvxEdneA5kLo2uOwhj5paNzHlVLZkRjRFWKCfVDAciiN1waxfM6tbkIo5/Wib4+d/YnFumdMC2YpE8Y88aV7xqBZM2QabRTVvBxAgsDVZKYx1+n4wCaBmRC3nVYdXIRlRSdXi9I5r79WKlNoxXlYdgM79O4iOvG1j00eH+wdPd/Se7h48v9p8f7z85fnyUPX/y+J+jZJkrPhWVXbvAfjX1FLgYX/D//OCfX4rlQptizUK/aK3TNXDhnqdJw6WxcQ4vuGJTwVrYEk4zXhSsFo4zqUptag5AgKdpTux8rtuqwG2Ya+W4VEwJC0vn0UH2BbgnVcVwPMu4Ecw6DYTiNmAaEXgVCDQpdH4pzIRxVbDJ5XM7IXKsUJK+401TyRwRPGal1rtTbugnoa6OYcMXbQ4/J/SthbV8Jm4gsBMf3RoqfqcNq/SM6ICMQrBo8YkafpPAm/TzmOnGyVr+FtkO2ORKigVsCakYR7jwQJhIFBjOOtPmrgWyVXpm2UK6uW4d46rj+h4OY6bdXBiSHiz3K5trlXMnVML4TgOv1oyzeVtztWsEL/i0Esy2dc3Nkulkw0WcTktWt5WTTRXnbpn4KK2DLSeW3YD1VCpRMKmcZlrFt1d3xA+iqjT7SZuqSJbI8dlNGyBldDlT2ogPfKqvxDE72D88Gq7ca2kdzIe+s5HTHZ8xwfN5mGUPtdF/7nT8szNmO0JdHe78V7pV+Uwozykk1U/ig5nRbXPMDtfw0cVc+C/jKtEuItnKGZ/CIsOfVpduAZsH5KcD/VbSUnC1BJpzx3JdVSJ3dswK4fw/tGF6aoW5EjawqwY2m2tYKW2Y45fCslpw2xpRw74msPG11c1pmVR51RaC/VVwEAM4V8tqvmS8spqZVoFCpXGNzVCh4USzP9FUCaSdg4ycik4cI2cD/lxWNvAefgtwFewTEEJzgbgl8wv7fTEXJhXec940AjgQJjsX6VTRQAACKOLGUmuntIM1D5M9Zqd+uBwMAV36ScOWga1qxx1+GbACI0NkKjixkd+/J2dv0CSRds2EaMV50+zBVGQuMtbxRip8Cy3C+qDURTuDyRIUO4exQb0yNze6nc3Zr61ogWB2aZ2oLavkpWB/4+UlH7N3opAWOaAxOhfWSjUjyOF12+Zzxi17rWfWcTuHl0/O3rBzYCdDJPMbEZkc/+6slW53iGYuamF49UEGqUP7WXx0QhWdLBrs6mv39epeehXGYLKALVJKYTz7SEuEfCRLlEAopuw3ka+DTQOazNRoHQQDjudGW1D+1nED+2naOjZBcJksJrgeoP+IGInQeM6Pyif7+2WPEKvTj+Lsd039vZK/tuI+8yYmP0YW9YyN9FqgXp8Khmwsi2unV/SmB/+/iQmS1QLgexJhsIKWcdTtJA69CprJK7BpNehKv3L+bdJQc1E1ZVvBJoJNTTOMgN1Cs+9oQzOprOMqJzNmRR5ZGBiFEjAJqVPWqVPRcMPJBKHpW6aEKEA2KbaYy3w+HCru7FzXMBiY18m8T0swfIPkwal6kRQe6dIJxSpROibqxi2HS1lq3VtF4MRNrOLFsrlh+egZDsCs40vLeLWA/0Tagilo54E1ca7BGkd4qM2D0GUgt4PMjlTt3vUsTkNMRfcKqjBZ9hY+whwwQG/xa57P4UgwJHEKJ9CZDpsbIPW/0zG2T+wVnJ7CGXfX5IeJGZNXcsWOeVHJOxgyJ/QlMFwhSjT4QLXOBZNKOskd6OkSdqdwC20uWa6VEmiQgyoNuIHCBmk746YAZregl7Sy4+R9r7Sm0p/0pVa8YmWlF8yIHGy6yFUg0y5enBFUvys6NAe4wQN4PcEMpYgVKpor8M75P96yhueXwj2y32QoOb2l3RjtdK6rwVD+RAtqpTcowdQGj+sCDkXBEghUcoYry3GWGTvXtYiqvLXexnHC1GyHjgBOm52AqWZGlML0UFErE7TezKCfyQb1nDQV0QZDGzSAnQcUGKClZozblSFS/JH0GXvRGwB2Tmtb0LMEtTP+pAL0fmkV4udtQTCJ4kEmY2ugdQRW2g1gglT3C7aLVgcxROQTgrcXBopuChTWXk/ASdiKmisnc8AQDoZAY66Y+OiNhbGX4ARU2qhYnAb/Ucsr+ZsIXhM4UrNcGLT2rXQtp/U4LdlStyaOUfKKXAAMPiG95sRMm+UYXg0S0ToJ3gZlW7R+efSNgNQshHXAH0BTIH8pqyoaXbxpjG6M5E5Uy0+w6nhRGGFtX349nEGH7I5LFZiLBiThG+VMPZWzVre2Wnp2xm8IJGMLIIvVtQCfDpjAFg/Np2djxlmha1gAcNWwVsmPzILXwWWM/aOjLOkI6zrRzHAdDV8EnALjTzJ6MPH8GpkMbEyh4ARAUGGDtd5p4Z0tk0w2ExBtk8yjNYFjXCNUQTYGshcYsBEknieyUW9VpksnVtZkoFMqHW19f7Tof9Zbh78CPH+siJ49Wg84N4M8wG0z0C8Hz496iPlJ3YLZfTiF9q+Hn/XGnAmd5dItP2zIMn0h3RLpPpj9G62cEbwaoqPB/ymU2xRObxMrOQ42wO+tNm7OTmphZM7XINkqZ5YfpNUfcl1sAs0Xfgh2ev4jgyEGGL44uRatTa0mobR2QV9wxYshpSqdpzb9dejMhP7QaKncunFfazWTDhwqIKsr7vCPAQaj/8N2Kq12jtnus8fZ04Oj54/3x2yn4m7nmB09yZ7sP/n24Dn7v315AEgO6fVwYvq9FWY3yOLkJ2/uBfKMGRnfSCD4bWa4aitupAtWAAuOQyO83ysRni+CzIxHG8/h0vjzUS6UE4Ysr7LS2jDV1lNhwE/mz8LBrglSjhF6FWvmSwtRgehay8O27oxJxt5ql4QP4KgBQp+3TtcowmdCh9lmo9W1m2rrtNot8sHaGDGTWm1yp73DEW7aaLt/f3EdXhvaaoTT2p3291ZMRZ9QsrkFB9msG2V0ehYVdJCIqCxSzvJeAPCPaNP5tE/Pro5AGZ+eXT0NMEQI4wS0ap7fgtd9aPPm5MV1WKeDe5PW3oJAoup7g5z5r++l2A/7eGjj7ouENu6mKbZWmEzUXFb9AR5MeoHwYjhAoPgaBMq2qj5sUIQCEiPLYBicN4osfsVlBX6jAflPqqkwjr0CV4SQaogvWu3ZxjytQ29jSZ51HDg6RPCUuNdU3IGNuYau+PomdVNqCfnBhkjMuZ1vaPgRUQomCxHqOVj5uTZGwLm059YHCnJECHWK0mqZBgkZ+EhSr997K8hlOYGP0BUNJwf8Ayg6iaGkXKvSe8R51RsTbI2cq+7EzELod0XK0Qh9Kg32+H0o9OOK0G1XWSsKQMRhiNWQeR4Er/M5CCYADuhVeibVEJFkS3Lckj0/mm6LvhstPLjei+YzPphnjyII4bzSLcaupCoNj2HgLsDlT8PeO0yIgTzPbgholeyNcEbm4NoEX1jiyOaQCHPoY2vAIaVw+VxYtLIS6Ew6SzHEDkng6MB3dhjDlBAi9A7SPgoE17SKgpNG1NpFdyrTrbOyEAk5VjHzOHFG0bMwIQJMZ3P8lCzEfpQef0kAuXk3eFCEMocEkg5VItin+EvyHA4Ym5PMo4uOQH4s4BttZlzJ3/CUAjGuEPKmXbZkhSxLYVKfCfzgJAZ6Gfc20a4TiivHhLqSRqu6b0R1vHXy03kcXBZj9r3Ws0p4/mc/vvuenRbov/Uu08GGz0are+vp06fPnj17/vz5t99+2yen15CygvP9b51b5KGpepKMw2AcoIr3xeC5AnZBsokGwqG1u4Jbt3uwYtJSJGFz7HBKI7DTl0F6Ia7E2QNE5e7B4eOjJ0+fPf92n0/zQpT76zHeoMqOOKexviHWAaXwcBiyejCM3gQ5sGxuQCghozvMalHItu5h2hh9JQthNoRlz+mDey0MmIUgb5qAxRd2zPhvrRFjNsubMYFksDMLOZOOVzoXXA0mxxe2Ny1/et3QpOiQeM/tlqpjL+iF6ank3sMbglvxxX4AgyILg/y4JGWnEbksZTgjRiy8e55iUOSl12UKJIrWi7mwpK58QCExIFFf+fTVCNqSJlRL0FHg8v4EBSWLDdhSZAR3k5dFfw/Lms82KlPSvYGDRdeoRwiSgKatrByo8zWoOT7bEGYdZxFefNZHIMkAvXn0JBP0hlzQleFPcVBKq+yNu8HV6ObcOX/CsMSyGxr5nYfOaq74DKw3VN+RDwaSpIBYkEnESBJFSwXJy5XHN4iS5NWbw63IomnUDr2p3uWz18/EXAMzibDeFlv10odiq19i7C8lwt0CgASR0gkeLAAYwWIg8P/vAGC6KE73svT/VVHAdBtsQ4HbUOA2FLgNBW5DgdtQ4PWhwESJ/dHigT3UNx0U/ARlv5HI4LWT3YYHt+HBbXhwGx78w4UHff13DA76CvCbHAdvhOO76eoE1yJVmGd3PrjfVnSwpnL895BqlFbVo7/FH8qB7bSpoUI+YxOR24xemoBvl0c0CCbNBZmybq3zpUxodHUl1h3//wQn7V9bYZbg5qEarshGUhUSKjh2d+lEDYWLhBDQ01ZyNnfVusBYMhv8nvoOAGoVKE6pnJgZXCLLePELoBpUZj4XNQ9fR4jENzSFgbGIjQhSzjFGmx7vxAc3uJ16XmRIZ48p7h4g7iOuluxSqs5j8d6XGNQofug99Fz7ikogXiV8GBbITMFojFRj4Y3tSjHDtOAVCB2LqgwSyIIzBqFnoztz8YbM41eABh5B6fkUJgYCxiPYw2EjIu967bkGA6qkvgWNWMO+drKhGjvlsZg/H3gsPriZx2h910VJQjnD+kBJpYMRiBhBXkCPVyJLnkDN7UqREVedTAGGgiULvlRdes/fHB4mvNuVib3uyvhRsITSZkALHIZwWA3RJ3gKgCKMEFrDgbpJELwAiocKWyhrMy4kWlD6RFcS5W13NhXwRjTBCSYnmxsEFE9Ncoymr62rmgq3EAJGorQ+kJ6csvoIrB+MSpKgDtFA7gooeXYSVuJ2cvvDEoGswTuqWp9ZXiFEX6+C5+q00BzF+XpCJ68R2K5Uu0f1lFs6ktei1mbJQMhhPQyBKxLCE1ht2FVbQfkQRvilsCsvW8iREgV+9AkSiodmEw8tIUYXUNGH0FnOG9earidJPzAAJSepswMEcW8DksuairROMSSJq9dZF3Ou2MS/EKqOJtkg7QP3+gSFwy4vismYTYjld5HlBT6Csvjd3AgIRkx8qU7oyxIhxgLswHE0MwkLDkkn61JEwNbbbbi1IG53fTVWbzEC6ptYjldAnFiStUp82iSWzeVsTuVn62UgvImbQpeDVYkwcXWw2m1lcTy7TcYhDGGFslQG1jmqeEQz4tVBDtaRh2Qz9hM3kOMEeSSsbIHPOtNHl9DSYcwWgjUVR7cA5RswHkFW1GyD57loHJ92KQigETrTacwa32UJahoxKpXzdr3vDFca43edaIiL7DnrljWODZBW15GY3AMZZLGt744EMgkbBhFEMJ858mwoNUfpPF1CoZ4ZtgwiJkGFCZuvkODpyMn30jV5ipV/yaNuWQnXCDNK1DU9mWKvmFVRcapYDVktXS0iOlCBiRa666cEjWd8w46hley3dPgzD2RmodA+JJ7lvMoxJEnenYovo65COpGmo0ZQoGCC0ukSVXqqYzEPn4ZuKtDEiUQQOGdXSv4DJrVWsivEZQmI0cgy3a0Y/BlSwJxml0I0rG18eSp+lHaj6lMVLGGc6AodQWT6g3fOq3G6sl18cM1pG1zcVrhbuPxekiz1h9AwyVRgbXOtYCvDS5xN6J0JewSS3QrH9shksMJ9A/wcPOO+swTYasy20w59BpBqXbSVsCjqetsulZPeMoBwfWuA16plaCIlVTdoeuD3LNL95IeBRSVs8eWhiLGOO9snedtr23AHV2aIqa58KVXTug/hR8WVtiLXXXW5bl36ArdvZFXJte80RuQSZPExO1i7mC9p6LCgZE6rdNiUUUtSOKivkXT+bwE2oxHsUukFneC90u641K3f9WFLw88IBbo3IPQkLSnQWKjiDm6264R3h2qPgeD1VZGNQIEL4nNQeFdp6AmkOrT1C42FiqyH6gZdgj+AF/BRI8ycNxaOOr7tTinVTJjGSOW+gfWEumOvM5yGBUDV6jRBBJi1VtZBEz0AQl4J6ZbZKrN3CZ/r/nXy1xcvP9uR9/QlSORgrHYrlt2p8ww4Lvq4PdiioMEN8HtbqScZqfOKXXO8XZAJtprh10GKPNspt9DcjY6Cia/vBktxxRrHp5MO5gQEm5iM2YRX3NSTL9PAQyR7K+vldn9tH4TvevqOtAPKt5sb7qDFli5k/80E2qr+0yZ20hpOvF7aX/sZIsFU28TU3/EF+oVCMz4gA5giJnLTezKRbpAlfZJEIxb6kklViI/gL4DeEzr/QGwBjFlIC/Kq8PoeAwwgw6zgJp+LomNYaKIkYxMnA4pcXAVbdvLBG4mTISXPRcMOvmX7z48Pnx4f7OPBnb149d3x/v/8+uDw6H+di7yFVAP/F/RKE9z5M4Xxzw4yevVgn/4RkVqAj9i2ObhzIPCHZkjTiCJ84P9rTf7nA2ghu58dsMK6Px9mB9lhdmgb9+eDw8f9MKluXa5rsUnxRUNcJ8F6LVU7fwEcYvA0SF1UybHX07E9yLGUh9GHqa/Gv0jSiUhI7T1LLqvWiLUyKUK8k2y6u0yKcO8umzzOvbUz0l5+sMmmvG6blpXmbt36vJP2kiEEsEoaIzUwZ2+l2CORzTJmiXGZ1RWiCC3swizAWY8nER9YHdnuqIfzZ+CKz67B/QO4XfoTWMt/105i9Bb0GjS4KZi5fULj6FoDizz0sWRsH9byYH9/VbaAX4pL5cvuKbIJrW9An6BLBF0h4IX0s0dWZNxaOVM2Qch2qw58ByAWUNQEQR8B3KO6aXiqUewImlRS56Vs1COiFVfCdNbjHQ4HPcKd0+crXrq4dgF8j3wZ+wnm18VVWLS/XfcFsX0tOBxCFej25LAeT9xAQzij4gFsFI4ZDI63Tq/63mB9an4JvWHBTeiHkrSpc62stA6AE9lCYG5lI42erdAQTgV9At7D/Pcnl1sPAOSQTI8ABNMLLTgKdI6da84AcILZYMnZKNGo3Tmry+TuTwmcE533IOkQ6nuEBp94wLlvpFbgsVqShClEydvKsfOlBV0fgaaC5hTH0w11XsM6voW0qdfjpJO9cVBvLyGjHENEgiutMCBw+pIG33nVGt2IvZPaOmEKXu98k2zX6dSIKx+jCK+fX+x8A8vIFfvhh+O67phb8iq8tbv/5Hh/f+ebbPRZehy+Exhe8UEvMqpbsLAS8lBPeX6lsRozViJ0fcPBzQnMy7O0xzD4LdKw3Hfh7xuicifYenA1hMPAWTM4j2B0zLIpHNrJTU84U5QJuqxj4D3ERgC2F4txeoAUFaBEdxu3Vueya+6LFlnoyhcCV+Fvroo9ctL0w2m4oGCJaCuon7ePfOCQp8EuZW+8Uw/I+p/fnb75r9D723YhKqrnxfZ9soqR8WBFDCsxeFkK39xeVoP5ENBOxMQg5ifEi/I7Fr5cJwNf89C2HhYFxufAQNQheEV8FQKqpO8w2n32wEsEfk2NGxAJEOzjg2MP01IeDKURskgcJdmLKGhJxPIKukgKbpewzE4gC03xj+TjNUkajZr1pjOTxYYmcmYktmTHHQ91vY++P335zfWE7Xhu07goXt+wwFINEjYeDI9TgN1ltISMDUAiRMNSOZWiVW8Oqze66NEDUNG541WHaZLRmjDT0cHTPo4PKxjIeYQWTq0LyDFZEQ56EWpiH54quA9xgBF6R0yX9R2Gb7ibb2j0M+7mwagd8qiVv92FztdZ8jg1gAErjTVY7FH0iWg4u/CiCLbbBGBhqtsEEJl800fFcTMT7sMGSXGBIzAYAU0Vu6wrqS5tdouV9GAIILmACkClSozh4p4x6zBZoUi7MZF6QVmbKE3fozQ13VE7ScR6dL4iaj0jp5lTM6FTA+17oW+zz74XOlgfYCzl3Jhl2jWFd97fUFGSNojhQWP2PTqo1ZIilJ6hR0ZZIYyM7jQn8jlmnnVN/wGz07NggUMU29Np17Zw14ooPsW4+XLq7r74mrsvsN4uoPSF1NoFrr4FlX9dnd2QTtsauy+hxu5LrK/7AmrrhoeFoL/ig+s12EUs7CE1BuwEPkf0qkZb1ysIyh+HV4yoxBWPm9PpNDBxV72yMaPgIYqYNigF1lYuhXHBu5Ku4g/h7xvMkJPYVqfnJqK++hDfbFpMXI49oMJGhYoI+DZe7LTeYZne6dS5VeDDrrFB54ntX9yEZiFG/dbmByeXOOFcka4xFZggzrkp4PasMbuSxrWQiOz7OtkxewktH0zwHKNYg+jA39qpMEqAIQ8nzHDuvwtfQihTwgVcrVlhgQfZ1T82IS+Owh3peIN9/vH50w9Pj7a9ELa9ELa9ELa9ELa9EP4b9UIA/bkhTEY/EOwgM3s3QUIYkILlXQK6pWS3uWCTgBkUGtc17F8jXGuU7V3eGFoojm606h5mPmTS4bgybct0YiMdQ/oS3fji643HcPgISSTRfgUTV6oZJiNQ7vmNrVG9pUzZyz4kCJSdQP9bFESTVSo0t1BhfZ8LWDYmm/X9CjbTn+IHWsr1Y26KP9/eyJvg5CK29FyZcGTCie/xzh80ooKQxKSuX+G2JnCNR5jUKAxmEyrueB0rpbpCJXCRQbEBZEeoAsK4IpeFsGTjIhtFoE4Db60svLZZyWtZLftUezDV9OM58/DZo+DrM6KYcwfXDU0lV2NWGiGmthizhVSFXthvBsLIvznAu6021YpjYPNSKwxMbggxH0oRY6GId60cfcNz9uM5e6N/4Vf9MjFts0sw+T/bHPxoEW08c0Fyt3VmXWvTo+wo2989ODjcpRKwVeyHe23T9A+Zygn1ryP4f6xiG47NnwvjMB7xPfiwtB2zdtoq197E69wsVhqp6Niv4HMhT8PdyiMH+9nBUXZwSxjnYS/0XBG/cCPii14PYrpVliIPve7qEAHCa4knsW/yBG/Bu6q77B9q1JnYuiTbwZBNLm1NOounEY9OV0eI63T2aNtcaNtcaNtcaNtc6I/dXGjuXM+L/8PFxdkn3zwCH8V02Cy0gmGT1lTU2BZzCJ3uXYsJr7SmCvjStbZ39+eHD6a6WGZpQ9qbtkeSkBEqJ9NP+8Tt5Wf00WQ46ip5nz9/dj2KlExzByTvwwkXdBzxi3Ejlj+IqtJsoU1VrMd2A7S80JDNZG+i6CNAFjf7XPBCmDXG1cHR4/UEhq4turgDzvch7ahHUj9UIuIu4hUxeF7znWGmIi0PcJpVeiEMpM6jCA3tpjJ2LqgmVudtHfK8ImxL3Vl2TkNaPRwIXr0438lGq8SZCTdmDXQrYU3r1pIJL3k2G0vYekfgSc9K22PGwWqC7LHHe3vTSs8yeprlut5bwd02Wlnx2fe5H/auGz1F8vPu9JvwvH6rB3w/914nbO+32QlpqPts7RpX722o99Dsk8/DXO/cPdrvR8Q2e5pDvGiIIVHwtBYQCV2kSHm/1rO76W7vXuK95j0gokJ3q7srYZx8nxAPYtiMfgxFTYBVDHhQ/68Q+qcvmb/tXvRKmhfcqMmYTbAVGvxDrin/FMb0phNKqTYxo1Cc1ivZgsmEslq+2pIAd3nyBoEF87eEQjbbVNLhuV86KMGSqrNQG256XQ5P0cB2cClcyGqdENhgo3muSJ2hXCVtYQBiWn8X1oKgpGWf/WmEyY4HEwplvRHmnF+JWGYEzdigOhr4M3RJ9NmE3gkgVK79bQeGKbFg0HsF7NJaX8VtyGCyeQVlbW2zinJCnntVJTOrqeh4NIL6e1TrqR94GpxdaBj87uJkjLSB/4S9WdLeD4xLhTGpNHibPLpeImDDglBW00/pAMwhINMqor/PANZXwgQJ0uWPYNZngJOmZHRMmIx0rwSQAD2cNAjsasFQaP+Tje4sxZCt1sWgH0yoj05wKOz8gA0SuEpHJQnXGO10rqt+AyJuptIZbjovP6NyVeqXiI0G4bKSS8FqCdWUVLI0Rg7kldU4GLYjSl+2l8tGdJ4zmf86ZiXPxVTryzFzC+mcD1BIyxZhnULUtmv+1LXuZFdCFUmPJG3idYg0mUKAii1i5nBsg+B3wR40K2SnZz5d2oJBa6DQK4G5kCZUCH6BVjiX/avc1hhYA3XyKcbVyJ+kECxzhiuLNjfmO0417BtpBHVl66hzWrIJ9ZvCL6mUPm2WHp6H9j1jNgmblX7y9VmyWwnb1kMCPH76vEcAkiBu+WFjjr7RifdaYQNPmCTOLpkcOz2DBgVF4CZu2UJUFQk5Asni9usSE/ryj3YC9hx2Wle7fKa0dTKHVkWq4KZ3VWYEW1Z6kS7Ga8ENNFCDFA0XT0Ez6ebtFM8/wCDYMG0vEm9XFrtgqw3pfXA8//Hf7NujH/7tzfdP3vxj7/n81PzH2a/50T///tv+n3tLEVmjvw4PYt7svAzAg50WxLUzvCxlnv2s3gmYD1rJIUQOFb4/K/YzgWTsZ/YnJtVUt6r4WTH2J2gFkfwFHUWM4pX/TXxM/2oV9p36Wf2soKdzCrPmTZO0HaYLYEF57fo78ai5G7xD3WfHUSElhk0KM0ouADOyDNPHYfJXUiwyj8M1AwfSQA8HYWQtnDAekR7Sd8OpQ6SHAWCCUQsaLIUcB812VtmJaN/jm1KbBTeFKD7I5hbWuSHPILlTI5ak03ZNfiIDuTH64/A4e/AttEY5yA576Emu+AefqdTH7sEEzOnJ2xN2FqTDWxyKPQo7d7FYZIBDps1szytm8NTYvSBPdj1ywwfZx7mrq3j0Zeyc5Ajqq9CdJHxlSf7wCjtVoARDU+mtcN9BNSpIOIv/IudshAvdwchma8k7u25OA4L3qws3HQHxxtF0yTQGNKHVOPiMSZ2RXJGRowfYfg9OLvaTLOUDXnNCCpeA3Evl0rdrlG73yxq1G36MIIMCXq94D4/6s6alvWXa91ms0etn4XQRh8FRMyY+Zgz2xZhVyOK/8BwsSSAa6N74+hdoucVQSKBgxHoTJDwHhuc28nIixLzVDsnzgnc9HwT7mx8n3YbxSoCOwhVfQv1hWzRj5vJmzGRz9XRX5nUzZsLl2TdfHuVd3nyWFIRTH/D98fwUK64r5noHG/gtsPVroGIGtDvyFExOSY0V+Zg1skaCfnnkBKQT1wA1pTGpb+DH9NkNzoETFXramEG9B5ijkleBg8exDhZOa+nhlvDzfSRiY99CQM3DOMDHj3wjkdsh7vb1GxlXSQvXKF6orzatMGd5a52uY4WHBwo1KjB8aHe/2t5Eq1LO2u6CEahVatXdCcCsLh0Ml3Q461eclNKIBa8qC0lqzrSY4eUpJLXaawxOER5SY6muFUpiuUIfb21i36qFmPawSAbBfO9KW8vWgQZCnpy9IWqg2REQDdyQOnCg39X1/hsSUB5vnzGiluAsTJqDwTxtZAUb2rp4drCM34HEoZkKwaSWKuyN9/SBwsDjhirYq4vXcKxrNBSQdG0XqQF0YqzHzizBdACHObgGsXdVIaChcKAHlBCBXvkEp9O2rmZbV7Otq9nW1WzrarZ1NdfU1ayW1QRt008wu6dTJnG63Aj+s91TGobfFjhsCxy2BQ7bAocNFThYYSSvNuswDudrOELhETFxr27aywFuiHCHQCpWQ5PbG9vVC0N1jXAwDJZTcER3kKBpQrYu6yaECkx6mUA4eGIWTmHxP42li78+LvEfuqqEgX/5Qyz8qzuCrsmNCDB7JO1Fnx+SqHHmfoQ0Pb2/qGv3wYOgEFmKhki7eGgz40r+1hn7wc2z+vyWPJAUTjjfC2Ug1QMtWZBv/dT8mJwBJ2qugpbWhuzVHtOtZGpExuvdODoXVQPlNowbA3ezwUHfd9j0cLqbfLjySTpQTan7CfoRjW4+n9KS419QkpKi2mepTaqxdL2DeRDG1b1rhzsRfI764xZ2AiH043nsVkf5ZOtZR69I97tnH/4hLcM/uFn4B7YJ/0AG4R/YGqR5fi7M78oanSkYaew9vqmUO0se3fmK7GuFGw9DrNd0kBEXtV1Xbkc+5x484KPkamBZ7CW8TEklvbxaGCneq5o1WHZXOqEgU2lpQ6vjcGcv3psMXneCiAZiI33ACnh3Vukpryi6BXGrgG7nULqLvOZmZjfEF6MTY/iS0iWQSNzMMCKc+sne4O2RZE/46UFEWuRwFYay0smrXr1jNlphI/pzl9lYjbnLdoM43IVoQrA/d+H0Af9b6dEsPoq8xQsPNkSKkyne+QI54F0L40CVbvTBDtlrrdmbSrUX5vYZ5OaIdhxpIXrFJ/RDH2rYJRWkWjdGzwyvY62jlbWs+Jr7fVeRb2RxizV+XeZHQE2u9LxuBiDXkuM2sA2H2ycG0H/v/SYX4Z7TdNXpHpPBko8O9w+e7u4/2T18fLH//Hj/yfHjo+z5k8f/7Pvp8dqrIvtd075AGOz05QCJo8OjfkIXmpx3GOp3MRwOknDcBZELn4/xJnh/ky1KSkvpGoGggGgGcRefXT3tLrV0x/FSy6TZAONsavQC6qatCDUbhETYohCvbfgsNv6pMBFKDcqcITYu1eyDT+cc3FT9YKQCitBYlOIEl0HrMuWswWLuzXUt9njlr4wIKKfxelK175JHN6ramOcINcRwuVPoF1ryHC7ZBZ3ZyCuNROUGckVBVUqRJ9dFwdExLjYIF/+CXb3YhLLULVxrAuU0XC1ZU3F4E/KBMcZL5QXsIkWBQPub6QATOtjVY1/0DN/yoKIgYo5DUBasZwBJahWq3KCuhUBSVYpiE6JiNokzOYHkhNwIF/0w4L3pPPvCjintjybWYpshCFnEcLsZU9Z08NgkCWpjllcS7+AKr0IUkKLxWZoXim048NgORR8FTvH0LGh7pzvsZTMZe5MHrmODTFBPNOot4JMAT8+YM/JKQj/fMVNwkRTUIvhKAwIqHaQ3CG6g6+d0GXNp0qGOeTbN8qyYfIKVIps7bKj1MZWTKpapQco5rrEO3UNCu9owThLtoD1x3j25YUucsPN1GTldfWVB3RnCQgGTKEogKrXpZ80YMYOEUzCoIf0B7/Lu3oesDMOmMqY4ghXoM0xzbZJbgaGPy8WLM4LqY53ksKKUXiNyISGRiAgklcRWD+f/eEspmo9saJlPQAFgh0vGvosdW0Ie4WAk6kJbLZN6U08PgrmSmq5suHwQpQLlwEA3gzbEUhGSE6ZmOxHeDgggLKdOwAYs1AriNvT4wp/J+g8h32GhE0Gk8w2gB4LNrgyRzoME0nlvAMhWaS3OgiB2GTpSAU/80qq8O174nU5frwPWkbZrxdGBhN3rl3EXFRFxQmSQFx78XphC/2YTsPMUSC1mRc0V1FRQzjsQGkq6PvrLiUieEVBp8QQFLUacZlcSpgt1x53XUbFcGMd79UpBVpk4Rgm5VwEmXW+Vcydm2iy9sKI6NetkVTGhbIsFT9xdV3ECBCtlVUWxwZvG6MbAzVbV8hOkEUnyO4ike5lDyPV02Z1fmKg60KMfBUw9lbNWt7Zaem7GbwgkXEIMKi0a7Rgx4CDGx4yHdngo3ltsogdNlOEW4n90lKU2immHEIYsD+4ewinw/SSjB1S6GpkMkzAVFGUSVNhfrc8S88e9SSabCci0SebRmoA7D1QWaI7YXrq7ro8BNBm8x5sq6/or/ABnUNeVc9BGIevQb8+BvXXwvJ/27Sd1C2b34RSSBx5+ts1k22aybTPZtpls20y2/0aZbLK5BYf1h57RMJMs5JHR6wz2NfDVSpiWnZ5dHYEyPj27ehpgiFVd+9kS0NZlv1ER1i0IXOf0OqOqsfso9r5P7A51SNciAWVBN0xx27xy27xy27xy27zyD9e8klqLrHrQwqMbXGjBHQN3D6/6Y4KYxN+0WXOfENhChBxcJ5TrqsILn9eHeWOIt5TgmVZFwp1Ylw2ek+TqxjA2WKkU3P4Ed4Fo5qIWhlcbbLfxKoyRiidNBmBA/5EsUd3jHeDQ3o1AMWqiUVBdJFwJgZ4dyzi0rgGPJIarrK+DnRBA3H2FxguWQm+fhDme86Pyyf5+2SPGRrbT6P3q/glca1qlwIsQMB5OmbwSfgdW8cbQZY90VOZf80uIOjjo6Wgl3o+fCLYIGlkoKX1EKadV0tptiE68ZiL47A2sE3SFECqHGUhroVwO/YIAy4gCJqCgz0kuOve9D6RHuOFmeImuFiu6ZAZAMDI7utesVLNKdHeEDVa0ePxMPBHTUuxz8TQ/+vbZYTEV35b7B8+O+MHTx8+m0+eHR8/K21oUPMyap0qO6EkOxmT/r0mnZWrNh9J2vA8C1neFomXHi8Ut1Em6hY7k6Y5TARavO4DcdMwXDANeJ43T52IZuknFOKWM4Tf4H91IEXcb4N3FmRg7gTAnRFw8esBkhYQsrGkLM6fP6M4T0yowUKLGgXiTXc++QFAK13STZVMOVcI0lZXUAKrixl4AumSvKg4teCiGlJAZ1RbV/gY1DT/nVWshlJSeihiGFv4quLNDENJChmkhSt5WcMlurpsYBo30AnFK3sgIU5YQuQowaD+KYsjqIp3DLu2ZHl/bJMb4kIz9gu6YQfiRt2hO/5J09U/aXTBuYOxQWI5qf52e7QlJOItpFYeLUAHiNZIS5VdXFIxSs49dnxnHne4CqF0fj9hxYNJb+MktjNFbDrIMNrEi/04ZdSsLEmMqC37jqnQyDNt26EtwSnFK3hbOX2++YvPQbIABeRhwSI3H2WGWdjbwoZee+dc9ucH6828NDL9BIC7EdhAr7wjYoyguodaHlETcbom1pZEiCrh9kREhim1tI0JfSETIrwc5jhIm+heGhTxK27DQNiy0DQttw0LbsNA2LHRDWAiVxR8uLERYbzwsdHftvpnY0Jp5bmND29jQNja0jQ394WJDralSx8D7d69v8Qq8f/eaTtvhJkpm2wZEK/IG1LdXkGePaa4G1/L9u9fULY/eDPoA6DU1gl+CQ7bQC6glAId4DnGTMR2WxlifRd9rFsT8XTwA605zD7dpXtLhnMhtqnHs1r8DvY7JKZXleifZEKcKT/vol7WMIz1rvvRJ0pTECxaBb+2HdPVJ5dWyq5MNnoEIFeabeZcvFCVyK8aUXR+1tPemzXRQnBM6xZMjYGAN9qfQo2tp+KzunBgPTtkzbYJ1Hm6/46Wj1hyTrycJoZ1uUupegK/560m4nITuYkFSBKSz0ecqMz8tETossXd6yRrWk8pysNgBukzH1VomvhfM7w3DMTDi4ZrADOBNILdb4IWsSXdzCQFB6LjoTAtBVpAHlDkenD99x1NqxiTLnnbr7pb/+Ojo8Z53r/7l1z/Tc//3107329KGe2w2RNXRe+UvuxFFdz8QsggVkqSzjbMkSHhCoox0qWJhQNccdJz2gini7sSmqGExkf5G8CDGcHl4DnVe6EH3MOBTaamc+Bdo1hxT+UNrWBBsPeZNVzPWb8XPIliO8U7wLwdExz3Buzbye6+FBS665ufemjfc2mQlH3rNzwh82Mu9q/I6HNymDKQzvNCnN3Yig4hAO9ktp4216NzlxDEY8ujo8WDjHh097o2PZV53QOA+9MBoFA5A/Br9Fkgi/wtEPdVs7RwIJtzPw3ZW+Gogzv+C4lx8hOYcIrnGIR0FS1W8MiVzEhQAm/xlgpsxWlqMujYluOOn+A78xuEbTKgIb42TwfADStWIEONtSnXjOnwQdf/mhL5eCcD1IsxsKtxCiE6jw6AQ2c758EjvDaRNre05Qr+W93ZQkKSrBCIVCpcFmxyvVb0e32tEUm9mYCtv8Jz1nsCvTC6tNoydCYJFHP6+IVB2QeZ2MIzDbuifYmK4DF/1KgiUdiWueNTLZJz1w2d0HSHwD978Bn4gAU7m3pkEnkjoN4ZbIZzl/AU6bs4h1QAOw6F8tTH6ShaC8f/H3vX2Nm4j/ff7KYj0RRPAdpLi2aJPD9fDInG3uU26aeK0fefQFh2rK0s+UU7ifvrDjxySI1mS5az3tgUWKBaNLM4Mh8OZITV/XMItGUWzzcy3SeKS66HQxeP+nFcgf6Pbj7/BxcfnvvP4ct2x9brjL3fT8Ze95NAqH8sHd/phml2Epx30u4XhtHyIy8R5nqoLueoV3rIQcaO5WrvSQvPsidqQopSFixvByYHXmzQ8Xsoc3sLKk+r8i+4qOVI+RKu8Np9kJxO26pLE13MXGNAsLJ+EoMC6DTm5lTOZx//Ls+tdSgvKAnkckeN6Iq+yP+MkkcevByfi0LLxH+Ls+o5Yiupzp9+MT22jSlcj7Ui8WS4T9ZuavIuL429PXqMd2GsCLcThu59GV5c9O+atmn7IjgRFMx2ffjM4EVfZJE7U8enr4en/fUd8Ov72pFoi9kvR6S9Fp78Unf5SdHp/Rac/LamViM0W0wAt+KoPfnwvJsq04CGvAcHPrypwfzDIztzFwzRbLDIc9aX3FfwxwbiRKI2BAx4ViH5Vb7itPai0TaibfGsvBJpfCTIoG6Bo158hWs8ClknsrzVxn/a9JbT68iJ+wJqDp0W+UmXodi70pgWbTf5QU+fO2j/GW2fyAz1knDUr5vpM4dRFyCrzM73saXTVRWpEMsQgguecdGxJGUUxVfSBl44FdDH1Bg+dQstryKlhEeFNK9hCViCNhVw70GYhN6RjcxEhRFzltq6fAVordpuAa2W0Cp320TTJVlHYSGf4031DNNHikhLGajhxRb/a279paajGdYCKXGqGjKKxeWHsQLoibFnOt1ppzmbAYJlnEM1wMPf6gH7pP79qXSzueNIQyMvbLHtIlJ0xreBX4g2YifOjyJKIbxpHE8gfeMIMl7asRu3LrWvNcLiskpAQ147GvR+4tTOmDgJWwdUiZU3YKLlnzLZhOzIaMGADuuIiNR8ncbEed1Cu7aO6YiVJ67pwG1LeFU9u4uE64Si92qAPIlQ3yoNCOHd/12wu+xuq8hbVpAoah62tcVEwtvYBVc8TDVbKdDrPcoev75VBg9n1ZNVbDz6EDyOLwQNQ6tnEWFU/pHY5GlAt5IPaHRtGcXOwI9bKyG5IX44ukROVaCG+EqP35+/RJfsJF3YLuYSDo9W/GNgad2OLy7HF9F6AV8KSMHCSC3sX5Bbdp+ql9gL+ApNWuoTFcJdzOGACiue14kkWAzU1nT+J/iM+J0ZN9WC9SAb0nu0TgYAD2KE0S/thZOWS1ZLeLunNS1O6CXUgJlmWKJl2ZO8scMR8fQvLvok304PJKk42UW6uqDfcB6ffnZ+e/P9BN3Le3wqDgd/H+lX/sJrgEGzTV2jt3/FnNYDD797BKXsrAWjwUrZqsjBoqzYLr25d5yq7l1lU3bUv2ECMA8uMmjLXolrF0d4wXWeRuLs43xQh/KuXcqr2hipA3ESGjJG9cjB1V0WbyKyK2q4KuyEinbuQy01MJhLTmIq9oWMg63HmyhQn1Kp0jPl4hga4DWyN1DLJ1iZubK+IA9wGxHB18C1p71NmgBtQBx28V8Qe7Fa09W7Nx+O1cEmdk+YMupwqntcrclcO3Wtxf2Cr07oB9m4qVz13dawIw2CjS0Kdc0Uz/iNLsg+x7CMdKIr1NHvk7ve/7a/inH5ZC/6eP213OZ/XgOI2j+jwIAcNXKT3BvaSoXw1WCcSNXThP3frZ5vL4XjuCKC7sWaccbQ7uqFEWBggm/ALGb6gUoYshQGp2FVoBhMiEa0QOIQjTl6slqXrO+Pq4TrYpKv5+y9gRpyNXCgEx2a5mCiAMOtmmqKryEaDmAfTAnGCABpHhjStHk11GkTJahvAg0K0rGw9wkXw6tx8oyiRhK/UpjS/uZWqYyHVUFvmWbSaFrszckS5oXbvEhh8Wvdza0P7YnEpof1a++vsQ4b5aAtq1l5vR8zUOI9YHabPZEH7GiZxWk+HC+rfGTvi5+bZk40it+hIWg0lbUyf8sb/NQeBBqy/+UhmNz+EtToRp0OTXBVzhB9QD3uKcHVqzV63B0V25v6uRVluLUSxGEZ1hQAtlT7GeZYuaOv5ilYEgpVDx/c7U+fdUC2XBWhu0mfsQqmBG9UiHxzTIWuT0RM/jUbXPXG1vv3lsiduUHbIVKC/ubs6Ct39hTgAcQf8zhoP/JU1ohTiXEX8EO6oJWEmTcBdgRbi+ZcHGknlvwEtqk5q0IqStUFqQYnll2nUT+J0f6g3zGoDAbU9hKhupSMiwGrH2dSvpGXujW1HyiS04+WddFpw+WY5len5njklHFStc0/SY3sgFFtXsYJ1TwL0QuwfJUPUZWCrDFVw7lOGyiS0491VhirTq5chys4YFKs0VQm/j9/RrJVUqgHmVnI2i6dwcMQELUgiNZVL7Ro1oEiZDYdz3XGEuH98TmR63xP3D7myoX0q10uZ3rfTHncL+i+T/evvl29+dpBYBF5PvL0ZwvYB//Dm9vrNz64pibg4p4l6SERBK3mUJRMvO+Srl2mk0j8sz4z4nK3gk1r4Lg28nUc8k/sFlLDhu5FDn+JU+esb6qKMK2Two5g9htW1+HlVfwhrncBFSW2TwnGxQkzjIGJcYts8r3ss+8vDIYdGU69cyo6i3Ra44uy/redmfYmSe1HJHVTPRS7DFxd2BgnaTRifRMyVjFDRgpWCu/+9/6PjD/6P18u7SxOsUjmRLYo1VF3UQ5oTlTfCJpW2EZTNBY0LNDuazpUu5Uzc28mO0b4CU0pxRgK/SOdUhdAwl+XqbFvp6vs7LfPIrSYQ+uY8iC0NXwe4fcHhDXmMviYeFp0niUyX4JfUerWA3+lcYuPkjV2+H/nFBz/CUR3i4UG9d9zFNwZo6oTZ5OfilcGMhVHucB64SCPThMRUdjQTwAQTqQsKmYlT01jJnGsNDvxuPvX1QuaEB5crcBo17ZBarPKFimDJDQhXzC9N1hWZwK/h7N9IaaFy3+fp4jwUYwwdjcylP3EwjcLu28T2mMh0RxPxK6xDsAohaPi7k28Gp/8Rs9wlBTvfX9oE9n4hHx7MXg5emeBb8AnNeyasF5tJcWJqVD58rTfwo5kN+x40i3NdtKpYMG77R+vyYlS3Wxnghsw1gOMvtkLcWJYGgOy9VnhkaCF5epCqYmzKoY2LrNhKOA3lhdB2Q0VWbxdkNKQLOpjfF82Lm/3Ok6tg22VqHN+W+ZE2LXKZbmrTEbPKH6tUSxa+VbeCwyvdrhhgZebxw5zqWNkhzhEqmRe8+STX+Jw+zRbLVQHvPHbghTW3VOVPu7QhZ7Zs3Wk8yZVGyVdropG3HLoyChGndjxUeTYrQWi4hqDVonLOY0MEsQH/9cX7d+yPIQv2w4/UgKn6mPqq2cclli5UMc+i7Sylc+Lxo8onx3ZQLVODSwVewjxxd5wGYtOIw7fDUU9cv7/Fv3cjVuPiqGf8gdtfLjkQ3EVOPKTD2+Hl8GzUE3fX529Gw544H14OR0MOpWJpcsVKgbbOFZVEUHXMjbA3KYYUNldbtAIFTGtm7eG5rHVsP+S90AnX2HSdoNrM4fGRBeD9z3jGcug9pPtjBIDq41Ob1Ruoi7X77d4CgsmFPdYbLwayfJk0EGgaTVO7WuwK74vC/bIN6syKIC2ScYBDU7rCbpaQXivhLfzH0KpktXLbsavs2UN+SqwI7/IJ49UPat03O80WEqW3PTQa9UFVfSWeZr7jsdwMxd2kFPPVQqYCnY8NWTYZjk8T9Q7glYRVmzg2opQFdhWOSyaj5f7tcCRIVMZUvADE/rNQuiABoZtTU6G0EY7dYChIb3wrA9HWfhAMXnXRc7lwUmYZUqjnYjs3KGMsfNDR5WXmMT1QGTj8Y6LsfQ8P40bzPJ4V/Zvrs+roMCL4jOV8NTeZNAv2uMmemiDoAXXUb5/mlX2J0Nrqbog2djaPV8BwBYLJYupSULdwHfy12ZTGtV/myp+Y0QsRck8QeXYlfc+gdpgeHsAUebaaJErPM9MkORyncvkUDP+N+aM0w1oT7+jgO9jQ1GDZaQV2lBysNOTL29TKNieoKLTguuyCsKfY52UKcSiXJgoCyyUSuca3xDRZk06exKnM1wG+B5+twnL4GiX0hWbWIlS2OaxWe5+pBfu5p1pyGhdK6lWu8CWJRY0dXLHH4pB5kvpoFy+SQ0chAVequeqSVCSu/jRmfO9xnJaWpfFkwD31hgWDGQ3f3M2AKrdYNR7Dao2mtEwh493NFaO9LxKVPhTzcmaXfebwXFzzj2HoNmyvp6qW2hA3zlbFlsk3nVVewgErrZ+TBf8dAP1vjFw="
 }
@@ -27,8 +27,11 @@ import (
 	_ "github.com/elastic/beats/packetbeat/protos/dns"
 	_ "github.com/elastic/beats/packetbeat/protos/http"
 	_ "github.com/elastic/beats/packetbeat/protos/icmp"
+	_ "github.com/elastic/beats/packetbeat/protos/kafka"
+	_ "github.com/elastic/beats/packetbeat/protos/ldap"
 	_ "github.com/elastic/beats/packetbeat/protos/memcache"
 	_ "github.com/elastic/beats/packetbeat/protos/mongodb"
+	_ "github.com/elastic/beats/packetbeat/protos/mqtt"
 	_ "github.com/elastic/beats/packetbeat/protos/mysql"
 	_ "github.com/elastic/beats/packetbeat/protos/nfs"
 	_ "github.com/elastic/beats/packetbeat/protos/pgsql"
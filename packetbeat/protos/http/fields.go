@@ -32,5 +32,5 @@ func init() {
 // AssetHttp returns asset data.
 // This is the base64 encoded gzipped contents of protos/http.
 func AssetHttp() string {
-	return "eJzUVMuOlUAQ3fMVJ7N2+AAWJm6MszEubuJyUkAB7dCP6a72yt+b5nEdoKMxNy5kBfU451T1aR7xwlOFQcQVgCgZucLDp8vly0MBtBwar5woayqk4GNw3KhONeDvbASd4rENZYH1rSoA4BGGNN9Q0yOT4wq9t3GL7LCfTGe9pvQBqm0UyMAzIzy/Rg4CMi08B2dN4HLFeEv6lnjtucUzk2RqzhpzHLsBmVr2YZfbcGz9jRs5pJbg81LxwtPV+vZQslP6/pAEPkCTQ2ONkDLK9POiGnISPberoFUzOm/1nF9nLU9oXwfVDNsYELshQYXE0ak+eqpHLvHU3cquSoYZNpDmE+QqIS0I5BnOc0hWUWbu0RwC9fwufUy4qnFEzQjsyJNwi3o6ITZWawplkT2C1KfzJ0CjomNGq97Tslvx8ajekQwVoh/L18h+KjLGWgz4B2ediv7eWkFIYnh2g6dwlLmjvGwXZenA0nE8av5B2qW7/dkKPtpo2vw6/x9H7/8Ev567LH1CSxa/09InzHr6vaUb2x6F3Gfo9B8ubxtbnTWz5K9UznP/QMHK8zMAAP//lgPSsQ=="
+	return "eJzUVkFP20wQvftXjDgTI33Sd8mhUtWKwgVQm6pHMrHH9pa1d5kdE/zvq3XWaWJvQgPlUHGBndk3b9487zCDB+rmUInYBECUaJrD2dVicXeWAOTkMlZWlGnm4A9nzlKmCpUBPVEjUCjSuUsTCL/NEwCAGTRY0xbVH0lnaQ4lm3Y42cO+bgrDNfpCgCvTCkhFfUVgemzJCWCTA5OzpnGUBozdoruFw53teaSTSM6UY6zGXoOEObHbiw04ZvWTsl14/7M5vN9kPFC3NpyPUvaYfhgFAT5CjRYy0wiqRjVlL1SGVlqmPBAKnKFgU/fx0Gua7EEBwI9KZdXQBogZkEA5X6NQZcu40pTCdbFNWyupeliHNU0gAwUvECATWCbnraKa/k5NzmFJ5/6PDtZKa1gROLLIKJTDqpsgZqau0aVJdAT+Xh2fAGqF40itSu5tNgfhdszeolRzaFmnjy1xlyTjaiXb7EXHHJng1Ofl17tPkKHW7hwcCRSGe39e/Dc49Lfge1BLtFarrMe68LyWfmLipfbtp39oYUf8pLKxEG8w6KIiKFqtO3hsUatCUd6PCkzhexgK9j1TPrYkPWNt/RtUkdZmbVjn6RcmEuL4/GuSyuR/l/4u3Q3+S2y/YXflCcc5OkFp3X1m8rjO2jTl6Sx752ygwUPD7me29I6YbaJLEEaliUNPE7Txszpp7/9jfb2f9uMOD/K7uV3cX95+v/kc5xnenNHtjfZCz3I6S2I2POBGhA+RE5RPxqSHUHKAWFhik6TTt1iwp60YHR3TYjHs5DCTzY3DUzECl6Zt8vhUwjr5B5Zn/Ot44/acoPltGrJeuz0nmKvu+PY8+CS9dnf6f/nSrWK7D1+0ftRz78DAVoyOkl8DAFMz/Po="
 }
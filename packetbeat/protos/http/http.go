@@ -72,6 +72,9 @@ type httpConnectionData struct {
 	streams   [2]*stream
 	requests  messageList
 	responses messageList
+
+	// h2 is set once the connection uses HTTP/2
+	h2 *http2Connection
 }
 
 type messageList struct {
@@ -294,6 +297,11 @@ func (http *httpPlugin) doParse(
 		detailedf("Payload received: [%s]", pkt.Payload)
 	}
 
+	if conn.h2 != nil {
+		http.parseHTTP2(conn, pkt.Payload, tcptuple, dir, pkt.Ts)
+		return conn
+	}
+
 	extraMsgSize := 0 // size of a "seen" packet for which we don't store the actual bytes
 
 	st := conn.streams[dir]
@@ -322,6 +330,20 @@ func (http *httpPlugin) doParse(
 			st.message = &message{ts: pkt.Ts}
 		}
 
+		if st.parseState == stateStart && extraMsgSize == 0 {
+			ok, more := isHTTP2Preface(st.data[st.parseOffset:])
+			if more {
+				// wait for more data
+				break
+			}
+			if ok {
+				conn.h2 = newHTTP2Connection(dir, http.maxMessageSize)
+				conn.h2.parsers[dir].expectPreface = true
+				http.switchToHTTP2(conn, tcptuple, pkt.Ts)
+				return conn
+			}
+		}
+
 		parser := newParser(&http.parserConfig)
 		ok, complete := parser.parse(st, extraMsgSize)
 		extraMsgSize = 0
@@ -342,6 +364,13 @@ func (http *httpPlugin) doParse(
 
 		// and reset stream for next message
 		st.PrepareForNewMessage()
+
+		if conn.h2 != nil {
+			// the connection has been upgraded, the rest of the data are
+			// HTTP/2 frames
+			http.switchToHTTP2(conn, tcptuple, pkt.Ts)
+			return conn
+		}
 	}
 
 	return conn
@@ -394,6 +423,14 @@ func (http *httpPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
 		return private, false
 	}
 
+	if conn.h2 != nil {
+		// the frame boundaries and the HPACK state are lost
+		if !conn.h2.failed {
+			http.failHTTP2(conn.h2)
+		}
+		return conn, false
+	}
+
 	stream := conn.streams[dir]
 	if stream == nil || stream.message == nil {
 		// nothing to do
@@ -426,10 +463,7 @@ func (http *httpPlugin) handleHTTP(
 	dir uint8,
 ) {
 
-	m.tcpTuple = *tcptuple
-	m.direction = dir
-	m.cmdlineTuple = procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort())
-	http.hideHeaders(m)
+	http.prepareMessage(m, tcptuple, dir)
 
 	if m.isRequest {
 		if isDebug {
@@ -440,11 +474,36 @@ func (http *httpPlugin) handleHTTP(
 		if isDebug {
 			debugf("Received response with tuple: %s", m.tcpTuple)
 		}
+		if http.isHTTP2Upgrade(conn, m) {
+			// the response to the upgrade request is sent in stream 1
+			requ := conn.requests.pop()
+			conn.h2 = newHTTP2Connection(requ.direction, http.maxMessageSize)
+			conn.h2.parsers[requ.direction].expectPreface = true
+			conn.h2.streams[1] = &http2Stream{request: requ}
+			return
+		}
 		conn.responses.append(m)
 		http.correlate(conn)
 	}
 }
 
+// prepareMessage sets the connection information of a message.
+func (http *httpPlugin) prepareMessage(m *message, tcptuple *common.TCPTuple, dir uint8) {
+	m.tcpTuple = *tcptuple
+	m.direction = dir
+	m.cmdlineTuple = procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort())
+	http.hideHeaders(m)
+}
+
+// isHTTP2Upgrade checks if the response switches the connection to
+// cleartext HTTP/2.
+func (http *httpPlugin) isHTTP2Upgrade(conn *httpConnectionData, resp *message) bool {
+	return resp.statusCode == 101 &&
+		bytes.EqualFold(resp.upgrade, []byte("h2c")) &&
+		conn.responses.empty() &&
+		!conn.requests.empty()
+}
+
 func (http *httpPlugin) flushResponses(conn *httpConnectionData) {
 	for !conn.responses.empty() {
 		unmatchedResponses.Add(1)
@@ -525,9 +584,12 @@ func (http *httpPlugin) newTransaction(requ, resp *message) beat.Event {
 	fields["status"] = status
 
 	var httpFields ProtocolFields
+	var path string
 	if requ != nil {
 		http.decodeBody(requ)
-		path, params, err := http.extractParameters(requ)
+		var params string
+		var err error
+		path, params, err = http.extractParameters(requ)
 		if err != nil {
 			logp.Warn("Fail to parse HTTP parameters: %v", err)
 		}
@@ -594,6 +656,13 @@ func (http *httpPlugin) newTransaction(requ, resp *message) beat.Event {
 	}
 
 	pb.MarshalStruct(evt.Fields, "http", httpFields)
+
+	if grpc := grpcFields(requ, resp, path); grpc != nil {
+		fields.Put("http.grpc", grpc)
+		if resp != nil && resp.hasGRPCStatus && resp.grpcStatus != 0 {
+			fields["status"] = common.ERROR_STATUS
+		}
+	}
 	return evt
 }
 
@@ -820,6 +889,10 @@ func (http *httpPlugin) Expired(tuple *common.TCPTuple, private protos.ProtocolD
 	if isDebug {
 		debugf("expired connection %s", tuple)
 	}
+	if conn.h2 != nil {
+		http.flushHTTP2(conn.h2)
+		return
+	}
 	// terminate streams
 	for dir, s := range conn.streams {
 		// Do not send incomplete or empty messages
@@ -0,0 +1,683 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// HTTP/2 frame types and flags (RFC 7540).
+const (
+	frameHeaderLength = 9
+
+	frameData         = 0x0
+	frameHeaders      = 0x1
+	frameRSTStream    = 0x3
+	frameSettings     = 0x4
+	framePushPromise  = 0x5
+	frameContinuation = 0x9
+
+	flagEndStream  = 0x1
+	flagAck        = 0x1
+	flagEndHeaders = 0x4
+	flagPadded     = 0x8
+	flagPriority   = 0x20
+
+	settingsHeaderTableSize = 0x1
+
+	// initial size of the HPACK dynamic table
+	defaultHeaderTableSize = 4096
+
+	// maxHeaderTableSize limits the HPACK dynamic table size allowed by
+	// SETTINGS frames
+	maxHeaderTableSize = 1 << 16
+)
+
+var http2Preface = []byte("PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+
+var (
+	errHTTP2Preface   = errors.New("invalid HTTP/2 connection preface")
+	errHTTP2Frame     = errors.New("invalid HTTP/2 frame")
+	errHTTP2FrameSize = errors.New("HTTP/2 frame too large")
+)
+
+var http2ErrorNames = []string{
+	"NO_ERROR",
+	"PROTOCOL_ERROR",
+	"INTERNAL_ERROR",
+	"FLOW_CONTROL_ERROR",
+	"SETTINGS_TIMEOUT",
+	"STREAM_CLOSED",
+	"FRAME_SIZE_ERROR",
+	"REFUSED_STREAM",
+	"CANCEL",
+	"COMPRESSION_ERROR",
+	"CONNECT_ERROR",
+	"ENHANCE_YOUR_CALM",
+	"INADEQUATE_SECURITY",
+	"HTTP_1_1_REQUIRED",
+}
+
+var grpcStatusNames = []string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+// http2Connection holds the state of a cleartext HTTP/2 connection, either
+// started with the connection preface or upgraded from HTTP/1.1.
+type http2Connection struct {
+	clientDir uint8
+	parsers   [2]*http2Parser
+	streams   map[uint32]*http2Stream
+
+	// failed is set once the state of the connection is lost. The rest of
+	// the connection is ignored.
+	failed bool
+}
+
+type http2Stream struct {
+	request  *message
+	response *message
+}
+
+// http2Parser reads the frames sent in one direction of the connection.
+type http2Parser struct {
+	data          []byte
+	expectPreface bool
+
+	// decoder holds the HPACK decompression state of the direction
+	decoder *hpack.Decoder
+
+	// header block received over HEADERS or PUSH_PROMISE and CONTINUATION
+	// frames
+	headerBlock    []byte
+	headerStreamID uint32
+	headerFlags    uint8
+	headerSize     int
+	promisedID     uint32
+
+	// DATA frame being received
+	inData           bool
+	dataStreamID     uint32
+	dataFlags        uint8
+	dataRemaining    int
+	paddingRemaining int
+}
+
+func newHTTP2Connection(clientDir uint8, maxMessageSize int) *http2Connection {
+	h2 := &http2Connection{
+		clientDir: clientDir,
+		streams:   map[uint32]*http2Stream{},
+	}
+	for i := range h2.parsers {
+		decoder := hpack.NewDecoder(defaultHeaderTableSize, nil)
+		decoder.SetMaxStringLength(maxMessageSize)
+		h2.parsers[i] = &http2Parser{decoder: decoder}
+	}
+	return h2
+}
+
+// isHTTP2Preface checks if data starts with the HTTP/2 client connection
+// preface. more is set if data is a prefix of the preface.
+func isHTTP2Preface(data []byte) (ok bool, more bool) {
+	if len(data) < len(http2Preface) {
+		return false, bytes.HasPrefix(http2Preface, data)
+	}
+	return bytes.HasPrefix(data, http2Preface), false
+}
+
+// switchToHTTP2 parses the data buffered by the HTTP/1 streams of the
+// connection as HTTP/2 frames.
+func (http *httpPlugin) switchToHTTP2(
+	conn *httpConnectionData,
+	tcptuple *common.TCPTuple,
+	ts time.Time,
+) {
+	if isDebug {
+		debugf("Connection %s switched to HTTP/2", tcptuple)
+	}
+	streams := conn.streams
+	conn.streams = [2]*stream{}
+	for dir, st := range streams {
+		if st != nil && len(st.data) > 0 {
+			http.parseHTTP2(conn, st.data, tcptuple, uint8(dir), ts)
+		}
+	}
+}
+
+// parseHTTP2 processes the HTTP/2 frames received in direction dir.
+func (http *httpPlugin) parseHTTP2(
+	conn *httpConnectionData,
+	data []byte,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) {
+	h2 := conn.h2
+	if h2.failed {
+		return
+	}
+
+	p := h2.parsers[dir]
+	p.data = append(p.data, data...)
+	if err := http.parseFrames(h2, p, tcptuple, dir, ts); err != nil {
+		if isDebug {
+			debugf("%v, ignoring the rest of the HTTP/2 connection %s", err, tcptuple)
+		}
+		http.failHTTP2(h2)
+	}
+}
+
+// failHTTP2 publishes the streams in progress and stops parsing the
+// connection.
+func (http *httpPlugin) failHTTP2(h2 *http2Connection) {
+	http.flushHTTP2(h2)
+	h2.failed = true
+	h2.parsers = [2]*http2Parser{}
+}
+
+// flushHTTP2 publishes the streams still waiting for a response.
+func (http *httpPlugin) flushHTTP2(h2 *http2Connection) {
+	ids := make([]uint32, 0, len(h2.streams))
+	for id := range h2.streams {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		s := h2.streams[id]
+		delete(h2.streams, id)
+		if s.request != nil && s.response == nil {
+			unmatchedRequests.Add(1)
+		}
+		http.publishHTTP2Stream(s)
+	}
+}
+
+func (http *httpPlugin) publishHTTP2Stream(s *http2Stream) {
+	if s.request == nil {
+		unmatchedResponses.Add(1)
+	}
+	if s.request == nil && s.response == nil {
+		return
+	}
+	http.publishTransaction(http.newTransaction(s.request, s.response))
+}
+
+func (http *httpPlugin) parseFrames(
+	h2 *http2Connection,
+	p *http2Parser,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) error {
+	for {
+		if p.expectPreface {
+			ok, more := isHTTP2Preface(p.data)
+			if more {
+				return nil
+			}
+			if !ok {
+				return errHTTP2Preface
+			}
+			p.data = p.data[len(http2Preface):]
+			p.expectPreface = false
+		}
+
+		if p.inData {
+			n := p.dataRemaining
+			if n > len(p.data) {
+				n = len(p.data)
+			}
+			if n > 0 {
+				http.http2Data(h2, p.dataStreamID, dir, p.data[:n])
+				p.data = p.data[n:]
+				p.dataRemaining -= n
+			}
+
+			n = p.paddingRemaining
+			if n > len(p.data) {
+				n = len(p.data)
+			}
+			p.data = p.data[n:]
+			p.paddingRemaining -= n
+
+			if p.dataRemaining > 0 || p.paddingRemaining > 0 {
+				// wait for more data
+				return nil
+			}
+			p.inData = false
+			if p.dataFlags&flagEndStream != 0 {
+				http.http2EndStream(h2, p.dataStreamID, dir)
+			}
+			continue
+		}
+
+		if len(p.data) < frameHeaderLength {
+			return nil
+		}
+		length := int(p.data[0])<<16 | int(p.data[1])<<8 | int(p.data[2])
+		typ, flags := p.data[3], p.data[4]
+		streamID := binary.BigEndian.Uint32(p.data[5:]) & 0x7fffffff
+
+		if p.headerStreamID != 0 && typ != frameContinuation {
+			// header blocks must not be interleaved with other frames
+			return errHTTP2Frame
+		}
+
+		if typ == frameData {
+			// DATA frames are not buffered, their payload is processed as
+			// it is received
+			offset, padding := frameHeaderLength, 0
+			if flags&flagPadded != 0 {
+				if len(p.data) < frameHeaderLength+1 {
+					return nil
+				}
+				padding = int(p.data[frameHeaderLength])
+				offset++
+				if length < 1 || padding > length-1 {
+					return errHTTP2Frame
+				}
+			}
+			if m := h2.message(streamID, dir); m != nil {
+				m.size += uint64(frameHeaderLength + length)
+			}
+
+			p.inData = true
+			p.dataStreamID = streamID
+			p.dataFlags = flags
+			p.dataRemaining = length - (offset - frameHeaderLength) - padding
+			p.paddingRemaining = padding
+			p.data = p.data[offset:]
+			continue
+		}
+
+		if length > http.maxMessageSize {
+			return errHTTP2FrameSize
+		}
+		if len(p.data) < frameHeaderLength+length {
+			// wait for the complete frame
+			return nil
+		}
+		payload := p.data[frameHeaderLength : frameHeaderLength+length]
+		p.data = p.data[frameHeaderLength+length:]
+
+		err := http.http2Frame(h2, p, typ, flags, streamID, payload, tcptuple, dir, ts)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (http *httpPlugin) http2Frame(
+	h2 *http2Connection,
+	p *http2Parser,
+	typ, flags uint8,
+	streamID uint32,
+	payload []byte,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) error {
+	size := frameHeaderLength + len(payload)
+
+	switch typ {
+	case frameHeaders, framePushPromise:
+		fragment, ok := http2Unpad(payload, flags)
+		if !ok || streamID == 0 {
+			return errHTTP2Frame
+		}
+		var promisedID uint32
+		if typ == framePushPromise {
+			if len(fragment) < 4 {
+				return errHTTP2Frame
+			}
+			promisedID = binary.BigEndian.Uint32(fragment) & 0x7fffffff
+			fragment = fragment[4:]
+		} else if flags&flagPriority != 0 {
+			if len(fragment) < 5 {
+				return errHTTP2Frame
+			}
+			fragment = fragment[5:]
+		}
+		p.headerBlock = append(p.headerBlock[:0], fragment...)
+		p.headerStreamID = streamID
+		p.headerFlags = flags
+		p.headerSize = size
+		p.promisedID = promisedID
+
+	case frameContinuation:
+		if p.headerStreamID == 0 || streamID != p.headerStreamID {
+			return errHTTP2Frame
+		}
+		if p.headerSize+size > http.maxMessageSize {
+			// do not buffer an endless sequence of CONTINUATION frames
+			return errHTTP2FrameSize
+		}
+		p.headerBlock = append(p.headerBlock, payload...)
+		p.headerFlags |= flags & flagEndHeaders
+		p.headerSize += size
+
+	case frameRSTStream:
+		if len(payload) != 4 {
+			return errHTTP2Frame
+		}
+		http.http2Reset(h2, streamID, dir, binary.BigEndian.Uint32(payload))
+		return nil
+
+	case frameSettings:
+		if flags&flagAck != 0 {
+			return nil
+		}
+		if len(payload)%6 != 0 {
+			return errHTTP2Frame
+		}
+		for ; len(payload) > 0; payload = payload[6:] {
+			id := binary.BigEndian.Uint16(payload)
+			value := binary.BigEndian.Uint32(payload[2:])
+			if id == settingsHeaderTableSize {
+				// the setting limits the table used by the peer's encoder
+				if value > maxHeaderTableSize {
+					value = maxHeaderTableSize
+				}
+				h2.parsers[1-dir].decoder.SetAllowedMaxDynamicTableSize(value)
+			}
+		}
+		return nil
+
+	default:
+		// PRIORITY, PING, GOAWAY, WINDOW_UPDATE and unknown frames
+		return nil
+	}
+
+	if p.headerFlags&flagEndHeaders == 0 {
+		// wait for CONTINUATION frames
+		return nil
+	}
+	return http.http2HeaderBlock(h2, p, tcptuple, dir, ts)
+}
+
+// http2Unpad returns the payload of a frame without padding.
+func http2Unpad(payload []byte, flags uint8) ([]byte, bool) {
+	if flags&flagPadded == 0 {
+		return payload, true
+	}
+	if len(payload) < 1 {
+		return nil, false
+	}
+	padding := int(payload[0])
+	if padding > len(payload)-1 {
+		return nil, false
+	}
+	return payload[1 : len(payload)-padding], true
+}
+
+// http2HeaderBlock decodes a complete header block. All header blocks of a
+// direction must be decoded in order to keep the HPACK state.
+func (http *httpPlugin) http2HeaderBlock(
+	h2 *http2Connection,
+	p *http2Parser,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) error {
+	streamID, flags, size, promisedID := p.headerStreamID, p.headerFlags, p.headerSize, p.promisedID
+	p.headerStreamID = 0
+
+	fields, err := p.decoder.DecodeFull(p.headerBlock)
+	if err != nil {
+		return err
+	}
+
+	if promisedID != 0 {
+		// the server pushes the response to the request in the promise
+		requ := http.newHTTP2Message(fields, true, tcptuple, h2.clientDir, ts)
+		h2.streams[promisedID] = &http2Stream{request: requ}
+		return nil
+	}
+
+	s := h2.streams[streamID]
+	if s == nil {
+		s = &http2Stream{}
+		h2.streams[streamID] = s
+	}
+
+	var m *message
+	if dir == h2.clientDir {
+		if s.request == nil {
+			s.request = http.newHTTP2Message(fields, true, tcptuple, dir, ts)
+		} else {
+			http.http2Headers(s.request, fields)
+		}
+		m = s.request
+	} else {
+		if s.response == nil || s.response.statusCode < 200 {
+			// interim responses are replaced by the final response
+			s.response = http.newHTTP2Message(fields, false, tcptuple, dir, ts)
+		} else {
+			// trailers
+			http.http2Headers(s.response, fields)
+		}
+		m = s.response
+	}
+	m.size += uint64(size)
+
+	if flags&flagEndStream != 0 {
+		http.http2EndStream(h2, streamID, dir)
+	}
+	return nil
+}
+
+func (http *httpPlugin) newHTTP2Message(
+	fields []hpack.HeaderField,
+	isRequest bool,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) *message {
+	m := &message{
+		ts:        ts,
+		isRequest: isRequest,
+		version:   version{major: 2},
+		headers:   map[string]common.NetString{},
+	}
+	http.http2Headers(m, fields)
+
+	parser := newParser(&http.parserConfig)
+	if isRequest {
+		m.sendBody = parser.shouldIncludeInBody(m.contentType, http.parserConfig.includeRequestBodyFor)
+	} else {
+		m.sendBody = parser.shouldIncludeInBody(m.contentType, http.parserConfig.includeResponseBodyFor)
+	}
+	m.saveBody = m.sendBody || bytes.Contains(m.contentType, []byte("urlencoded"))
+
+	http.prepareMessage(m, tcptuple, dir)
+	return m
+}
+
+// http2Headers stores the decoded header fields in the message. The fields
+// are added to the raw headers in the HTTP/1 format.
+func (http *httpPlugin) http2Headers(m *message, fields []hpack.HeaderField) {
+	parser := newParser(&http.parserConfig)
+	for _, f := range fields {
+		value := common.NetString(f.Value)
+		m.rawHeaders = append(m.rawHeaders, f.Name...)
+		m.rawHeaders = append(m.rawHeaders, ": "...)
+		m.rawHeaders = append(m.rawHeaders, value...)
+		m.rawHeaders = append(m.rawHeaders, constCRLF...)
+
+		switch f.Name {
+		case ":method":
+			m.method = value
+		case ":path":
+			m.requestURI = value
+		case ":authority":
+			m.host = value
+		case ":status":
+			code, _ := strconv.Atoi(f.Value)
+			m.statusCode = uint16(code)
+		case "grpc-status":
+			m.grpcStatus, _ = strconv.Atoi(f.Value)
+			m.hasGRPCStatus = true
+		case "grpc-message":
+			// the message is percent-encoded
+			msg, err := url.PathUnescape(f.Value)
+			if err != nil {
+				msg = f.Value
+			}
+			m.grpcMessage = msg
+		}
+
+		if !f.IsPseudo() {
+			parser.processHeader(m, []byte(f.Name), value)
+		}
+	}
+	m.rawHeaders = append(m.rawHeaders, constCRLF...)
+}
+
+// message returns the message of the stream sent in direction dir.
+func (h2 *http2Connection) message(streamID uint32, dir uint8) *message {
+	s := h2.streams[streamID]
+	if s == nil {
+		return nil
+	}
+	if dir == h2.clientDir {
+		return s.request
+	}
+	return s.response
+}
+
+func (http *httpPlugin) http2Data(h2 *http2Connection, streamID uint32, dir uint8, data []byte) {
+	m := h2.message(streamID, dir)
+	if m == nil {
+		return
+	}
+	if !m.hasContentLength {
+		m.contentLength += len(data)
+	}
+	if m.saveBody {
+		if n := http.maxMessageSize - len(m.body); n < len(data) {
+			data = data[:n]
+		}
+		m.body = append(m.body, data...)
+	}
+}
+
+// http2EndStream is called when the last frame of a stream has been sent in
+// direction dir. The transaction is published once the response is
+// complete.
+func (http *httpPlugin) http2EndStream(h2 *http2Connection, streamID uint32, dir uint8) {
+	if dir == h2.clientDir {
+		return
+	}
+	s := h2.streams[streamID]
+	if s == nil {
+		return
+	}
+	delete(h2.streams, streamID)
+	http.publishHTTP2Stream(s)
+}
+
+func (http *httpPlugin) http2Reset(h2 *http2Connection, streamID uint32, dir uint8, code uint32) {
+	s := h2.streams[streamID]
+	if s == nil {
+		// the stream is complete already
+		return
+	}
+	delete(h2.streams, streamID)
+
+	sender := "server"
+	if dir == h2.clientDir {
+		sender = "client"
+	}
+	note := fmt.Sprintf("Stream reset by %s: %s", sender, http2ErrorName(code))
+	if s.request != nil {
+		s.request.notes = append(s.request.notes, note)
+	} else if s.response != nil {
+		s.response.notes = append(s.response.notes, note)
+	}
+	http.publishHTTP2Stream(s)
+}
+
+func http2ErrorName(code uint32) string {
+	if int(code) < len(http2ErrorNames) {
+		return http2ErrorNames[code]
+	}
+	return fmt.Sprintf("0x%x", code)
+}
+
+func grpcStatusName(code int) string {
+	if code >= 0 && code < len(grpcStatusNames) {
+		return grpcStatusNames[code]
+	}
+	return strconv.Itoa(code)
+}
+
+func isGRPC(contentType common.NetString) bool {
+	return bytes.HasPrefix(contentType, []byte("application/grpc"))
+}
+
+// grpcFields returns the gRPC fields of a transaction, or nil if the request
+// is not a gRPC call. The service and method are parsed from the request
+// path, which has the format /package.Service/Method.
+func grpcFields(requ, resp *message, path string) common.MapStr {
+	if requ == nil || !isGRPC(requ.contentType) {
+		return nil
+	}
+
+	fields := common.MapStr{}
+	if parts := strings.Split(strings.TrimPrefix(path, "/"), "/"); len(parts) == 2 {
+		fields["service"] = parts[0]
+		fields["method"] = parts[1]
+	}
+	if resp != nil && resp.hasGRPCStatus {
+		fields["status_code"] = resp.grpcStatus
+		fields["status"] = grpcStatusName(resp.grpcStatus)
+		if resp.grpcMessage != "" {
+			fields["message"] = resp.grpcMessage
+		}
+	}
+	return fields
+}
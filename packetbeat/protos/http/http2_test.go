@@ -0,0 +1,405 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package http
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+// http2Peer encodes the frames sent by one endpoint of a connection.
+type http2Peer struct {
+	buf     bytes.Buffer
+	encoder *hpack.Encoder
+}
+
+func newHTTP2Peer() *http2Peer {
+	p := &http2Peer{}
+	p.encoder = hpack.NewEncoder(&p.buf)
+	return p
+}
+
+func frame(typ, flags uint8, streamID uint32, payload []byte) []byte {
+	data := make([]byte, frameHeaderLength, frameHeaderLength+len(payload))
+	data[0] = byte(len(payload) >> 16)
+	data[1] = byte(len(payload) >> 8)
+	data[2] = byte(len(payload))
+	data[3] = typ
+	data[4] = flags
+	binary.BigEndian.PutUint32(data[5:], streamID)
+	return append(data, payload...)
+}
+
+// headerBlock encodes the header fields, given as name and value pairs.
+func (p *http2Peer) headerBlock(fields ...string) []byte {
+	p.buf.Reset()
+	for i := 0; i < len(fields); i += 2 {
+		p.encoder.WriteField(hpack.HeaderField{Name: fields[i], Value: fields[i+1]})
+	}
+	return append([]byte(nil), p.buf.Bytes()...)
+}
+
+func (p *http2Peer) headers(streamID uint32, endStream bool, fields ...string) []byte {
+	flags := uint8(flagEndHeaders)
+	if endStream {
+		flags |= flagEndStream
+	}
+	return frame(frameHeaders, flags, streamID, p.headerBlock(fields...))
+}
+
+func data(streamID uint32, endStream bool, payload string) []byte {
+	var flags uint8
+	if endStream {
+		flags = flagEndStream
+	}
+	return frame(frameData, flags, streamID, []byte(payload))
+}
+
+func settings(values ...uint32) []byte {
+	var payload []byte
+	for i := 0; i < len(values); i += 2 {
+		var b [6]byte
+		binary.BigEndian.PutUint16(b[:], uint16(values[i]))
+		binary.BigEndian.PutUint32(b[2:], values[i+1])
+		payload = append(payload, b[:]...)
+	}
+	return frame(frameSettings, 0, 0, payload)
+}
+
+func concat(parts ...[]byte) []byte {
+	var data []byte
+	for _, p := range parts {
+		data = append(data, p...)
+	}
+	return data
+}
+
+type http2Test struct {
+	http    *httpPlugin
+	tuple   *common.TCPTuple
+	private protos.ProtocolData
+}
+
+func newHTTP2Test(store *eventStore) *http2Test {
+	return &http2Test{
+		http:  httpModForTests(store),
+		tuple: testCreateTCPTuple(),
+	}
+}
+
+func (ht *http2Test) parse(dir uint8, data []byte) {
+	pkt := protos.Packet{Payload: data}
+	ht.private = ht.http.Parse(&pkt, ht.tuple, dir, ht.private)
+}
+
+func getValue(t *testing.T, trans common.MapStr, key string) interface{} {
+	v, err := trans.GetValue(key)
+	if err != nil {
+		t.Errorf("%s: %v", key, err)
+	}
+	return v
+}
+
+func TestHTTP2_PriorKnowledge(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	client, server := newHTTP2Peer(), newHTTP2Peer()
+
+	ht.parse(tcp.TCPDirectionOriginal, concat(
+		http2Preface,
+		settings(),
+		client.headers(1, true,
+			":method", "GET", ":scheme", "http", ":authority", "example.com",
+			":path", "/index.html?q=1", "user-agent", "curl/7.64.0"),
+		client.headers(3, true,
+			":method", "GET", ":scheme", "http", ":authority", "example.com",
+			":path", "/style.css", "user-agent", "curl/7.64.0"),
+	))
+	assert.True(t, store.empty())
+
+	// responses are sent out of order
+	ht.parse(tcp.TCPDirectionReverse, concat(
+		settings(),
+		server.headers(3, false, ":status", "404", "content-type", "text/plain"),
+		data(3, true, "not found"),
+		server.headers(1, false, ":status", "200", "content-length", "5"),
+		data(1, false, "hel"),
+		data(1, true, "lo"),
+	))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "Error", trans["status"])
+	assert.Equal(t, "GET /style.css", trans["query"])
+	assert.Equal(t, "2", getValue(t, trans, "http.version"))
+	assert.Equal(t, int64(404), getValue(t, trans, "http.response.status_code"))
+	assert.Equal(t, int64(9), getValue(t, trans, "http.response.body.bytes"))
+
+	trans = expectTransaction(t, &store)
+	assert.Equal(t, "OK", trans["status"])
+	assert.Equal(t, "GET /index.html", trans["query"])
+	assert.Equal(t, common.NetString("get"), getValue(t, trans, "http.request.method"))
+	assert.Equal(t, int64(200), getValue(t, trans, "http.response.status_code"))
+	assert.Equal(t, int64(5), getValue(t, trans, "http.response.body.bytes"))
+	assert.Equal(t, "example.com", getValue(t, trans, "url.domain"))
+	assert.Equal(t, "q=1", getValue(t, trans, "url.query"))
+	assert.Equal(t, "curl/7.64.0", getValue(t, trans, "user_agent.original"))
+	assert.True(t, store.empty())
+}
+
+func TestHTTP2_GRPC(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	client, server := newHTTP2Peer(), newHTTP2Peer()
+
+	request := func(streamID uint32) []byte {
+		return concat(
+			client.headers(streamID, false,
+				":method", "POST", ":scheme", "http", ":authority", "greeter:50051",
+				":path", "/helloworld.Greeter/SayHello",
+				"content-type", "application/grpc", "te", "trailers"),
+			data(streamID, true, "\x00\x00\x00\x00\x07\x0a\x05world"),
+		)
+	}
+
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface, settings(), request(1)))
+	ht.parse(tcp.TCPDirectionReverse, concat(
+		settings(),
+		server.headers(1, false, ":status", "200", "content-type", "application/grpc"),
+		data(1, false, "\x00\x00\x00\x00\x0d\x0a\x0bhello world"),
+		server.headers(1, true, "grpc-status", "0"),
+	))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "OK", trans["status"])
+	assert.Equal(t, "POST /helloworld.Greeter/SayHello", trans["query"])
+	assert.Equal(t, common.MapStr{
+		"service":     "helloworld.Greeter",
+		"method":      "SayHello",
+		"status_code": 0,
+		"status":      "OK",
+	}, getValue(t, trans, "http.grpc"))
+
+	// the second call reuses the HPACK dynamic table
+	ht.parse(tcp.TCPDirectionOriginal, request(3))
+	ht.parse(tcp.TCPDirectionReverse, server.headers(3, true,
+		":status", "200", "content-type", "application/grpc",
+		"grpc-status", "5", "grpc-message", "no%20such%20user"))
+
+	trans = expectTransaction(t, &store)
+	assert.Equal(t, "Error", trans["status"])
+	assert.Equal(t, int64(200), getValue(t, trans, "http.response.status_code"))
+	assert.Equal(t, common.MapStr{
+		"service":     "helloworld.Greeter",
+		"method":      "SayHello",
+		"status_code": 5,
+		"status":      "NOT_FOUND",
+		"message":     "no such user",
+	}, getValue(t, trans, "http.grpc"))
+}
+
+func TestHTTP2_Upgrade(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	server := newHTTP2Peer()
+
+	ht.parse(tcp.TCPDirectionOriginal, []byte("GET /status HTTP/1.1\r\n"+
+		"Host: example.com\r\n"+
+		"Connection: Upgrade, HTTP2-Settings\r\n"+
+		"Upgrade: h2c\r\n"+
+		"HTTP2-Settings: AAMAAABkAARAAAAAAAIAAAAA\r\n"+
+		"\r\n"))
+	ht.parse(tcp.TCPDirectionReverse, concat(
+		[]byte("HTTP/1.1 101 Switching Protocols\r\n"+
+			"Connection: Upgrade\r\n"+
+			"Upgrade: h2c\r\n"+
+			"\r\n"),
+		settings(),
+		server.headers(1, false, ":status", "200"),
+	))
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface, settings()))
+	assert.True(t, store.empty())
+
+	ht.parse(tcp.TCPDirectionReverse, data(1, true, "up"))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "OK", trans["status"])
+	assert.Equal(t, "GET /status", trans["query"])
+	assert.Equal(t, int64(200), getValue(t, trans, "http.response.status_code"))
+	assert.Equal(t, int64(2), getValue(t, trans, "http.response.body.bytes"))
+	assert.True(t, store.empty())
+}
+
+func TestHTTP2_ServerFirst(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	client, server := newHTTP2Peer(), newHTTP2Peer()
+
+	// the SETTINGS frame of the server is seen before the client preface
+	ht.parse(tcp.TCPDirectionReverse, settings(settingsHeaderTableSize, 8192))
+	ht.parse(tcp.TCPDirectionOriginal, http2Preface[:10])
+	ht.parse(tcp.TCPDirectionOriginal, concat(
+		http2Preface[10:],
+		settings(),
+		client.headers(1, true, ":method", "GET", ":path", "/"),
+	))
+	ht.parse(tcp.TCPDirectionReverse, server.headers(1, true, ":status", "204"))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, int64(204), getValue(t, trans, "http.response.status_code"))
+}
+
+func TestHTTP2_FramesSplit(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	ht.http.hideKeywords = []string{"password"}
+	client, server := newHTTP2Peer(), newHTTP2Peer()
+
+	// the header block is split in a padded HEADERS frame and a
+	// CONTINUATION frame
+	block := client.headerBlock(":method", "POST", ":path", "/upload",
+		"content-type", "application/x-www-form-urlencoded", "authorization", "Basic c2VjcmV0")
+	payload := concat([]byte{3}, block[:10], []byte{0, 0, 0})
+	requ := concat(
+		http2Preface,
+		frame(frameHeaders, flagPadded, 1, payload),
+		frame(frameContinuation, flagEndHeaders, 1, block[10:]),
+		frame(frameData, flagPadded|flagEndStream, 1, concat([]byte{2}, []byte("a=1&password=x"), []byte{0, 0})),
+	)
+	for i := range requ {
+		ht.parse(tcp.TCPDirectionOriginal, requ[i:i+1])
+	}
+	ht.parse(tcp.TCPDirectionReverse, server.headers(1, true, ":status", "200"))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "POST /upload", trans["query"])
+	assert.Equal(t, int64(14), getValue(t, trans, "http.request.body.bytes"))
+	assert.Equal(t, "a=1&password=xxxxx", getValue(t, trans, "url.query"))
+}
+
+func TestHTTP2_RedactAuthorization(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	ht.http.redactAuthorization = true
+	ht.http.sendRequest = true
+	client, server := newHTTP2Peer(), newHTTP2Peer()
+
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface,
+		client.headers(1, true, ":method", "GET", ":path", "/", "authorization", "Basic c2VjcmV0")))
+	ht.parse(tcp.TCPDirectionReverse, server.headers(1, true, ":status", "200"))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, ":method: GET\r\n:path: /\r\nauthorization:***************\r\n\r\n", trans["request"])
+}
+
+func TestHTTP2_Reset(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	client := newHTTP2Peer()
+
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface,
+		client.headers(1, false, ":method", "POST", ":path", "/pkg.Svc/Stream",
+			"content-type", "application/grpc"),
+		frame(frameRSTStream, 0, 1, []byte{0, 0, 0, 8}),
+	))
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "Error", trans["status"])
+	assert.Contains(t, getValue(t, trans, "error.message"), "Stream reset by client: CANCEL")
+	assert.Equal(t, common.MapStr{
+		"service": "pkg.Svc",
+		"method":  "Stream",
+	}, getValue(t, trans, "http.grpc"))
+}
+
+func TestHTTP2_ExpiredAndInvalid(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	client := newHTTP2Peer()
+
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface,
+		client.headers(1, true, ":method", "GET", ":path", "/slow")))
+	ht.http.Expired(ht.tuple, ht.private)
+
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "Error", trans["status"])
+	assert.Equal(t, "GET /slow", trans["query"])
+
+	// an invalid header block stops the analysis of the connection
+	ht.parse(tcp.TCPDirectionOriginal, concat(
+		client.headers(3, true, ":method", "GET", ":path", "/"),
+		frame(frameHeaders, flagEndHeaders, 5, []byte{0xff, 0xff, 0xff, 0xff}),
+	))
+	trans = expectTransaction(t, &store)
+	assert.Equal(t, "GET /", trans["query"])
+	assert.True(t, ht.private.(*httpConnectionData).h2.failed)
+
+	ht.parse(tcp.TCPDirectionOriginal, []byte("GET / HTTP/1.1\r\n\r\n"))
+	assert.True(t, store.empty())
+}
+
+func TestHTTP2_ContinuationFlood(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	ht.http.maxMessageSize = 1000
+	client := newHTTP2Peer()
+
+	block := client.headerBlock(":method", "GET", ":path", "/")
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface,
+		frame(frameHeaders, 0, 1, block)))
+	h2 := ht.private.(*httpConnectionData).h2
+	for i := 0; i < 100 && !h2.failed; i++ {
+		ht.parse(tcp.TCPDirectionOriginal, frame(frameContinuation, 0, 1, make([]byte, 100)))
+		if !h2.failed {
+			assert.True(t, len(h2.parsers[tcp.TCPDirectionOriginal].headerBlock) <= 1000)
+		}
+	}
+	assert.True(t, h2.failed)
+	assert.True(t, store.empty())
+}
+
+func TestHTTP2_HeaderTableSizeLimit(t *testing.T) {
+	var store eventStore
+	ht := newHTTP2Test(&store)
+	client, server := newHTTP2Peer(), newHTTP2Peer()
+
+	// the client allows any table size, the server's table size update is
+	// rejected if it exceeds the limit
+	ht.parse(tcp.TCPDirectionOriginal, concat(http2Preface,
+		settings(settingsHeaderTableSize, 0xffffffff),
+		client.headers(1, true, ":method", "GET", ":path", "/")))
+
+	server.encoder.SetMaxDynamicTableSizeLimit(1 << 20)
+	server.encoder.SetMaxDynamicTableSize(1 << 20)
+	ht.parse(tcp.TCPDirectionReverse, server.headers(1, true, ":status", "200"))
+
+	assert.True(t, ht.private.(*httpConnectionData).h2.failed)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "Error", trans["status"])
+	_, err := trans.GetValue("http.response.status_code")
+	assert.Error(t, err)
+}
@@ -58,6 +58,7 @@ type message struct {
 	host          common.NetString
 	referer       common.NetString
 	userAgent     common.NetString
+	upgrade       common.NetString
 	encodings     []string
 	isChunked     bool
 	headers       map[string]common.NetString
@@ -77,6 +78,11 @@ type message struct {
 	packetLossReq  bool
 	packetLossResp bool
 
+	// gRPC status sent in the trailers of HTTP/2 responses
+	hasGRPCStatus bool
+	grpcStatus    int
+	grpcMessage   string
+
 	next *message
 }
 
@@ -89,6 +95,9 @@ func (v version) String() string {
 	if v.major == 1 && v.minor == 1 {
 		return "1.1"
 	}
+	if v.major == 2 {
+		return "2"
+	}
 	return fmt.Sprintf("%d.%d", v.major, v.minor)
 }
 
@@ -122,6 +131,7 @@ var (
 	nameHost             = []byte("host")
 	nameReferer          = []byte("referer")
 	nameUserAgent        = []byte("user-agent")
+	nameUpgrade          = []byte("upgrade")
 )
 
 func newParser(config *parserConfig) *parser {
@@ -348,8 +358,6 @@ func (parser *parser) parseHeader(m *message, data []byte) (bool, bool, int) {
 		return true, false, 0
 	}
 
-	config := parser.config
-
 	// enabled if required. Allocs for parameters slow down parser big times
 	if isDetailed {
 		detailedf("Data: %s", data)
@@ -374,64 +382,7 @@ func (parser *parser) parseHeader(m *message, data []byte) (bool, bool, int) {
 				debugf("Header: '%s' Value: '%s'\n", data[:i], headerVal)
 			}
 
-			// Headers we need for parsing. Make sure we always
-			// capture their value
-			if bytes.Equal(headerName, nameContentLength) {
-				m.contentLength, _ = parseInt(headerVal)
-				m.hasContentLength = true
-			} else if bytes.Equal(headerName, nameContentType) {
-				m.contentType = headerVal
-			} else if bytes.Equal(headerName, nameTransferEncoding) {
-				encodings := parseCommaSeparatedList(headerVal)
-				// 'chunked' can only appear at the end
-				if n := len(encodings); n > 0 && encodings[n-1] == transferEncodingChunked {
-					m.isChunked = true
-					encodings = encodings[:n-1]
-				}
-				if len(encodings) > 0 {
-					// Append at the end of encodings. If a content-encoding
-					// header is also present, it was applied by sender before
-					// transfer-encoding.
-					m.encodings = append(m.encodings, encodings...)
-				}
-			} else if bytes.Equal(headerName, nameContentEncoding) {
-				encodings := parseCommaSeparatedList(headerVal)
-				// Append at the beginning of m.encodings, as Content-Encoding
-				// is supposed to be applied before Transfer-Encoding.
-				m.encodings = append(encodings, m.encodings...)
-			} else if bytes.Equal(headerName, nameConnection) {
-				m.connection = headerVal
-			} else if len(config.realIPHeader) > 0 && bytes.Equal(headerName, []byte(config.realIPHeader)) {
-				if ips := bytes.SplitN(headerVal, []byte{','}, 2); len(ips) > 0 {
-					m.realIP = trim(ips[0])
-				}
-			} else if bytes.Equal(headerName, nameHost) {
-				m.host = headerVal
-			} else if bytes.Equal(headerName, nameReferer) {
-				m.referer = headerVal
-			} else if bytes.Equal(headerName, nameUserAgent) {
-				m.userAgent = headerVal
-			}
-
-			if config.sendHeaders {
-				if !config.sendAllHeaders {
-					_, exists := config.headersWhitelist[string(headerName)]
-					if !exists {
-						return true, true, p + 2
-					}
-				}
-				if val, ok := m.headers[string(headerName)]; ok {
-					composed := make([]byte, len(val)+len(headerVal)+2)
-					off := copy(composed, val)
-					copy(composed[off:], []byte(", "))
-					copy(composed[off+2:], headerVal)
-
-					m.headers[string(headerName)] = composed
-				} else {
-					m.headers[string(headerName)] = headerVal
-				}
-			}
-
+			parser.processHeader(m, headerName, headerVal)
 			return true, true, p + 2
 		}
 	}
@@ -439,6 +390,72 @@ func (parser *parser) parseHeader(m *message, data []byte) (bool, bool, int) {
 	return true, false, len(data)
 }
 
+// processHeader stores the value of the header field in the message. The
+// header name must be lower case.
+func (parser *parser) processHeader(m *message, headerName, headerVal []byte) {
+	config := parser.config
+
+	// Headers we need for parsing. Make sure we always
+	// capture their value
+	if bytes.Equal(headerName, nameContentLength) {
+		m.contentLength, _ = parseInt(headerVal)
+		m.hasContentLength = true
+	} else if bytes.Equal(headerName, nameContentType) {
+		m.contentType = headerVal
+	} else if bytes.Equal(headerName, nameTransferEncoding) {
+		encodings := parseCommaSeparatedList(headerVal)
+		// 'chunked' can only appear at the end
+		if n := len(encodings); n > 0 && encodings[n-1] == transferEncodingChunked {
+			m.isChunked = true
+			encodings = encodings[:n-1]
+		}
+		if len(encodings) > 0 {
+			// Append at the end of encodings. If a content-encoding
+			// header is also present, it was applied by sender before
+			// transfer-encoding.
+			m.encodings = append(m.encodings, encodings...)
+		}
+	} else if bytes.Equal(headerName, nameContentEncoding) {
+		encodings := parseCommaSeparatedList(headerVal)
+		// Append at the beginning of m.encodings, as Content-Encoding
+		// is supposed to be applied before Transfer-Encoding.
+		m.encodings = append(encodings, m.encodings...)
+	} else if bytes.Equal(headerName, nameConnection) {
+		m.connection = headerVal
+	} else if len(config.realIPHeader) > 0 && bytes.Equal(headerName, []byte(config.realIPHeader)) {
+		if ips := bytes.SplitN(headerVal, []byte{','}, 2); len(ips) > 0 {
+			m.realIP = trim(ips[0])
+		}
+	} else if bytes.Equal(headerName, nameHost) {
+		m.host = headerVal
+	} else if bytes.Equal(headerName, nameReferer) {
+		m.referer = headerVal
+	} else if bytes.Equal(headerName, nameUserAgent) {
+		m.userAgent = headerVal
+	} else if bytes.Equal(headerName, nameUpgrade) {
+		m.upgrade = headerVal
+	}
+
+	if config.sendHeaders {
+		if !config.sendAllHeaders {
+			_, exists := config.headersWhitelist[string(headerName)]
+			if !exists {
+				return
+			}
+		}
+		if val, ok := m.headers[string(headerName)]; ok {
+			composed := make([]byte, len(val)+len(headerVal)+2)
+			off := copy(composed, val)
+			copy(composed[off:], []byte(", "))
+			copy(composed[off+2:], headerVal)
+
+			m.headers[string(headerName)] = composed
+		} else {
+			m.headers[string(headerName)] = headerVal
+		}
+	}
+}
+
 func parseCommaSeparatedList(s common.NetString) (list []string) {
 	values := bytes.Split(s, []byte(","))
 	list = make([]string, len(values))
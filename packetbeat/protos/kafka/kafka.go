@@ -0,0 +1,496 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/applayer"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+const (
+	// maxMessageSize is the largest message size accepted in the size
+	// field, larger values indicate a stream not carrying Kafka.
+	maxMessageSize = 1 << 30
+
+	// maxParsedSize is the number of bytes buffered for parsing a message.
+	// Only this prefix of bigger messages is parsed, the rest is skipped.
+	maxParsedSize = 1 << 20
+)
+
+type stream struct {
+	applayer.Stream
+
+	// ts is the time the first segment of the current message was seen
+	ts time.Time
+	// skip is the number of bytes of a truncated message still to be skipped
+	skip int
+}
+
+type kafkaConnectionData struct {
+	streams [2]*stream
+	pending map[int32]*transaction
+}
+
+type transaction struct {
+	ts           time.Time
+	endTime      time.Time
+	tuple        common.TCPTuple
+	cmdlineTuple *common.ProcessTuple
+	dir          uint8
+
+	request  *kafkaMessage
+	response *kafkaMessage
+}
+
+// Kafka protocol plugin
+type kafkaPlugin struct {
+	// config
+	ports        []int
+	sendRequest  bool
+	sendResponse bool
+
+	transactionTimeout time.Duration
+
+	results protos.Reporter
+}
+
+var (
+	debugf  = logp.MakeDebug("kafka")
+	isDebug = false
+)
+
+var (
+	unmatchedResponses = monitoring.NewInt(nil, "kafka.unmatched_responses")
+	unmatchedRequests  = monitoring.NewInt(nil, "kafka.unmatched_requests")
+)
+
+func init() {
+	protos.Register("kafka", New)
+}
+
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &kafkaPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (kafka *kafkaPlugin) init(results protos.Reporter, config *kafkaConfig) error {
+	kafka.setFromConfig(config)
+
+	kafka.results = results
+	isDebug = logp.IsDebug("kafka")
+
+	return nil
+}
+
+func (kafka *kafkaPlugin) setFromConfig(config *kafkaConfig) {
+	kafka.ports = config.Ports
+	kafka.sendRequest = config.SendRequest
+	kafka.sendResponse = config.SendResponse
+	kafka.transactionTimeout = config.TransactionTimeout
+}
+
+func (kafka *kafkaPlugin) GetPorts() []int {
+	return kafka.ports
+}
+
+func (kafka *kafkaPlugin) ConnectionTimeout() time.Duration {
+	return kafka.transactionTimeout
+}
+
+func (kafka *kafkaPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("ParseKafka exception")
+
+	conn := ensureKafkaConnection(private)
+	conn = kafka.doParse(conn, pkt, tcptuple, dir)
+	if conn == nil {
+		return nil
+	}
+	return conn
+}
+
+func ensureKafkaConnection(private protos.ProtocolData) *kafkaConnectionData {
+	if private == nil {
+		return newConnection()
+	}
+
+	priv, ok := private.(*kafkaConnectionData)
+	if !ok {
+		logp.Warn("kafka connection data type error, create new one")
+		return newConnection()
+	}
+	if priv == nil {
+		logp.Warn("Unexpected: kafka connection data not set, create new one")
+		return newConnection()
+	}
+
+	return priv
+}
+
+func newConnection() *kafkaConnectionData {
+	return &kafkaConnectionData{pending: map[int32]*transaction{}}
+}
+
+// isRequest returns true if the packets sent in direction dir are sent to
+// the broker.
+func (kafka *kafkaPlugin) isRequest(tcptuple *common.TCPTuple, dir uint8) bool {
+	toServer := false
+	for _, port := range kafka.ports {
+		if int(tcptuple.DstPort) == port {
+			toServer = true
+			break
+		}
+	}
+	return toServer == (dir == tcp.TCPDirectionOriginal)
+}
+
+func (kafka *kafkaPlugin) doParse(
+	conn *kafkaConnectionData,
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) *kafkaConnectionData {
+
+	st := conn.streams[dir]
+	if st == nil {
+		st = newStream()
+		conn.streams[dir] = st
+		if isDebug {
+			debugf("new stream: %p (dir=%v, len=%v)", st, dir, len(pkt.Payload))
+		}
+	}
+
+	if st.Buf.Len() == 0 && st.skip == 0 {
+		st.ts = pkt.Ts
+	}
+	if err := st.Append(pkt.Payload); err != nil {
+		if isDebug {
+			debugf("%v, dropping TCP stream: ", err)
+		}
+		return nil
+	}
+
+	isRequest := kafka.isRequest(tcptuple, dir)
+	for st.Buf.Len() > 0 {
+		if st.skip > 0 {
+			n := st.skip
+			if n > st.Buf.Len() {
+				n = st.Buf.Len()
+			}
+			st.Buf.Advance(n)
+			st.Buf.Reset()
+			st.skip -= n
+			st.ts = pkt.Ts
+			continue
+		}
+
+		data := st.Buf.Bytes()
+		if len(data) < 4 {
+			break
+		}
+		size := int(int32(binary.BigEndian.Uint32(data)))
+		if size < 4 || size > maxMessageSize {
+			kafka.dropStream(conn, dir, errInvalidSize)
+			return conn
+		}
+
+		total := 4 + size
+		truncated := len(data) < total
+		if truncated && len(data) < maxParsedSize {
+			// wait for more data
+			break
+		}
+		n := total
+		if truncated {
+			n = len(data)
+		}
+
+		var err error
+		if isRequest {
+			err = kafka.handleRequest(conn, data[4:n], truncated, total, tcptuple, dir, st.ts)
+		} else {
+			kafka.handleResponse(conn, data[4:n], truncated, total, dir, pkt.Ts)
+		}
+		if err != nil {
+			kafka.dropStream(conn, dir, err)
+			return conn
+		}
+
+		st.Buf.Advance(n)
+		st.Buf.Reset()
+		st.skip = total - n
+		st.ts = pkt.Ts
+	}
+
+	return conn
+}
+
+func (kafka *kafkaPlugin) dropStream(conn *kafkaConnectionData, dir uint8, err error) {
+	// drop this tcp stream. Will retry parsing with the next segment in it
+	conn.streams[dir] = nil
+	if isDebug {
+		debugf("Ignore Kafka message (%v). Drop tcp stream. Try parsing with the next segment", err)
+	}
+}
+
+func newStream() *stream {
+	s := &stream{}
+	s.Stream.Init(tcp.TCPMaxDataInStream)
+	return s
+}
+
+func (kafka *kafkaPlugin) handleRequest(
+	conn *kafkaConnectionData,
+	data []byte,
+	truncated bool,
+	size int,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) error {
+	m, err := parseRequest(data, truncated)
+	if err != nil {
+		return err
+	}
+	m.size = size
+	if isDebug {
+		debugf("Kafka (%p) %s v%d request, correlation ID %d",
+			conn, apiName(m.apiKey), m.apiVersion, m.correlationID)
+	}
+
+	t := &transaction{
+		ts:           ts,
+		tuple:        *tcptuple,
+		cmdlineTuple: procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort()),
+		dir:          dir,
+		request:      m,
+	}
+	if m.apiKey == apiProduce && m.acks == 0 {
+		// the broker does not respond to produce requests without acks
+		kafka.publishTransaction(t)
+		return nil
+	}
+	kafka.expirePending(conn, ts)
+	conn.pending[m.correlationID] = t
+	return nil
+}
+
+// expirePending publishes the requests without response for
+// transaction_timeout, without waiting for their response anymore.
+func (kafka *kafkaPlugin) expirePending(conn *kafkaConnectionData, ts time.Time) {
+	for correlationID, t := range conn.pending {
+		if ts.Sub(t.ts) > kafka.transactionTimeout {
+			debugf("Request with correlation ID %d timed out", correlationID)
+			delete(conn.pending, correlationID)
+			unmatchedRequests.Add(1)
+			kafka.publishTransaction(t)
+		}
+	}
+}
+
+func (kafka *kafkaPlugin) handleResponse(
+	conn *kafkaConnectionData,
+	data []byte,
+	truncated bool,
+	size int,
+	dir uint8,
+	ts time.Time,
+) {
+	correlationID, ok := parseResponseHeader(data)
+	t := conn.pending[correlationID]
+	if !ok || t == nil {
+		debugf("Response from unknown transaction. Ignoring")
+		unmatchedResponses.Add(1)
+		return
+	}
+	delete(conn.pending, correlationID)
+
+	t.response = parseResponse(data, truncated, t.request)
+	t.response.size = size
+	t.endTime = ts
+	kafka.publishTransaction(t)
+}
+
+func (kafka *kafkaPlugin) publishTransaction(t *transaction) {
+	if kafka.results == nil {
+		return
+	}
+	kafka.results(kafka.newEvent(t))
+}
+
+func (kafka *kafkaPlugin) newEvent(t *transaction) beat.Event {
+	requ, resp := t.request, t.response
+
+	source, destination := common.MakeEndpointPair(t.tuple.BaseTuple, t.cmdlineTuple)
+	src, dst := &source, &destination
+	if t.dir == tcp.TCPDirectionReverse {
+		src, dst = dst, src
+	}
+
+	evt, pbf := pb.NewBeatEvent(t.ts)
+	pbf.SetSource(src)
+	pbf.SetDestination(dst)
+	pbf.Source.Bytes = int64(requ.size)
+	pbf.Event.Dataset = "kafka"
+	pbf.Event.Start = t.ts
+	if resp != nil {
+		pbf.Destination.Bytes = int64(resp.size)
+		pbf.Event.End = t.endTime
+	}
+	pbf.Network.Transport = "tcp"
+	pbf.Network.Protocol = pbf.Event.Dataset
+
+	info := common.MapStr{
+		"api_key":        requ.apiKey,
+		"api_version":    requ.apiVersion,
+		"correlation_id": requ.correlationID,
+	}
+	if requ.clientID != "" {
+		info["client_id"] = requ.clientID
+	}
+	if requ.apiKey == apiProduce {
+		info["acks"] = requ.acks
+	}
+	if requ.groupID != "" {
+		info["group_id"] = requ.groupID
+	}
+	if requ.truncated || (resp != nil && resp.truncated) {
+		info["truncated"] = true
+	}
+
+	// the response lists the partitions with their error codes
+	topicsMsg := requ
+	if resp != nil && len(resp.topics) > 0 {
+		topicsMsg = resp
+	}
+	if len(topicsMsg.topics) > 0 {
+		info["topics"] = topicsMsg.topics
+	}
+	if len(topicsMsg.partitions) > 0 {
+		info["partitions"] = partitionsFields(topicsMsg.partitions, resp != nil)
+	}
+
+	status := common.OK_STATUS
+	if resp != nil {
+		info["error_code"] = resp.errorCode
+		info["error"] = errorName(resp.errorCode)
+		if resp.errorCode != 0 {
+			status = common.ERROR_STATUS
+		}
+	}
+
+	method := apiName(requ.apiKey)
+	path := strings.Join(topicsMsg.topics, ",")
+
+	fields := evt.Fields
+	fields["type"] = pbf.Event.Dataset
+	fields["status"] = status
+	fields["method"] = method
+	fields["path"] = path
+	fields["query"] = strings.TrimSpace(fmt.Sprintf("%s v%d %s", method, requ.apiVersion, path))
+	fields["kafka"] = info
+
+	if kafka.sendRequest {
+		fields["request"] = fields["query"]
+	}
+	if kafka.sendResponse && resp != nil {
+		fields["response"] = info["error"]
+	}
+
+	return evt
+}
+
+func partitionsFields(partitions []topicPartition, withErrors bool) []common.MapStr {
+	fields := make([]common.MapStr, len(partitions))
+	for i, p := range partitions {
+		fields[i] = common.MapStr{
+			"topic":     p.topic,
+			"partition": p.partition,
+		}
+		if withErrors {
+			fields[i]["error_code"] = p.errorCode
+		}
+	}
+	return fields
+}
+
+func (kafka *kafkaPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
+	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool) {
+
+	// the message boundaries are lost with the missing bytes
+	kafka.flushPending(private)
+	return private, true
+}
+
+func (kafka *kafkaPlugin) ReceivedFin(tcptuple *common.TCPTuple, dir uint8,
+	private protos.ProtocolData) protos.ProtocolData {
+
+	kafka.flushPending(private)
+	return private
+}
+
+func (kafka *kafkaPlugin) Expired(tuple *common.TCPTuple, private protos.ProtocolData) {
+	kafka.flushPending(private)
+}
+
+// flushPending publishes the requests without response once the connection
+// ends or can not be parsed anymore.
+func (kafka *kafkaPlugin) flushPending(private protos.ProtocolData) {
+	conn, ok := private.(*kafkaConnectionData)
+	if !ok || conn == nil {
+		return
+	}
+
+	for correlationID, t := range conn.pending {
+		debugf("Request with correlation ID %d not answered before the end of the stream", correlationID)
+		delete(conn.pending, correlationID)
+		unmatchedRequests.Add(1)
+		kafka.publishTransaction(t)
+	}
+}
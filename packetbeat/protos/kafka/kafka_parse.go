@@ -0,0 +1,681 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+)
+
+// API keys with body parsing support.
+const (
+	apiProduce      = 0
+	apiFetch        = 1
+	apiMetadata     = 3
+	apiOffsetCommit = 8
+)
+
+// api describes the versions of an API whose bodies are parsed.
+type api struct {
+	// first version using the flexible encoding (KIP-482)
+	firstFlexible int16
+	// last version with known layout
+	maxVersion int16
+
+	parseRequest  func(d *decoder, version int16, m *kafkaMessage)
+	parseResponse func(d *decoder, version int16, m *kafkaMessage)
+}
+
+var apis = map[int16]api{
+	apiProduce:      {9, 11, parseProduceRequest, parseProduceResponse},
+	apiFetch:        {12, 13, parseFetchRequest, parseFetchResponse},
+	apiMetadata:     {9, 12, parseMetadataRequest, parseMetadataResponse},
+	apiOffsetCommit: {8, 9, parseOffsetCommitRequest, parseOffsetCommitResponse},
+}
+
+var apiNames = []string{
+	"Produce",
+	"Fetch",
+	"ListOffsets",
+	"Metadata",
+	"LeaderAndIsr",
+	"StopReplica",
+	"UpdateMetadata",
+	"ControlledShutdown",
+	"OffsetCommit",
+	"OffsetFetch",
+	"FindCoordinator",
+	"JoinGroup",
+	"Heartbeat",
+	"LeaveGroup",
+	"SyncGroup",
+	"DescribeGroups",
+	"ListGroups",
+	"SaslHandshake",
+	"ApiVersions",
+	"CreateTopics",
+	"DeleteTopics",
+	"DeleteRecords",
+	"InitProducerId",
+	"OffsetForLeaderEpoch",
+	"AddPartitionsToTxn",
+	"AddOffsetsToTxn",
+	"EndTxn",
+	"WriteTxnMarkers",
+	"TxnOffsetCommit",
+	"DescribeAcls",
+	"CreateAcls",
+	"DeleteAcls",
+	"DescribeConfigs",
+	"AlterConfigs",
+	"AlterReplicaLogDirs",
+	"DescribeLogDirs",
+	"SaslAuthenticate",
+	"CreatePartitions",
+	"CreateDelegationToken",
+	"RenewDelegationToken",
+	"ExpireDelegationToken",
+	"DescribeDelegationToken",
+	"DeleteGroups",
+	"ElectLeaders",
+	"IncrementalAlterConfigs",
+	"AlterPartitionReassignments",
+	"ListPartitionReassignments",
+	"OffsetDelete",
+	"DescribeClientQuotas",
+	"AlterClientQuotas",
+	"DescribeUserScramCredentials",
+	"AlterUserScramCredentials",
+}
+
+var errorNames = []string{
+	"NONE",
+	"OFFSET_OUT_OF_RANGE",
+	"CORRUPT_MESSAGE",
+	"UNKNOWN_TOPIC_OR_PARTITION",
+	"INVALID_FETCH_SIZE",
+	"LEADER_NOT_AVAILABLE",
+	"NOT_LEADER_OR_FOLLOWER",
+	"REQUEST_TIMED_OUT",
+	"BROKER_NOT_AVAILABLE",
+	"REPLICA_NOT_AVAILABLE",
+	"MESSAGE_TOO_LARGE",
+	"STALE_CONTROLLER_EPOCH",
+	"OFFSET_METADATA_TOO_LARGE",
+	"NETWORK_EXCEPTION",
+	"COORDINATOR_LOAD_IN_PROGRESS",
+	"COORDINATOR_NOT_AVAILABLE",
+	"NOT_COORDINATOR",
+	"INVALID_TOPIC_EXCEPTION",
+	"RECORD_LIST_TOO_LARGE",
+	"NOT_ENOUGH_REPLICAS",
+	"NOT_ENOUGH_REPLICAS_AFTER_APPEND",
+	"INVALID_REQUIRED_ACKS",
+	"ILLEGAL_GENERATION",
+	"INCONSISTENT_GROUP_PROTOCOL",
+	"INVALID_GROUP_ID",
+	"UNKNOWN_MEMBER_ID",
+	"INVALID_SESSION_TIMEOUT",
+	"REBALANCE_IN_PROGRESS",
+	"INVALID_COMMIT_OFFSET_SIZE",
+	"TOPIC_AUTHORIZATION_FAILED",
+	"GROUP_AUTHORIZATION_FAILED",
+	"CLUSTER_AUTHORIZATION_FAILED",
+	"INVALID_TIMESTAMP",
+	"UNSUPPORTED_SASL_MECHANISM",
+	"ILLEGAL_SASL_STATE",
+	"UNSUPPORTED_VERSION",
+	"TOPIC_ALREADY_EXISTS",
+	"INVALID_PARTITIONS",
+	"INVALID_REPLICATION_FACTOR",
+	"INVALID_REPLICA_ASSIGNMENT",
+	"INVALID_CONFIG",
+	"NOT_CONTROLLER",
+	"INVALID_REQUEST",
+	"UNSUPPORTED_FOR_MESSAGE_FORMAT",
+	"POLICY_VIOLATION",
+	"OUT_OF_ORDER_SEQUENCE_NUMBER",
+	"DUPLICATE_SEQUENCE_NUMBER",
+	"INVALID_PRODUCER_EPOCH",
+	"INVALID_TXN_STATE",
+	"INVALID_PRODUCER_ID_MAPPING",
+	"INVALID_TRANSACTION_TIMEOUT",
+	"CONCURRENT_TRANSACTIONS",
+	"TRANSACTION_COORDINATOR_FENCED",
+	"TRANSACTIONAL_ID_AUTHORIZATION_FAILED",
+	"SECURITY_DISABLED",
+	"OPERATION_NOT_ATTEMPTED",
+	"KAFKA_STORAGE_ERROR",
+	"LOG_DIR_NOT_FOUND",
+	"SASL_AUTHENTICATION_FAILED",
+	"UNKNOWN_PRODUCER_ID",
+	"REASSIGNMENT_IN_PROGRESS",
+}
+
+// Limits used to detect streams not carrying the Kafka protocol.
+const (
+	maxAPIKey     = 1000
+	maxAPIVersion = 100
+)
+
+var (
+	errInvalidSize   = errors.New("invalid message size")
+	errInvalidHeader = errors.New("invalid request header")
+)
+
+func apiName(key int16) string {
+	if int(key) < len(apiNames) {
+		return apiNames[key]
+	}
+	return "Unknown"
+}
+
+func errorName(code int16) string {
+	switch {
+	case code == -1:
+		return "UNKNOWN_SERVER_ERROR"
+	case code >= 0 && int(code) < len(errorNames):
+		return errorNames[code]
+	default:
+		return "UNKNOWN"
+	}
+}
+
+type topicPartition struct {
+	topic     string
+	partition int32
+	errorCode int16
+}
+
+type kafkaMessage struct {
+	size      int  // size of the complete message, including the size field
+	truncated bool // only a prefix of the message has been parsed
+
+	apiKey        int16
+	apiVersion    int16
+	correlationID int32
+	clientID      string
+
+	// request fields
+	acks    int16 // Produce only
+	groupID string
+
+	topics     []string
+	partitions []topicPartition
+
+	// first error code reported in the response
+	errorCode int16
+}
+
+func (m *kafkaMessage) addTopic(topic string) {
+	for _, t := range m.topics {
+		if t == topic {
+			return
+		}
+	}
+	m.topics = append(m.topics, topic)
+}
+
+func (m *kafkaMessage) addPartition(topic string, partition int32, errorCode int16) {
+	m.addTopic(topic)
+	m.partitions = append(m.partitions, topicPartition{topic, partition, errorCode})
+	m.setError(errorCode)
+}
+
+func (m *kafkaMessage) setError(code int16) {
+	if m.errorCode == 0 {
+		m.errorCode = code
+	}
+}
+
+// parseRequest parses the request header and, for supported APIs and
+// versions, the topics and partitions of the body. If the message is
+// truncated, the complete header is required.
+func parseRequest(data []byte, truncated bool) (*kafkaMessage, error) {
+	d := &decoder{buf: data}
+	m := &kafkaMessage{truncated: truncated}
+
+	m.apiKey = d.int16()
+	m.apiVersion = d.int16()
+	m.correlationID = d.int32()
+	// the client ID is never encoded as compact string
+	clientID, ok := d.nullableString()
+	if d.err || m.apiKey < 0 || m.apiKey >= maxAPIKey ||
+		m.apiVersion < 0 || m.apiVersion >= maxAPIVersion {
+		return nil, errInvalidHeader
+	}
+	if ok {
+		m.clientID = clientID
+	}
+
+	a, found := apis[m.apiKey]
+	if !found || m.apiVersion > a.maxVersion {
+		return m, nil
+	}
+	if m.apiVersion >= a.firstFlexible {
+		d.flexible = true
+		d.tags()
+	}
+	a.parseRequest(d, m.apiVersion, m)
+	return m, nil
+}
+
+// parseResponseHeader parses the correlation ID of a response.
+func parseResponseHeader(data []byte) (int32, bool) {
+	if len(data) < 4 {
+		return 0, false
+	}
+	return int32(binary.BigEndian.Uint32(data)), true
+}
+
+// parseResponse parses the response to the request.
+func parseResponse(data []byte, truncated bool, requ *kafkaMessage) *kafkaMessage {
+	d := &decoder{buf: data}
+	m := &kafkaMessage{
+		truncated:     truncated,
+		apiKey:        requ.apiKey,
+		apiVersion:    requ.apiVersion,
+		correlationID: d.int32(),
+	}
+
+	a, found := apis[m.apiKey]
+	if !found || m.apiVersion > a.maxVersion {
+		return m
+	}
+	if m.apiVersion >= a.firstFlexible {
+		d.flexible = true
+		d.tags()
+	}
+	a.parseResponse(d, m.apiVersion, m)
+	return m
+}
+
+func parseProduceRequest(d *decoder, v int16, m *kafkaMessage) {
+	if v >= 3 {
+		d.nullableString() // transactional_id
+	}
+	m.acks = d.int16()
+	d.int32() // timeout_ms
+	d.array(func() {
+		topic := d.string()
+		d.array(func() {
+			partition := d.int32()
+			if !d.err {
+				m.addPartition(topic, partition, 0)
+			}
+			d.bytes() // records
+			d.tags()
+		})
+		d.tags()
+	})
+}
+
+func parseProduceResponse(d *decoder, v int16, m *kafkaMessage) {
+	d.array(func() {
+		topic := d.string()
+		d.array(func() {
+			partition := d.int32()
+			errorCode := d.int16()
+			d.int64() // base_offset
+			if v >= 2 {
+				d.int64() // log_append_time_ms
+			}
+			if v >= 5 {
+				d.int64() // log_start_offset
+			}
+			if v >= 8 {
+				d.array(func() { // record_errors
+					d.int32()
+					d.nullableString()
+					d.tags()
+				})
+				d.nullableString() // error_message
+			}
+			d.tags()
+			if !d.err {
+				m.addPartition(topic, partition, errorCode)
+			}
+		})
+		d.tags()
+	})
+}
+
+func parseFetchRequest(d *decoder, v int16, m *kafkaMessage) {
+	d.int32() // replica_id
+	d.int32() // max_wait_ms
+	d.int32() // min_bytes
+	if v >= 3 {
+		d.int32() // max_bytes
+	}
+	if v >= 4 {
+		d.int8() // isolation_level
+	}
+	if v >= 7 {
+		d.int32() // session_id
+		d.int32() // session_epoch
+	}
+	d.array(func() {
+		topic := d.topic(v >= 13)
+		d.array(func() {
+			partition := d.int32()
+			if v >= 9 {
+				d.int32() // current_leader_epoch
+			}
+			d.int64() // fetch_offset
+			if v >= 12 {
+				d.int32() // last_fetched_epoch
+			}
+			if v >= 5 {
+				d.int64() // log_start_offset
+			}
+			d.int32() // partition_max_bytes
+			d.tags()
+			if !d.err {
+				m.addPartition(topic, partition, 0)
+			}
+		})
+		d.tags()
+	})
+}
+
+func parseFetchResponse(d *decoder, v int16, m *kafkaMessage) {
+	if v >= 1 {
+		d.int32() // throttle_time_ms
+	}
+	if v >= 7 {
+		m.setError(d.int16())
+		d.int32() // session_id
+	}
+	d.array(func() {
+		topic := d.topic(v >= 13)
+		d.array(func() {
+			partition := d.int32()
+			errorCode := d.int16()
+			d.int64() // high_watermark
+			if v >= 4 {
+				d.int64() // last_stable_offset
+			}
+			if v >= 5 {
+				d.int64() // log_start_offset
+			}
+			if v >= 4 {
+				d.array(func() { // aborted_transactions
+					d.int64()
+					d.int64()
+					d.tags()
+				})
+			}
+			if v >= 11 {
+				d.int32() // preferred_read_replica
+			}
+			// the records may be cut off in truncated messages
+			if !d.err {
+				m.addPartition(topic, partition, errorCode)
+			}
+			d.bytes() // records
+			d.tags()
+		})
+		d.tags()
+	})
+}
+
+func parseMetadataRequest(d *decoder, v int16, m *kafkaMessage) {
+	d.array(func() {
+		var topic string
+		if v >= 10 {
+			d.uuid()
+			topic, _ = d.nullableString()
+		} else {
+			topic = d.string()
+		}
+		d.tags()
+		if !d.err && topic != "" {
+			m.addTopic(topic)
+		}
+	})
+}
+
+func parseMetadataResponse(d *decoder, v int16, m *kafkaMessage) {
+	if v >= 3 {
+		d.int32() // throttle_time_ms
+	}
+	d.array(func() { // brokers
+		d.int32()
+		d.string()
+		d.int32()
+		if v >= 1 {
+			d.nullableString()
+		}
+		d.tags()
+	})
+	if v >= 2 {
+		d.nullableString() // cluster_id
+	}
+	if v >= 1 {
+		d.int32() // controller_id
+	}
+	d.array(func() {
+		errorCode := d.int16()
+		var topic string
+		if v >= 10 {
+			topic, _ = d.nullableString()
+			d.uuid()
+		} else {
+			topic = d.string()
+		}
+		if v >= 1 {
+			d.int8() // is_internal
+		}
+		d.array(func() {
+			partitionError := d.int16()
+			partition := d.int32()
+			d.int32() // leader_id
+			if v >= 7 {
+				d.int32() // leader_epoch
+			}
+			d.array(func() { d.int32() }) // replica_nodes
+			d.array(func() { d.int32() }) // isr_nodes
+			if v >= 5 {
+				d.array(func() { d.int32() }) // offline_replicas
+			}
+			d.tags()
+			if !d.err {
+				m.addPartition(topic, partition, partitionError)
+			}
+		})
+		if v >= 8 {
+			d.int32() // topic_authorized_operations
+		}
+		d.tags()
+		if !d.err {
+			m.addTopic(topic)
+			m.setError(errorCode)
+		}
+	})
+}
+
+func parseOffsetCommitRequest(d *decoder, v int16, m *kafkaMessage) {
+	m.groupID = d.string()
+	if v >= 1 {
+		d.int32()  // generation_id
+		d.string() // member_id
+	}
+	if v >= 7 {
+		d.nullableString() // group_instance_id
+	}
+	if v >= 2 && v <= 4 {
+		d.int64() // retention_time_ms
+	}
+	d.array(func() {
+		topic := d.string()
+		d.array(func() {
+			partition := d.int32()
+			d.int64() // committed_offset
+			if v >= 6 {
+				d.int32() // committed_leader_epoch
+			}
+			if v == 1 {
+				d.int64() // commit_timestamp
+			}
+			d.nullableString() // committed_metadata
+			d.tags()
+			if !d.err {
+				m.addPartition(topic, partition, 0)
+			}
+		})
+		d.tags()
+	})
+}
+
+func parseOffsetCommitResponse(d *decoder, v int16, m *kafkaMessage) {
+	if v >= 3 {
+		d.int32() // throttle_time_ms
+	}
+	d.array(func() {
+		topic := d.string()
+		d.array(func() {
+			partition := d.int32()
+			errorCode := d.int16()
+			d.tags()
+			if !d.err {
+				m.addPartition(topic, partition, errorCode)
+			}
+		})
+		d.tags()
+	})
+}
+
+// decoder reads the primitive types of the Kafka protocol. Reading past the
+// end of the buffer sets err, after which all reads return zero values.
+type decoder struct {
+	buf      []byte
+	flexible bool
+	err      bool
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err || n < 0 || len(d.buf) < n {
+		d.err = true
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	if b := d.next(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *decoder) int16() int16 {
+	if b := d.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *decoder) int32() int32 {
+	if b := d.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *decoder) int64() int64 {
+	if b := d.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = true
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+// length reads the length of a string, bytes or array. Null values have a
+// length of -1.
+func (d *decoder) length(size func() int) int {
+	if d.flexible {
+		return int(d.uvarint()) - 1
+	}
+	return size()
+}
+
+func (d *decoder) nullableString() (string, bool) {
+	n := d.length(func() int { return int(d.int16()) })
+	if n < 0 {
+		return "", false
+	}
+	return string(d.next(n)), true
+}
+
+func (d *decoder) string() string {
+	s, _ := d.nullableString()
+	return s
+}
+
+func (d *decoder) bytes() {
+	if n := d.length(func() int { return int(d.int32()) }); n > 0 {
+		d.next(n)
+	}
+}
+
+func (d *decoder) uuid() string {
+	return base64.RawURLEncoding.EncodeToString(d.next(16))
+}
+
+// topic reads a topic name, or a topic ID if the version identifies topics
+// by ID.
+func (d *decoder) topic(byID bool) string {
+	if byID {
+		return d.uuid()
+	}
+	return d.string()
+}
+
+// array calls fn for each element of the array.
+func (d *decoder) array(fn func()) {
+	n := d.length(func() int { return int(d.int32()) })
+	for i := 0; i < n && !d.err; i++ {
+		fn()
+	}
+}
+
+// tags skips the tagged fields of the flexible encoding.
+func (d *decoder) tags() {
+	if !d.flexible {
+		return
+	}
+	for n := d.uvarint(); n > 0 && !d.err; n-- {
+		d.uvarint() // tag
+		d.next(int(d.uvarint()))
+	}
+}
@@ -0,0 +1,500 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package kafka
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+// Helper function returning a Kafka module that can be used in tests. It
+// publishes the transactions in the event store.
+func kafkaModForTests(config *kafkaConfig) (*eventStore, *kafkaPlugin) {
+	var kafka kafkaPlugin
+	results := &eventStore{}
+	if config == nil {
+		config = &kafkaConfig{}
+		*config = defaultConfig
+		config.Ports = []int{9092}
+	}
+	kafka.init(results.publish, config)
+	return results, &kafka
+}
+
+// Helper function that returns an example TcpTuple
+func testTCPTuple() *common.TCPTuple {
+	t := &common.TCPTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 6512, DstPort: 9092,
+		},
+	}
+	t.ComputeHashables()
+	return t
+}
+
+// encoder builds Kafka messages for the tests.
+type encoder struct {
+	buf      []byte
+	flexible bool
+}
+
+func (e *encoder) int8(v int8) *encoder {
+	e.buf = append(e.buf, byte(v))
+	return e
+}
+
+func (e *encoder) int16(v int16) *encoder {
+	e.buf = append(e.buf, 0, 0)
+	binary.BigEndian.PutUint16(e.buf[len(e.buf)-2:], uint16(v))
+	return e
+}
+
+func (e *encoder) int32(v int32) *encoder {
+	e.buf = append(e.buf, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(e.buf[len(e.buf)-4:], uint32(v))
+	return e
+}
+
+func (e *encoder) int64(v int64) *encoder {
+	return e.int32(int32(v >> 32)).int32(int32(v))
+}
+
+func (e *encoder) uvarint(v uint64) *encoder {
+	var b [binary.MaxVarintLen64]byte
+	e.buf = append(e.buf, b[:binary.PutUvarint(b[:], v)]...)
+	return e
+}
+
+func (e *encoder) length(n int, size func(int) *encoder) *encoder {
+	if e.flexible {
+		return e.uvarint(uint64(n + 1))
+	}
+	return size(n)
+}
+
+func (e *encoder) string(s string) *encoder {
+	e.length(len(s), func(n int) *encoder { return e.int16(int16(n)) })
+	e.buf = append(e.buf, s...)
+	return e
+}
+
+func (e *encoder) bytes(b []byte) *encoder {
+	e.length(len(b), func(n int) *encoder { return e.int32(int32(n)) })
+	e.buf = append(e.buf, b...)
+	return e
+}
+
+func (e *encoder) array(n int) *encoder {
+	return e.length(n, func(n int) *encoder { return e.int32(int32(n)) })
+}
+
+func (e *encoder) tags() *encoder {
+	if e.flexible {
+		e.uvarint(0)
+	}
+	return e
+}
+
+// message returns the encoded message prefixed with its size.
+func (e *encoder) message() []byte {
+	data := make([]byte, 4, 4+len(e.buf))
+	binary.BigEndian.PutUint32(data, uint32(len(e.buf)))
+	return append(data, e.buf...)
+}
+
+func requestHeader(apiKey, apiVersion int16, correlationID int32, flexible bool) *encoder {
+	e := &encoder{}
+	e.int16(apiKey).int16(apiVersion).int32(correlationID).string("producer-1")
+	e.flexible = flexible
+	return e.tags()
+}
+
+func responseHeader(correlationID int32, flexible bool) *encoder {
+	e := &encoder{flexible: flexible}
+	return e.int32(correlationID).tags()
+}
+
+func parse(t *testing.T, kafka *kafkaPlugin, private protos.ProtocolData, dir uint8, data []byte) protos.ProtocolData {
+	return parseAt(t, kafka, private, dir, data, time.Now())
+}
+
+func parseAt(t *testing.T, kafka *kafkaPlugin, private protos.ProtocolData, dir uint8, data []byte, ts time.Time) protos.ProtocolData {
+	pkt := &protos.Packet{Ts: ts, Payload: data}
+	private = kafka.Parse(pkt, testTCPTuple(), dir, private)
+	if !assert.NotNil(t, private, "connection dropped") {
+		t.FailNow()
+	}
+	return private
+}
+
+// Helper function to read from the results Queue. Raises
+// an error if nothing is found in the queue.
+func expectTransaction(t *testing.T, e *eventStore) common.MapStr {
+	if len(e.events) == 0 {
+		t.Fatal("No transaction")
+	}
+
+	event := e.events[0]
+	e.events = e.events[1:]
+	return event.Fields
+}
+
+func TestParseRequestHeader(t *testing.T) {
+	data := requestHeader(18, 3, 1, true).message()
+	m, err := parseRequest(data[4:], false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ApiVersions", apiName(m.apiKey))
+		assert.Equal(t, int16(3), m.apiVersion)
+		assert.Equal(t, int32(1), m.correlationID)
+		assert.Equal(t, "producer-1", m.clientID)
+	}
+
+	_, err = parseRequest([]byte{0, 0, 0}, false)
+	assert.Equal(t, errInvalidHeader, err)
+
+	_, err = parseRequest([]byte{0xff, 0xff, 0, 0, 0, 0, 0, 1, 0xff, 0xff}, false)
+	assert.Equal(t, errInvalidHeader, err)
+}
+
+func TestErrorName(t *testing.T) {
+	assert.Equal(t, "NONE", errorName(0))
+	assert.Equal(t, "UNKNOWN_TOPIC_OR_PARTITION", errorName(3))
+	assert.Equal(t, "UNKNOWN_SERVER_ERROR", errorName(-1))
+	assert.Equal(t, "UNKNOWN", errorName(1000))
+}
+
+func TestProduce(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	requ := requestHeader(apiProduce, 7, 42, false)
+	requ.string("txn").int16(-1).int32(30000)
+	requ.array(1).string("orders")
+	requ.array(2).int32(0).bytes([]byte("records")).int32(1).bytes(nil)
+
+	resp := responseHeader(42, false)
+	resp.array(1).string("orders")
+	resp.array(2)
+	resp.int32(0).int16(0).int64(10).int64(-1).int64(0)
+	resp.int32(1).int16(6).int64(-1).int64(-1).int64(0)
+	resp.int32(0) // throttle_time_ms
+
+	private := parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ.message())
+	assert.Empty(t, results.events)
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp.message())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "kafka", trans["type"])
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	assert.Equal(t, "Produce", trans["method"])
+	assert.Equal(t, "orders", trans["path"])
+	assert.Equal(t, "Produce v7 orders", trans["query"])
+
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, int16(0), info["api_key"])
+	assert.Equal(t, int16(7), info["api_version"])
+	assert.Equal(t, int32(42), info["correlation_id"])
+	assert.Equal(t, "producer-1", info["client_id"])
+	assert.Equal(t, int16(-1), info["acks"])
+	assert.Equal(t, []string{"orders"}, info["topics"])
+	assert.Equal(t, []common.MapStr{
+		{"topic": "orders", "partition": int32(0), "error_code": int16(0)},
+		{"topic": "orders", "partition": int32(1), "error_code": int16(6)},
+	}, info["partitions"])
+	assert.Equal(t, int16(6), info["error_code"])
+	assert.Equal(t, "NOT_LEADER_OR_FOLLOWER", info["error"])
+
+	assert.Empty(t, results.events)
+}
+
+func TestProduceWithoutAcks(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	requ := requestHeader(apiProduce, 3, 1, false)
+	requ.string("").int16(0).int32(30000)
+	requ.array(1).string("logs")
+	requ.array(1).int32(2).bytes([]byte("records"))
+
+	parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ.message())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, int16(0), info["acks"])
+	assert.Equal(t, []common.MapStr{
+		{"topic": "logs", "partition": int32(2)},
+	}, info["partitions"])
+	assert.Nil(t, info["error"])
+}
+
+func TestFetchFlexible(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	requ := requestHeader(apiFetch, 12, 7, true)
+	requ.int32(-1).int32(500).int32(1).int32(52428800).int8(0).int32(0).int32(-1)
+	requ.array(1).string("events")
+	requ.array(1).int32(3).int32(-1).int64(100).int32(-1).int64(-1).int32(1048576).tags()
+	requ.tags()
+	requ.array(0)         // forgotten_topics_data
+	requ.string("rack-1") // rack_id
+	requ.tags()
+
+	resp := responseHeader(7, true)
+	resp.int32(0).int16(0).int32(0)
+	resp.array(1).string("events")
+	resp.array(1).int32(3).int16(1).int64(200).int64(200).int64(0)
+	resp.array(0).int32(-1).bytes([]byte("records")).tags()
+	resp.tags()
+	resp.tags()
+
+	private := parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ.message())
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp.message())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Fetch", trans["method"])
+	assert.Equal(t, "events", trans["path"])
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, []common.MapStr{
+		{"topic": "events", "partition": int32(3), "error_code": int16(1)},
+	}, info["partitions"])
+	assert.Equal(t, "OFFSET_OUT_OF_RANGE", info["error"])
+	assert.Nil(t, info["truncated"])
+}
+
+func TestFetchLargeResponse(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	requ := requestHeader(apiFetch, 4, 8, false)
+	requ.int32(-1).int32(500).int32(1).int32(52428800).int8(0)
+	requ.array(1).string("events")
+	requ.array(1).int32(0).int64(0).int32(4 * maxParsedSize)
+
+	resp := responseHeader(8, false)
+	resp.int32(0)
+	resp.array(1).string("events")
+	resp.array(1).int32(0).int16(0).int64(0).int64(0)
+	resp.array(0).bytes(make([]byte, 3*maxParsedSize))
+	data := resp.message()
+
+	private := parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ.message())
+	for len(data) > 0 {
+		n := 65536
+		if n > len(data) {
+			n = len(data)
+		}
+		private = parse(t, kafka, private, tcp.TCPDirectionReverse, data[:n])
+		data = data[n:]
+	}
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, true, info["truncated"])
+	assert.Equal(t, []common.MapStr{
+		{"topic": "events", "partition": int32(0), "error_code": int16(0)},
+	}, info["partitions"])
+
+	assert.Empty(t, results.events)
+
+	// the stream is in sync after the large message
+	requ = requestHeader(apiMetadata, 1, 9, false)
+	requ.array(0)
+	resp = responseHeader(9, false)
+	resp.array(0).int32(1).array(0)
+	private = parse(t, kafka, private, tcp.TCPDirectionOriginal, requ.message())
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp.message())
+	trans = expectTransaction(t, results)
+	assert.Equal(t, "Metadata", trans["method"])
+}
+
+func TestMetadata(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	requ := requestHeader(apiMetadata, 4, 3, false)
+	requ.array(2).string("orders").string("missing")
+	requ.int8(1) // allow_auto_topic_creation
+
+	resp := responseHeader(3, false)
+	resp.int32(0)
+	resp.array(1).int32(1).string("broker-1").int32(9092).string("")
+	resp.string("cluster").int32(1)
+	resp.array(2)
+	resp.int16(0).string("orders").int8(0)
+	resp.array(1).int16(0).int32(0).int32(1).array(1).int32(1).array(1).int32(1)
+	resp.int16(3).string("missing").int8(0).array(0)
+
+	private := parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ.message())
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp.message())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	assert.Equal(t, "orders,missing", trans["path"])
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, []string{"orders", "missing"}, info["topics"])
+	assert.Equal(t, "UNKNOWN_TOPIC_OR_PARTITION", info["error"])
+}
+
+func TestOffsetCommit(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	requ := requestHeader(apiOffsetCommit, 8, 5, true)
+	requ.string("billing").int32(4).string("member-1").string("")
+	requ.array(1).string("orders")
+	requ.array(1).int32(0).int64(500).int32(-1).string("").tags()
+	requ.tags()
+	requ.tags()
+
+	resp := responseHeader(5, true)
+	resp.int32(0)
+	resp.array(1).string("orders")
+	resp.array(1).int32(0).int16(0).tags()
+	resp.tags()
+	resp.tags()
+
+	private := parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ.message())
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp.message())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, "billing", info["group_id"])
+	assert.Equal(t, "NONE", info["error"])
+}
+
+func TestCorrelation(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+
+	// an unsupported API is reported with the header fields only
+	var private protos.ProtocolData
+	requ1 := requestHeader(18, 2, 1, false).message()
+	requ2 := requestHeader(apiMetadata, 0, 2, false).array(0).message()
+	data := append(requ1, requ2...)
+	private = parse(t, kafka, private, tcp.TCPDirectionOriginal, data[:10])
+	private = parse(t, kafka, private, tcp.TCPDirectionOriginal, data[10:])
+
+	resp2 := responseHeader(2, false).array(0).array(0).message()
+	resp1 := responseHeader(1, false).int16(35).message()
+	data = append(resp2, resp1...)
+	parse(t, kafka, private, tcp.TCPDirectionReverse, data)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Metadata", trans["method"])
+	trans = expectTransaction(t, results)
+	assert.Equal(t, "ApiVersions", trans["method"])
+	assert.Equal(t, "ApiVersions v2", trans["query"])
+	info := trans["kafka"].(common.MapStr)
+	assert.Equal(t, int16(0), info["error_code"])
+
+	before := unmatchedResponses.Get()
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp1)
+	assert.Equal(t, before+1, unmatchedResponses.Get())
+	assert.Empty(t, results.events)
+}
+
+func TestExpirePending(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+	before := unmatchedRequests.Get()
+
+	ts := time.Now()
+	var private protos.ProtocolData
+	private = parseAt(t, kafka, private, tcp.TCPDirectionOriginal,
+		requestHeader(apiMetadata, 0, 1, false).array(0).message(), ts)
+	private = parseAt(t, kafka, private, tcp.TCPDirectionOriginal,
+		requestHeader(apiMetadata, 0, 2, false).array(0).message(), ts.Add(kafka.transactionTimeout))
+	assert.Empty(t, results.events)
+
+	// the first request is not answered in time, the second one still is pending
+	private = parseAt(t, kafka, private, tcp.TCPDirectionOriginal,
+		requestHeader(apiMetadata, 0, 3, false).array(0).message(), ts.Add(kafka.transactionTimeout+time.Second))
+	assert.Equal(t, before+1, unmatchedRequests.Get())
+	assert.Len(t, private.(*kafkaConnectionData).pending, 2)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Metadata", trans["method"])
+	assert.Equal(t, int32(1), trans["kafka"].(common.MapStr)["correlation_id"])
+	assert.Empty(t, results.events)
+}
+
+func TestFlushPendingAtEndOfStream(t *testing.T) {
+	results, kafka := kafkaModForTests(nil)
+	tuple := testTCPTuple()
+
+	newPending := func() protos.ProtocolData {
+		return parse(t, kafka, nil, tcp.TCPDirectionOriginal,
+			requestHeader(apiMetadata, 0, 1, false).array(0).message())
+	}
+
+	before := unmatchedRequests.Get()
+	kafka.ReceivedFin(tuple, tcp.TCPDirectionOriginal, newPending())
+	_, drop := kafka.GapInStream(tuple, tcp.TCPDirectionOriginal, 10, newPending())
+	assert.True(t, drop)
+	kafka.Expired(tuple, newPending())
+
+	assert.Equal(t, before+3, unmatchedRequests.Get())
+	for i := 0; i < 3; i++ {
+		trans := expectTransaction(t, results)
+		assert.Equal(t, "Metadata", trans["method"])
+	}
+}
+
+func TestEventFields(t *testing.T) {
+	config := defaultConfig
+	config.Ports = []int{9092}
+	config.SendRequest = true
+	config.SendResponse = true
+	results, kafka := kafkaModForTests(&config)
+
+	requ := requestHeader(apiMetadata, 1, 1, false).array(1).string("orders").message()
+	resp := responseHeader(1, false).array(0).int32(1).array(1).
+		int16(29).string("orders").int8(0).array(0).message()
+
+	private := parse(t, kafka, nil, tcp.TCPDirectionOriginal, requ)
+	parse(t, kafka, private, tcp.TCPDirectionReverse, resp)
+
+	event := results.events[0]
+	assert.Equal(t, "Metadata v1 orders", event.Fields["request"])
+	assert.Equal(t, "TOPIC_AUTHORIZATION_FAILED", event.Fields["response"])
+
+	fields := event.Fields[pb.FieldsKey].(*pb.Fields)
+	assert.Equal(t, "kafka", fields.Network.Protocol)
+	assert.Equal(t, int64(len(requ)), fields.Source.Bytes)
+	assert.Equal(t, int64(len(resp)), fields.Destination.Bytes)
+	assert.Equal(t, "192.168.0.1", fields.Source.IP)
+	assert.Equal(t, int64(9092), fields.Destination.Port)
+}
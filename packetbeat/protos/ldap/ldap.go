@@ -0,0 +1,543 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ldap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/applayer"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+// maxParsedSize is the number of bytes buffered for parsing a message. Only
+// this prefix of bigger messages is parsed, the rest is skipped.
+const maxParsedSize = 1 << 16
+
+type stream struct {
+	applayer.Stream
+
+	// ts is the time the first segment of the current message was seen
+	ts time.Time
+	// skip is the number of bytes of a truncated message still to be skipped
+	skip int
+}
+
+type ldapConnectionData struct {
+	streams [2]*stream
+	pending map[int64]*transaction
+
+	// tls is set once StartTLS succeeded. The rest of the connection is
+	// encrypted and ignored.
+	tls bool
+}
+
+type transaction struct {
+	ts           time.Time
+	endTime      time.Time
+	tuple        common.TCPTuple
+	cmdlineTuple *common.ProcessTuple
+	dir          uint8
+
+	request  *ldapMessage
+	response *ldapMessage
+
+	// search results sent before the final response
+	entries    int
+	references int
+	bytesOut   int
+
+	// lastSeen is the time of the last message of the operation. Pending
+	// operations without messages for transaction_timeout are published
+	// without response.
+	lastSeen time.Time
+
+	// abandoned is set if the operation has been abandoned by the client
+	abandoned bool
+}
+
+// LDAP protocol plugin
+type ldapPlugin struct {
+	// config
+	ports          []int
+	sendRequest    bool
+	sendResponse   bool
+	redactPassword bool
+
+	transactionTimeout time.Duration
+
+	results protos.Reporter
+}
+
+var (
+	debugf  = logp.MakeDebug("ldap")
+	isDebug = false
+)
+
+var (
+	unmatchedResponses = monitoring.NewInt(nil, "ldap.unmatched_responses")
+	unmatchedRequests  = monitoring.NewInt(nil, "ldap.unmatched_requests")
+)
+
+func init() {
+	protos.Register("ldap", New)
+}
+
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &ldapPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (ldap *ldapPlugin) init(results protos.Reporter, config *ldapConfig) error {
+	ldap.setFromConfig(config)
+
+	ldap.results = results
+	isDebug = logp.IsDebug("ldap")
+
+	return nil
+}
+
+func (ldap *ldapPlugin) setFromConfig(config *ldapConfig) {
+	ldap.ports = config.Ports
+	ldap.sendRequest = config.SendRequest
+	ldap.sendResponse = config.SendResponse
+	ldap.redactPassword = config.RedactPassword
+	ldap.transactionTimeout = config.TransactionTimeout
+}
+
+func (ldap *ldapPlugin) GetPorts() []int {
+	return ldap.ports
+}
+
+func (ldap *ldapPlugin) ConnectionTimeout() time.Duration {
+	return ldap.transactionTimeout
+}
+
+func (ldap *ldapPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("ParseLdap exception")
+
+	conn := ensureLdapConnection(private)
+	if conn.tls {
+		return conn
+	}
+	conn = ldap.doParse(conn, pkt, tcptuple, dir)
+	if conn == nil {
+		return nil
+	}
+	return conn
+}
+
+func ensureLdapConnection(private protos.ProtocolData) *ldapConnectionData {
+	if private == nil {
+		return newConnection()
+	}
+
+	priv, ok := private.(*ldapConnectionData)
+	if !ok {
+		logp.Warn("ldap connection data type error, create new one")
+		return newConnection()
+	}
+	if priv == nil {
+		logp.Warn("Unexpected: ldap connection data not set, create new one")
+		return newConnection()
+	}
+
+	return priv
+}
+
+func newConnection() *ldapConnectionData {
+	return &ldapConnectionData{pending: map[int64]*transaction{}}
+}
+
+func (ldap *ldapPlugin) doParse(
+	conn *ldapConnectionData,
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) *ldapConnectionData {
+
+	st := conn.streams[dir]
+	if st == nil {
+		st = newStream()
+		conn.streams[dir] = st
+		if isDebug {
+			debugf("new stream: %p (dir=%v, len=%v)", st, dir, len(pkt.Payload))
+		}
+	}
+
+	if st.Buf.Len() == 0 && st.skip == 0 {
+		st.ts = pkt.Ts
+	}
+	if err := st.Append(pkt.Payload); err != nil {
+		if isDebug {
+			debugf("%v, dropping TCP stream: ", err)
+		}
+		return nil
+	}
+
+	for st.Buf.Len() > 0 && !conn.tls {
+		if st.skip > 0 {
+			n := st.skip
+			if n > st.Buf.Len() {
+				n = st.Buf.Len()
+			}
+			st.Buf.Advance(n)
+			st.Buf.Reset()
+			st.skip -= n
+			st.ts = pkt.Ts
+			continue
+		}
+
+		data := st.Buf.Bytes()
+		tag, length, headerLength, err := berHeader(data)
+		if err == nil && headerLength > 0 && tag != tagSequence {
+			err = errInvalidMessage
+		}
+		if err != nil {
+			ldap.dropStream(conn, dir, err)
+			return conn
+		}
+		if headerLength == 0 {
+			// wait for more data
+			break
+		}
+
+		total := headerLength + length
+		truncated := len(data) < total
+		if truncated && len(data) < maxParsedSize {
+			// wait for more data
+			break
+		}
+		n := total
+		if truncated {
+			n = len(data)
+		}
+
+		m, err := parseMessage(data[:n], truncated)
+		if err != nil {
+			ldap.dropStream(conn, dir, err)
+			return conn
+		}
+		m.size = total
+		if m.isRequest() {
+			ldap.handleRequest(conn, m, tcptuple, dir, st.ts)
+		} else {
+			ldap.handleResponse(conn, m, pkt.Ts)
+		}
+
+		st.Buf.Advance(n)
+		st.Buf.Reset()
+		st.skip = total - n
+		st.ts = pkt.Ts
+	}
+
+	return conn
+}
+
+func (ldap *ldapPlugin) dropStream(conn *ldapConnectionData, dir uint8, err error) {
+	// drop this tcp stream. Will retry parsing with the next segment in it
+	conn.streams[dir] = nil
+	if isDebug {
+		debugf("Ignore LDAP message (%v). Drop tcp stream. Try parsing with the next segment", err)
+	}
+}
+
+func newStream() *stream {
+	s := &stream{}
+	s.Stream.Init(tcp.TCPMaxDataInStream)
+	return s
+}
+
+func (ldap *ldapPlugin) handleRequest(
+	conn *ldapConnectionData,
+	m *ldapMessage,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) {
+	if isDebug {
+		debugf("LDAP (%p) %s request, message ID %d", conn, requestNames[m.op], m.messageID)
+	}
+
+	ldap.expirePending(conn, ts)
+
+	t := &transaction{
+		ts:           ts,
+		tuple:        *tcptuple,
+		cmdlineTuple: procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort()),
+		dir:          dir,
+		request:      m,
+		lastSeen:     ts,
+	}
+	if m.op == opAbandonRequest {
+		// the server does not respond to the abandoned operation anymore
+		if abandoned := conn.pending[m.abandonID]; abandoned != nil {
+			delete(conn.pending, m.abandonID)
+			abandoned.abandoned = true
+			ldap.publishTransaction(abandoned)
+		}
+	}
+	if m.op == opUnbindRequest || m.op == opAbandonRequest {
+		// the server does not respond to these requests
+		ldap.publishTransaction(t)
+		return
+	}
+	conn.pending[m.messageID] = t
+}
+
+// expirePending publishes the pending operations without messages for
+// transaction_timeout, without waiting for their response anymore.
+func (ldap *ldapPlugin) expirePending(conn *ldapConnectionData, ts time.Time) {
+	for id, t := range conn.pending {
+		if ts.Sub(t.lastSeen) > ldap.transactionTimeout {
+			debugf("Operation with message ID %d timed out", id)
+			delete(conn.pending, id)
+			unmatchedRequests.Add(1)
+			ldap.publishTransaction(t)
+		}
+	}
+}
+
+func (ldap *ldapPlugin) handleResponse(conn *ldapConnectionData, m *ldapMessage, ts time.Time) {
+	if m.messageID == 0 {
+		// unsolicited notifications are not related to a request
+		debugf("Unsolicited notification %s", m.name)
+		return
+	}
+
+	t := conn.pending[m.messageID]
+	if t == nil {
+		debugf("Response from unknown transaction. Ignoring")
+		unmatchedResponses.Add(1)
+		return
+	}
+
+	t.lastSeen = ts
+	switch m.op {
+	case opSearchResultEntry:
+		t.entries++
+		t.bytesOut += m.size
+		return
+	case opSearchResultRef:
+		t.references++
+		t.bytesOut += m.size
+		return
+	case opIntermediateResponse:
+		t.bytesOut += m.size
+		return
+	}
+
+	delete(conn.pending, m.messageID)
+	t.response = m
+	t.bytesOut += m.size
+	t.endTime = ts
+	ldap.publishTransaction(t)
+
+	if t.request.name == oidStartTLS && m.hasResult && m.resultCode == 0 {
+		conn.tls = true
+	}
+}
+
+func (ldap *ldapPlugin) publishTransaction(t *transaction) {
+	if ldap.results == nil {
+		return
+	}
+	ldap.results(ldap.newEvent(t))
+}
+
+func (ldap *ldapPlugin) newEvent(t *transaction) beat.Event {
+	requ, resp := t.request, t.response
+
+	source, destination := common.MakeEndpointPair(t.tuple.BaseTuple, t.cmdlineTuple)
+	src, dst := &source, &destination
+	if t.dir == tcp.TCPDirectionReverse {
+		src, dst = dst, src
+	}
+
+	evt, pbf := pb.NewBeatEvent(t.ts)
+	pbf.SetSource(src)
+	pbf.SetDestination(dst)
+	pbf.Source.Bytes = int64(requ.size)
+	pbf.Event.Dataset = "ldap"
+	pbf.Event.Start = t.ts
+	if resp != nil {
+		pbf.Destination.Bytes = int64(t.bytesOut)
+		pbf.Event.End = t.endTime
+	}
+	pbf.Network.Transport = "tcp"
+	pbf.Network.Protocol = pbf.Event.Dataset
+
+	method := requestNames[requ.op]
+	info := common.MapStr{
+		"message_id": requ.messageID,
+	}
+	if requ.truncated || (resp != nil && resp.truncated) {
+		info["truncated"] = true
+	}
+	if t.abandoned {
+		info["abandoned"] = true
+	}
+
+	var query string
+	switch requ.op {
+	case opBindRequest:
+		bind := common.MapStr{
+			"version":        requ.version,
+			"authentication": requ.authentication,
+		}
+		if requ.mechanism != "" {
+			bind["mechanism"] = requ.mechanism
+		}
+		if requ.password != "" {
+			if ldap.redactPassword {
+				bind["password"] = "xxxxx"
+			} else {
+				bind["password"] = requ.password
+			}
+		}
+		info["bind"] = bind
+		query = fmt.Sprintf("bind dn=%q auth=%s", requ.dn, requ.authentication)
+		if requ.mechanism != "" {
+			query += " mechanism=" + requ.mechanism
+		}
+	case opSearchRequest:
+		search := common.MapStr{
+			"scope":         enumName(scopeNames, requ.scope),
+			"deref_aliases": enumName(derefAliasesNames, requ.derefAliases),
+			"size_limit":    requ.sizeLimit,
+			"time_limit":    requ.timeLimit,
+			"filter":        requ.filter,
+		}
+		if len(requ.attributes) > 0 {
+			search["attributes"] = requ.attributes
+		}
+		if resp != nil {
+			search["entries"] = t.entries
+			search["references"] = t.references
+		}
+		info["search"] = search
+		query = fmt.Sprintf("search base=%q scope=%s filter=%q",
+			requ.dn, search["scope"], requ.filter)
+	case opModifyRequest:
+		info["changes"] = requ.changes
+		query = fmt.Sprintf("modify dn=%q", requ.dn)
+	case opAddRequest:
+		info["attributes"] = requ.attributes
+		query = fmt.Sprintf("add dn=%q", requ.dn)
+	case opDelRequest:
+		query = fmt.Sprintf("delete dn=%q", requ.dn)
+	case opModifyDNRequest:
+		info["new_rdn"] = requ.newRDN
+		info["delete_old_rdn"] = requ.deleteOldRDN
+		if requ.newSuperior != "" {
+			info["new_superior"] = requ.newSuperior
+		}
+		query = fmt.Sprintf("modifyDN dn=%q newrdn=%q", requ.dn, requ.newRDN)
+	case opCompareRequest:
+		info["attributes"] = requ.attributes
+		query = fmt.Sprintf("compare dn=%q", requ.dn)
+	case opAbandonRequest:
+		info["abandon_id"] = requ.abandonID
+		query = fmt.Sprintf("abandon id=%d", requ.abandonID)
+	case opExtendedRequest:
+		extended := common.MapStr{"name": requ.name}
+		if resp != nil && resp.name != "" {
+			extended["response_name"] = resp.name
+		}
+		info["extended"] = extended
+		query = "extended name=" + requ.name
+	default:
+		query = method
+	}
+
+	status := common.OK_STATUS
+	if resp != nil && resp.hasResult {
+		info["result_code"] = resp.resultCode
+		info["result"] = resultName(resp.resultCode)
+		if resp.matchedDN != "" {
+			info["matched_dn"] = resp.matchedDN
+		}
+		if resp.diagnosticMessage != "" {
+			info["diagnostic_message"] = resp.diagnosticMessage
+		}
+		if isFailure(resp.resultCode) {
+			status = common.ERROR_STATUS
+		}
+	}
+
+	fields := evt.Fields
+	fields["type"] = pbf.Event.Dataset
+	fields["status"] = status
+	fields["method"] = method
+	fields["path"] = requ.dn
+	fields["query"] = query
+	fields["ldap"] = info
+
+	if ldap.sendRequest {
+		fields["request"] = query
+	}
+	if ldap.sendResponse && resp != nil && resp.hasResult {
+		response := resultName(resp.resultCode)
+		if resp.diagnosticMessage != "" {
+			response += ": " + resp.diagnosticMessage
+		}
+		fields["response"] = response
+	}
+
+	return evt
+}
+
+func (ldap *ldapPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
+	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool) {
+
+	// the message boundaries are lost with the missing bytes
+	return private, true
+}
+
+func (ldap *ldapPlugin) ReceivedFin(tcptuple *common.TCPTuple, dir uint8,
+	private protos.ProtocolData) protos.ProtocolData {
+
+	return private
+}
@@ -0,0 +1,578 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ldap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Application tags of the LDAP protocol operations (RFC 4511).
+const (
+	opBindRequest          = 0
+	opBindResponse         = 1
+	opUnbindRequest        = 2
+	opSearchRequest        = 3
+	opSearchResultEntry    = 4
+	opSearchResultDone     = 5
+	opModifyRequest        = 6
+	opModifyResponse       = 7
+	opAddRequest           = 8
+	opAddResponse          = 9
+	opDelRequest           = 10
+	opDelResponse          = 11
+	opModifyDNRequest      = 12
+	opModifyDNResponse     = 13
+	opCompareRequest       = 14
+	opCompareResponse      = 15
+	opAbandonRequest       = 16
+	opSearchResultRef      = 19
+	opExtendedRequest      = 23
+	opExtendedResponse     = 24
+	opIntermediateResponse = 25
+)
+
+// BER identifier octets
+const (
+	classApplication = 0x40
+	classContext     = 0x80
+	constructed      = 0x20
+
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x30
+	tagSet         = 0x31
+)
+
+// maxFilterDepth limits the nesting of search filters.
+const maxFilterDepth = 64
+
+// oidStartTLS is the name of the StartTLS extended operation.
+const oidStartTLS = "1.3.6.1.4.1.1466.20037"
+
+var (
+	errInvalidMessage = errors.New("invalid LDAP message")
+	errInvalidLength  = errors.New("invalid BER length")
+)
+
+// requestNames maps the request operations to the method reported in the
+// events.
+var requestNames = map[int]string{
+	opBindRequest:     "bind",
+	opUnbindRequest:   "unbind",
+	opSearchRequest:   "search",
+	opModifyRequest:   "modify",
+	opAddRequest:      "add",
+	opDelRequest:      "delete",
+	opModifyDNRequest: "modifyDN",
+	opCompareRequest:  "compare",
+	opAbandonRequest:  "abandon",
+	opExtendedRequest: "extended",
+}
+
+var resultNames = map[int64]string{
+	0:   "success",
+	1:   "operationsError",
+	2:   "protocolError",
+	3:   "timeLimitExceeded",
+	4:   "sizeLimitExceeded",
+	5:   "compareFalse",
+	6:   "compareTrue",
+	7:   "authMethodNotSupported",
+	8:   "strongerAuthRequired",
+	10:  "referral",
+	11:  "adminLimitExceeded",
+	12:  "unavailableCriticalExtension",
+	13:  "confidentialityRequired",
+	14:  "saslBindInProgress",
+	16:  "noSuchAttribute",
+	17:  "undefinedAttributeType",
+	18:  "inappropriateMatching",
+	19:  "constraintViolation",
+	20:  "attributeOrValueExists",
+	21:  "invalidAttributeSyntax",
+	32:  "noSuchObject",
+	33:  "aliasProblem",
+	34:  "invalidDNSyntax",
+	36:  "aliasDereferencingProblem",
+	48:  "inappropriateAuthentication",
+	49:  "invalidCredentials",
+	50:  "insufficientAccessRights",
+	51:  "busy",
+	52:  "unavailable",
+	53:  "unwillingToPerform",
+	54:  "loopDetect",
+	64:  "namingViolation",
+	65:  "objectClassViolation",
+	66:  "notAllowedOnNonLeaf",
+	67:  "notAllowedOnRDN",
+	68:  "entryAlreadyExists",
+	69:  "objectClassModsProhibited",
+	71:  "affectsMultipleDSAs",
+	80:  "other",
+	118: "canceled",
+	119: "noSuchOperation",
+	120: "tooLate",
+	121: "cannotCancel",
+	122: "assertionFailed",
+	123: "authorizationDenied",
+}
+
+var scopeNames = []string{"base", "one", "sub", "children"}
+
+var derefAliasesNames = []string{"never", "searching", "finding", "always"}
+
+// filterOperators maps the tags of the attribute value assertion filters to
+// their operator.
+var filterOperators = map[byte]string{3: "=", 5: ">=", 6: "<=", 8: "~="}
+
+var modifyOperationNames = []string{"add", "delete", "replace", "increment"}
+
+func resultName(code int64) string {
+	if name, found := resultNames[code]; found {
+		return name
+	}
+	return "unknown"
+}
+
+// isFailure returns true if the result code reports a failed operation.
+func isFailure(code int64) bool {
+	switch code {
+	case 0, 5, 6, 10, 14:
+		return false
+	}
+	return true
+}
+
+func enumName(names []string, v int64) string {
+	if v >= 0 && v < int64(len(names)) {
+		return names[v]
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+type ldapMessage struct {
+	size      int  // size of the complete message
+	truncated bool // only a prefix of the message has been parsed
+
+	messageID int64
+	op        int
+
+	// request fields
+	dn             string
+	version        int64
+	authentication string
+	mechanism      string
+	password       string
+	scope          int64
+	derefAliases   int64
+	sizeLimit      int64
+	timeLimit      int64
+	filter         string
+	attributes     []string
+	changes        []string
+	newRDN         string
+	newSuperior    string
+	deleteOldRDN   bool
+	abandonID      int64
+	name           string // name of an extended operation
+
+	// response fields
+	hasResult         bool
+	resultCode        int64
+	matchedDN         string
+	diagnosticMessage string
+}
+
+func (m *ldapMessage) isRequest() bool {
+	_, found := requestNames[m.op]
+	return found
+}
+
+// berHeader parses the identifier and length octets at the beginning of
+// data. The returned header length is 0 if data does not contain the
+// complete header.
+func berHeader(data []byte) (tag byte, length int, headerLength int, err error) {
+	if len(data) < 2 {
+		return 0, 0, 0, nil
+	}
+	tag = data[0]
+	if tag&0x1f == 0x1f {
+		// LDAP does not use the high tag number form
+		return 0, 0, 0, errInvalidMessage
+	}
+
+	n := int(data[1])
+	if n < 0x80 {
+		return tag, n, 2, nil
+	}
+
+	// long form, the indefinite form is not allowed in LDAP
+	n &= 0x7f
+	if n == 0 || n > 4 {
+		return 0, 0, 0, errInvalidLength
+	}
+	if len(data) < 2+n {
+		return 0, 0, 0, nil
+	}
+	length = 0
+	for _, b := range data[2 : 2+n] {
+		length = length<<8 | int(b)
+	}
+	if length < 0 {
+		return 0, 0, 0, errInvalidLength
+	}
+	return tag, length, 2 + n, nil
+}
+
+// parseMessage parses an LDAPMessage. If the message is truncated, the
+// values cut off are not reported.
+func parseMessage(data []byte, truncated bool) (*ldapMessage, error) {
+	r := &berReader{buf: data, partial: truncated}
+	m := &ldapMessage{truncated: truncated}
+
+	msg := r.expect(tagSequence)
+	m.messageID = msg.integer(tagInteger)
+	tag, op := msg.next()
+	if msg.err || tag&0xc0 != classApplication {
+		return nil, errInvalidMessage
+	}
+	m.op = int(tag & 0x1f)
+
+	switch m.op {
+	case opBindRequest:
+		parseBindRequest(op, m)
+	case opUnbindRequest:
+	case opSearchRequest:
+		parseSearchRequest(op, m)
+	case opModifyRequest:
+		parseModifyRequest(op, m)
+	case opAddRequest:
+		parseAddRequest(op, m)
+	case opDelRequest:
+		// the DN is the value of the primitive operation
+		m.dn = string(op.buf)
+	case opModifyDNRequest:
+		parseModifyDNRequest(op, m)
+	case opCompareRequest:
+		parseCompareRequest(op, m)
+	case opAbandonRequest:
+		m.abandonID = parseInteger(op.buf)
+	case opExtendedRequest:
+		m.name = string(op.expect(classContext | 0).buf)
+	case opBindResponse, opSearchResultDone, opModifyResponse, opAddResponse,
+		opDelResponse, opModifyDNResponse, opCompareResponse:
+		parseResult(op, m)
+	case opExtendedResponse:
+		parseResult(op, m)
+		for !op.empty() {
+			if tag, value := op.next(); tag == classContext|10 {
+				m.name = string(value.buf)
+			}
+		}
+	case opSearchResultEntry, opSearchResultRef, opIntermediateResponse:
+		// only counted
+	default:
+		return nil, errInvalidMessage
+	}
+	return m, nil
+}
+
+func parseBindRequest(r *berReader, m *ldapMessage) {
+	m.version = r.integer(tagInteger)
+	m.dn = r.string()
+
+	tag, auth := r.next()
+	switch tag {
+	case classContext | 0:
+		m.authentication = "simple"
+		m.password = string(auth.buf)
+	case classContext | constructed | 3:
+		m.authentication = "sasl"
+		m.mechanism = auth.string()
+	}
+}
+
+func parseSearchRequest(r *berReader, m *ldapMessage) {
+	m.dn = r.string()
+	m.scope = r.integer(tagEnumerated)
+	m.derefAliases = r.integer(tagEnumerated)
+	m.sizeLimit = r.integer(tagInteger)
+	m.timeLimit = r.integer(tagInteger)
+	r.expect(tagBoolean) // typesOnly
+	if r.err {
+		return
+	}
+
+	var sb strings.Builder
+	if err := writeFilter(&sb, r, 0); err != nil {
+		return
+	}
+	m.filter = sb.String()
+
+	attrs := r.expect(tagSequence)
+	for !attrs.empty() {
+		attr := attrs.string()
+		if attrs.err {
+			break
+		}
+		m.attributes = append(m.attributes, attr)
+	}
+}
+
+func parseModifyRequest(r *berReader, m *ldapMessage) {
+	m.dn = r.string()
+	changes := r.expect(tagSequence)
+	for !changes.empty() {
+		change := changes.expect(tagSequence)
+		operation := change.integer(tagEnumerated)
+		attr := change.expect(tagSequence).string()
+		if change.err {
+			break
+		}
+		m.changes = append(m.changes, enumName(modifyOperationNames, operation)+": "+attr)
+	}
+}
+
+func parseAddRequest(r *berReader, m *ldapMessage) {
+	m.dn = r.string()
+	attrs := r.expect(tagSequence)
+	for !attrs.empty() {
+		attr := attrs.expect(tagSequence).string()
+		if attrs.err {
+			break
+		}
+		m.attributes = append(m.attributes, attr)
+	}
+}
+
+func parseModifyDNRequest(r *berReader, m *ldapMessage) {
+	m.dn = r.string()
+	m.newRDN = r.string()
+	m.deleteOldRDN = r.integer(tagBoolean) != 0
+	if tag, value := r.next(); tag == classContext|0 {
+		m.newSuperior = string(value.buf)
+	}
+}
+
+func parseCompareRequest(r *berReader, m *ldapMessage) {
+	m.dn = r.string()
+	ava := r.expect(tagSequence)
+	if attr := ava.string(); !ava.err {
+		m.attributes = []string{attr}
+	}
+}
+
+func parseResult(r *berReader, m *ldapMessage) {
+	code := r.integer(tagEnumerated)
+	if r.err {
+		return
+	}
+	m.hasResult = true
+	m.resultCode = code
+	m.matchedDN = r.string()
+	m.diagnosticMessage = r.string()
+}
+
+// writeFilter writes the string representation (RFC 4515) of the next filter
+// in r.
+func writeFilter(sb *strings.Builder, r *berReader, depth int) error {
+	if depth > maxFilterDepth {
+		return errInvalidMessage
+	}
+
+	tag, f := r.next()
+	if r.err {
+		return errInvalidMessage
+	}
+
+	sb.WriteByte('(')
+	switch tag {
+	case classContext | constructed | 0, classContext | constructed | 1:
+		if tag&0x1f == 0 {
+			sb.WriteByte('&')
+		} else {
+			sb.WriteByte('|')
+		}
+		for !f.empty() {
+			if err := writeFilter(sb, f, depth+1); err != nil {
+				return err
+			}
+		}
+	case classContext | constructed | 2:
+		sb.WriteByte('!')
+		if err := writeFilter(sb, f, depth+1); err != nil {
+			return err
+		}
+	case classContext | constructed | 3, classContext | constructed | 5,
+		classContext | constructed | 6, classContext | constructed | 8:
+		attr, value := f.string(), f.string()
+		sb.WriteString(attr)
+		sb.WriteString(filterOperators[tag&0x1f])
+		writeValue(sb, value)
+	case classContext | constructed | 4:
+		sb.WriteString(f.string())
+		sb.WriteByte('=')
+		substrings := f.expect(tagSequence)
+		final := false
+		for i := 0; !substrings.empty(); i++ {
+			tag, value := substrings.next()
+			if tag != classContext|0 || i > 0 {
+				sb.WriteByte('*')
+			}
+			writeValue(sb, string(value.buf))
+			final = tag == classContext|2
+		}
+		if !final {
+			sb.WriteByte('*')
+		}
+		if substrings.err {
+			return errInvalidMessage
+		}
+	case classContext | 7:
+		sb.WriteString(string(f.buf))
+		sb.WriteString("=*")
+	case classContext | constructed | 9:
+		var rule, attr, value string
+		dnAttributes := false
+		for !f.empty() {
+			tag, v := f.next()
+			switch tag {
+			case classContext | 1:
+				rule = string(v.buf)
+			case classContext | 2:
+				attr = string(v.buf)
+			case classContext | 3:
+				value = string(v.buf)
+			case classContext | 4:
+				dnAttributes = parseInteger(v.buf) != 0
+			}
+		}
+		sb.WriteString(attr)
+		if dnAttributes {
+			sb.WriteString(":dn")
+		}
+		if rule != "" {
+			sb.WriteByte(':')
+			sb.WriteString(rule)
+		}
+		sb.WriteString(":=")
+		writeValue(sb, value)
+	default:
+		return errInvalidMessage
+	}
+	sb.WriteByte(')')
+
+	if f.err {
+		return errInvalidMessage
+	}
+	return nil
+}
+
+// writeValue writes an assertion value, escaping the characters not allowed
+// in a filter string.
+func writeValue(sb *strings.Builder, value string) {
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '*', '(', ')', '\\', 0:
+			fmt.Fprintf(sb, "\\%02x", c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
+
+func parseInteger(b []byte) int64 {
+	if len(b) == 0 || len(b) > 8 {
+		return 0
+	}
+	// sign extension of the two's complement value
+	v := int64(int8(b[0]))
+	for _, c := range b[1:] {
+		v = v<<8 | int64(c)
+	}
+	return v
+}
+
+// berReader reads the BER encoded elements of a constructed value. Reading
+// an invalid or missing element sets err, after which all reads return zero
+// values. If partial is set, the last element may be cut off.
+type berReader struct {
+	buf     []byte
+	partial bool
+	err     bool
+}
+
+func (r *berReader) empty() bool {
+	return r.err || len(r.buf) == 0
+}
+
+// next returns the tag and a reader for the value of the next element.
+func (r *berReader) next() (byte, *berReader) {
+	value := &berReader{partial: r.partial}
+	if r.err {
+		value.err = true
+		return 0, value
+	}
+
+	tag, length, n, err := berHeader(r.buf)
+	if err != nil || n == 0 || (length > len(r.buf)-n && !r.partial) {
+		r.err = true
+		value.err = true
+		return 0, value
+	}
+
+	end := n + length
+	if end > len(r.buf) {
+		end = len(r.buf)
+	}
+	value.buf = r.buf[n:end]
+	r.buf = r.buf[end:]
+	return tag, value
+}
+
+// expect returns a reader for the value of the next element, which must
+// have the given tag.
+func (r *berReader) expect(tag byte) *berReader {
+	t, value := r.next()
+	if t != tag {
+		r.err = true
+		value.err = true
+	}
+	return value
+}
+
+func (r *berReader) integer(tag byte) int64 {
+	value := r.expect(tag)
+	if value.err {
+		return 0
+	}
+	return parseInteger(value.buf)
+}
+
+func (r *berReader) string() string {
+	value := r.expect(tagOctetString)
+	if value.err {
+		return ""
+	}
+	return string(value.buf)
+}
@@ -0,0 +1,588 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package ldap
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+// Helper function returning a LDAP module that can be used in tests. It
+// publishes the transactions in the event store.
+func ldapModForTests(config *ldapConfig) (*eventStore, *ldapPlugin) {
+	var ldap ldapPlugin
+	results := &eventStore{}
+	if config == nil {
+		config = &ldapConfig{}
+		*config = defaultConfig
+	}
+	ldap.init(results.publish, config)
+	return results, &ldap
+}
+
+// Helper function that returns an example TcpTuple
+func testTCPTuple() *common.TCPTuple {
+	t := &common.TCPTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 6512, DstPort: 389,
+		},
+	}
+	t.ComputeHashables()
+	return t
+}
+
+// tlv encodes a BER element.
+func tlv(tag byte, values ...[]byte) []byte {
+	var value []byte
+	for _, v := range values {
+		value = append(value, v...)
+	}
+
+	data := []byte{tag}
+	switch n := len(value); {
+	case n < 0x80:
+		data = append(data, byte(n))
+	case n < 0x100:
+		data = append(data, 0x81, byte(n))
+	case n < 0x10000:
+		data = append(data, 0x82, byte(n>>8), byte(n))
+	default:
+		data = append(data, 0x83, byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(data, value...)
+}
+
+func str(tag byte, s string) []byte {
+	return tlv(tag, []byte(s))
+}
+
+func octets(s string) []byte {
+	return str(tagOctetString, s)
+}
+
+func integer(tag byte, v int) []byte {
+	if v < 0x80 {
+		return tlv(tag, []byte{byte(v)})
+	}
+	return tlv(tag, []byte{byte(v >> 8), byte(v)})
+}
+
+func message(id int, op []byte) []byte {
+	return tlv(tagSequence, integer(tagInteger, id), op)
+}
+
+func result(op byte, code int, diagnostic string) []byte {
+	return tlv(classApplication|constructed|op,
+		integer(tagEnumerated, code), octets(""), octets(diagnostic))
+}
+
+func equality(attr, value string) []byte {
+	return tlv(classContext|constructed|3, octets(attr), octets(value))
+}
+
+func searchRequest(id int, base string, filter []byte, attrs ...string) []byte {
+	var attributes [][]byte
+	for _, attr := range attrs {
+		attributes = append(attributes, octets(attr))
+	}
+	return message(id, tlv(classApplication|constructed|opSearchRequest,
+		octets(base),
+		integer(tagEnumerated, 2),
+		integer(tagEnumerated, 0),
+		integer(tagInteger, 100),
+		integer(tagInteger, 0),
+		tlv(tagBoolean, []byte{0}),
+		filter,
+		tlv(tagSequence, attributes...),
+	))
+}
+
+func searchEntry(id int, dn string) []byte {
+	return message(id, tlv(classApplication|constructed|opSearchResultEntry,
+		octets(dn),
+		tlv(tagSequence, tlv(tagSequence, octets("cn"), tlv(tagSet, octets(dn)))),
+	))
+}
+
+func parse(t *testing.T, ldap *ldapPlugin, private protos.ProtocolData, dir uint8, data []byte) protos.ProtocolData {
+	return parseAt(t, ldap, private, dir, data, time.Now())
+}
+
+func parseAt(t *testing.T, ldap *ldapPlugin, private protos.ProtocolData, dir uint8, data []byte, ts time.Time) protos.ProtocolData {
+	pkt := &protos.Packet{Ts: ts, Payload: data}
+	private = ldap.Parse(pkt, testTCPTuple(), dir, private)
+	if !assert.NotNil(t, private, "connection dropped") {
+		t.FailNow()
+	}
+	return private
+}
+
+// Helper function to read from the results Queue. Raises
+// an error if nothing is found in the queue.
+func expectTransaction(t *testing.T, e *eventStore) common.MapStr {
+	if len(e.events) == 0 {
+		t.Fatal("No transaction")
+	}
+
+	event := e.events[0]
+	e.events = e.events[1:]
+	return event.Fields
+}
+
+func TestBerHeader(t *testing.T) {
+	tests := []struct {
+		data         []byte
+		tag          byte
+		length       int
+		headerLength int
+		err          error
+	}{
+		{[]byte{0x30}, 0, 0, 0, nil},
+		{[]byte{0x30, 0x05}, 0x30, 5, 2, nil},
+		{[]byte{0x30, 0x82, 0x01}, 0, 0, 0, nil},
+		{[]byte{0x30, 0x82, 0x01, 0x00}, 0x30, 256, 4, nil},
+		{[]byte{0x30, 0x80}, 0, 0, 0, errInvalidLength},
+		{[]byte{0x30, 0x85, 1, 2, 3, 4, 5}, 0, 0, 0, errInvalidLength},
+		{[]byte{0x1f, 0x01}, 0, 0, 0, errInvalidMessage},
+	}
+
+	for _, test := range tests {
+		tag, length, headerLength, err := berHeader(test.data)
+		assert.Equal(t, test.err, err, "%x", test.data)
+		assert.Equal(t, test.tag, tag, "%x", test.data)
+		assert.Equal(t, test.length, length, "%x", test.data)
+		assert.Equal(t, test.headerLength, headerLength, "%x", test.data)
+	}
+}
+
+func TestParseInteger(t *testing.T) {
+	assert.Equal(t, int64(0), parseInteger(nil))
+	assert.Equal(t, int64(127), parseInteger([]byte{0x7f}))
+	assert.Equal(t, int64(128), parseInteger([]byte{0x00, 0x80}))
+	assert.Equal(t, int64(-1), parseInteger([]byte{0xff}))
+	assert.Equal(t, int64(-129), parseInteger([]byte{0xff, 0x7f}))
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		filter   []byte
+		expected string
+	}{
+		{equality("uid", "jdoe"), "(uid=jdoe)"},
+		{equality("cn", "a*(b)\\"), `(cn=a\2a\28b\29\5c)`},
+		{str(classContext|7, "objectClass"), "(objectClass=*)"},
+		{
+			tlv(classContext|constructed|0,
+				equality("objectClass", "person"),
+				tlv(classContext|constructed|1,
+					tlv(classContext|constructed|5, octets("age"), octets("18")),
+					tlv(classContext|constructed|2, equality("mail", "")),
+				),
+			),
+			"(&(objectClass=person)(|(age>=18)(!(mail=))))",
+		},
+		{
+			tlv(classContext|constructed|4, octets("cn"), tlv(tagSequence,
+				str(classContext|0, "jo"), str(classContext|1, "h"), str(classContext|2, "n"))),
+			"(cn=jo*h*n)",
+		},
+		{
+			tlv(classContext|constructed|4, octets("cn"), tlv(tagSequence,
+				str(classContext|1, "oh"))),
+			"(cn=*oh*)",
+		},
+		{
+			tlv(classContext|constructed|9,
+				str(classContext|1, "caseExactMatch"),
+				str(classContext|2, "cn"),
+				str(classContext|3, "Fred"),
+				tlv(classContext|4, []byte{0xff})),
+			"(cn:dn:caseExactMatch:=Fred)",
+		},
+	}
+
+	for _, test := range tests {
+		var sb strings.Builder
+		r := &berReader{buf: test.filter}
+		if assert.NoError(t, writeFilter(&sb, r, 0)) {
+			assert.Equal(t, test.expected, sb.String())
+		}
+	}
+
+	// deeply nested filters are rejected
+	filter := equality("cn", "x")
+	for i := 0; i <= maxFilterDepth; i++ {
+		filter = tlv(classContext|constructed|2, filter)
+	}
+	var sb strings.Builder
+	assert.Error(t, writeFilter(&sb, &berReader{buf: filter}, 0))
+}
+
+func TestBind(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	requ := message(1, tlv(classApplication|constructed|opBindRequest,
+		integer(tagInteger, 3),
+		octets("cn=admin,dc=example,dc=org"),
+		str(classContext|0, "secret"),
+	))
+	resp := message(1, result(opBindResponse, 49, "invalid credentials"))
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	parse(t, ldap, private, tcp.TCPDirectionReverse, resp)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "ldap", trans["type"])
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	assert.Equal(t, "bind", trans["method"])
+	assert.Equal(t, "cn=admin,dc=example,dc=org", trans["path"])
+	assert.Equal(t, `bind dn="cn=admin,dc=example,dc=org" auth=simple`, trans["query"])
+
+	info := trans["ldap"].(common.MapStr)
+	assert.Equal(t, int64(1), info["message_id"])
+	assert.Equal(t, int64(49), info["result_code"])
+	assert.Equal(t, "invalidCredentials", info["result"])
+	assert.Equal(t, "invalid credentials", info["diagnostic_message"])
+	assert.Equal(t, common.MapStr{
+		"version":        int64(3),
+		"authentication": "simple",
+		"password":       "xxxxx",
+	}, info["bind"])
+}
+
+func TestBindPassword(t *testing.T) {
+	requ := message(1, tlv(classApplication|constructed|opBindRequest,
+		integer(tagInteger, 3),
+		octets("cn=admin,dc=example,dc=org"),
+		str(classContext|0, "secret"),
+	))
+	anonymous := message(2, tlv(classApplication|constructed|opBindRequest,
+		integer(tagInteger, 3), octets(""), str(classContext|0, "")))
+
+	config := defaultConfig
+	config.RedactPassword = false
+	results, ldap := ldapModForTests(&config)
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	private = parse(t, ldap, private, tcp.TCPDirectionReverse, message(1, result(opBindResponse, 0, "")))
+	private = parse(t, ldap, private, tcp.TCPDirectionOriginal, anonymous)
+	parse(t, ldap, private, tcp.TCPDirectionReverse, message(2, result(opBindResponse, 0, "")))
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	bind := trans["ldap"].(common.MapStr)["bind"].(common.MapStr)
+	assert.Equal(t, "secret", bind["password"])
+
+	trans = expectTransaction(t, results)
+	bind = trans["ldap"].(common.MapStr)["bind"].(common.MapStr)
+	assert.Nil(t, bind["password"])
+}
+
+func TestSaslBind(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	requ := message(1, tlv(classApplication|constructed|opBindRequest,
+		integer(tagInteger, 3),
+		octets(""),
+		tlv(classContext|constructed|3, octets("GSSAPI"), octets("token")),
+	))
+	resp := message(1, result(opBindResponse, 14, ""))
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	parse(t, ldap, private, tcp.TCPDirectionReverse, resp)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	assert.Equal(t, `bind dn="" auth=sasl mechanism=GSSAPI`, trans["query"])
+	info := trans["ldap"].(common.MapStr)
+	assert.Equal(t, common.MapStr{
+		"version":        int64(3),
+		"authentication": "sasl",
+		"mechanism":      "GSSAPI",
+	}, info["bind"])
+	assert.Equal(t, "saslBindInProgress", info["result"])
+}
+
+func TestSearch(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	requ := searchRequest(2, "dc=example,dc=org", equality("uid", "jdoe"), "cn", "mail")
+	var resp []byte
+	resp = append(resp, searchEntry(2, "uid=jdoe,ou=people,dc=example,dc=org")...)
+	resp = append(resp, searchEntry(2, "uid=jdoe,ou=staff,dc=example,dc=org")...)
+	resp = append(resp, message(2, str(classApplication|constructed|opSearchResultRef,
+		"ldap://other/dc=example,dc=org"))...)
+	resp = append(resp, message(2, result(opSearchResultDone, 0, ""))...)
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	// the responses are split across segments
+	private = parse(t, ldap, private, tcp.TCPDirectionReverse, resp[:20])
+	assert.Empty(t, results.events)
+	parse(t, ldap, private, tcp.TCPDirectionReverse, resp[20:])
+
+	event := results.events[0]
+	trans := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	assert.Equal(t, "search", trans["method"])
+	assert.Equal(t, "dc=example,dc=org", trans["path"])
+	assert.Equal(t, `search base="dc=example,dc=org" scope=sub filter="(uid=jdoe)"`, trans["query"])
+
+	info := trans["ldap"].(common.MapStr)
+	assert.Equal(t, common.MapStr{
+		"scope":         "sub",
+		"deref_aliases": "never",
+		"size_limit":    int64(100),
+		"time_limit":    int64(0),
+		"filter":        "(uid=jdoe)",
+		"attributes":    []string{"cn", "mail"},
+		"entries":       2,
+		"references":    1,
+	}, info["search"])
+	assert.Equal(t, "success", info["result"])
+
+	fields := event.Fields[pb.FieldsKey].(*pb.Fields)
+	assert.Equal(t, int64(len(requ)), fields.Source.Bytes)
+	assert.Equal(t, int64(len(resp)), fields.Destination.Bytes)
+	assert.Equal(t, "ldap", fields.Network.Protocol)
+}
+
+func TestSearchLargeEntry(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	requ := searchRequest(3, "dc=example,dc=org", str(classContext|7, "objectClass"))
+	entry := message(3, tlv(classApplication|constructed|opSearchResultEntry,
+		octets("cn=photo,dc=example,dc=org"),
+		tlv(tagSequence, tlv(tagSequence, octets("jpegPhoto"),
+			tlv(tagSet, tlv(tagOctetString, make([]byte, 3*maxParsedSize))))),
+	))
+	data := append(entry, message(3, result(opSearchResultDone, 0, ""))...)
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	for len(data) > 0 {
+		n := 1460
+		if n > len(data) {
+			n = len(data)
+		}
+		private = parse(t, ldap, private, tcp.TCPDirectionReverse, data[:n])
+		data = data[n:]
+	}
+
+	trans := expectTransaction(t, results)
+	info := trans["ldap"].(common.MapStr)
+	assert.Equal(t, 1, info["search"].(common.MapStr)["entries"])
+	assert.Nil(t, info["truncated"])
+	assert.Equal(t, "(objectClass=*)", info["search"].(common.MapStr)["filter"])
+}
+
+func TestModifyAddDelete(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	modify := message(4, tlv(classApplication|constructed|opModifyRequest,
+		octets("uid=jdoe,dc=example,dc=org"),
+		tlv(tagSequence,
+			tlv(tagSequence, integer(tagEnumerated, 2),
+				tlv(tagSequence, octets("mail"), tlv(tagSet, octets("jdoe@example.org")))),
+			tlv(tagSequence, integer(tagEnumerated, 1),
+				tlv(tagSequence, octets("telephoneNumber"), tlv(tagSet))),
+		),
+	))
+	add := message(5, tlv(classApplication|constructed|opAddRequest,
+		octets("uid=new,dc=example,dc=org"),
+		tlv(tagSequence,
+			tlv(tagSequence, octets("objectClass"), tlv(tagSet, octets("person"))),
+			tlv(tagSequence, octets("cn"), tlv(tagSet, octets("New"))),
+		),
+	))
+	del := message(6, str(classApplication|opDelRequest, "uid=old,dc=example,dc=org"))
+
+	var requests, responses []byte
+	requests = append(append(append(requests, modify...), add...), del...)
+	responses = append(responses, message(5, result(opAddResponse, 68, ""))...)
+	responses = append(responses, message(4, result(opModifyResponse, 0, ""))...)
+	responses = append(responses, message(6, result(opDelResponse, 32, ""))...)
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requests)
+	parse(t, ldap, private, tcp.TCPDirectionReverse, responses)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "add", trans["method"])
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	info := trans["ldap"].(common.MapStr)
+	assert.Equal(t, []string{"objectClass", "cn"}, info["attributes"])
+	assert.Equal(t, "entryAlreadyExists", info["result"])
+
+	trans = expectTransaction(t, results)
+	assert.Equal(t, "modify", trans["method"])
+	assert.Equal(t, `modify dn="uid=jdoe,dc=example,dc=org"`, trans["query"])
+	info = trans["ldap"].(common.MapStr)
+	assert.Equal(t, []string{"replace: mail", "delete: telephoneNumber"}, info["changes"])
+
+	trans = expectTransaction(t, results)
+	assert.Equal(t, "delete", trans["method"])
+	assert.Equal(t, "uid=old,dc=example,dc=org", trans["path"])
+	assert.Equal(t, "noSuchObject", trans["ldap"].(common.MapStr)["result"])
+}
+
+func TestStartTLS(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	requ := message(1, tlv(classApplication|constructed|opExtendedRequest,
+		str(classContext|0, oidStartTLS)))
+	resp := message(1, tlv(classApplication|constructed|opExtendedResponse,
+		integer(tagEnumerated, 0), octets(""), octets(""),
+		str(classContext|10, oidStartTLS)))
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	private = parse(t, ldap, private, tcp.TCPDirectionReverse, resp)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "extended", trans["method"])
+	assert.Equal(t, "extended name="+oidStartTLS, trans["query"])
+	info := trans["ldap"].(common.MapStr)
+	assert.Equal(t, common.MapStr{
+		"name":          oidStartTLS,
+		"response_name": oidStartTLS,
+	}, info["extended"])
+
+	// the TLS handshake is not parsed as LDAP
+	private = parse(t, ldap, private, tcp.TCPDirectionOriginal, []byte{0x16, 0x03, 0x01, 0x02, 0x00})
+	assert.True(t, private.(*ldapConnectionData).tls)
+	assert.Empty(t, results.events)
+}
+
+func TestUnbindAndUnmatched(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	before := unmatchedResponses.Get()
+	private := parse(t, ldap, nil, tcp.TCPDirectionReverse, message(9, result(opBindResponse, 0, "")))
+	assert.Equal(t, before+1, unmatchedResponses.Get())
+
+	// unsolicited notice of disconnection
+	notice := message(0, tlv(classApplication|constructed|opExtendedResponse,
+		integer(tagEnumerated, 52), octets(""), octets("shutting down"),
+		str(classContext|10, "1.3.6.1.4.1.1466.20036")))
+	private = parse(t, ldap, private, tcp.TCPDirectionReverse, notice)
+	assert.Empty(t, results.events)
+
+	parse(t, ldap, private, tcp.TCPDirectionOriginal, message(10, tlv(classApplication|opUnbindRequest)))
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "unbind", trans["method"])
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+}
+
+func TestAbandon(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal,
+		searchRequest(2, "dc=example,dc=org", equality("uid", "jdoe")))
+	private = parse(t, ldap, private, tcp.TCPDirectionOriginal,
+		message(3, tlv(classApplication|opAbandonRequest, []byte{2})))
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "search", trans["method"])
+	abandoned, _ := trans.GetValue("ldap.abandoned")
+	assert.Equal(t, true, abandoned)
+
+	trans = expectTransaction(t, results)
+	assert.Equal(t, "abandon", trans["method"])
+	abandonID, _ := trans.GetValue("ldap.abandon_id")
+	assert.Equal(t, int64(2), abandonID)
+
+	// a response sent before the server processed the abandon request
+	before := unmatchedResponses.Get()
+	parse(t, ldap, private, tcp.TCPDirectionReverse, message(2, result(opSearchResultDone, 0, "")))
+	assert.Equal(t, before+1, unmatchedResponses.Get())
+	assert.Empty(t, results.events)
+}
+
+func TestExpirePending(t *testing.T) {
+	results, ldap := ldapModForTests(nil)
+
+	start := time.Now()
+	private := parseAt(t, ldap, nil, tcp.TCPDirectionOriginal,
+		searchRequest(2, "dc=example,dc=org", equality("uid", "jdoe")), start)
+
+	// search results keep the operation from expiring
+	ts := start.Add(ldap.transactionTimeout)
+	private = parseAt(t, ldap, private, tcp.TCPDirectionReverse, searchEntry(2, "uid=jdoe"), ts)
+	ts = ts.Add(ldap.transactionTimeout)
+	private = parseAt(t, ldap, private, tcp.TCPDirectionOriginal,
+		searchRequest(3, "dc=example,dc=org", equality("uid", "admin")), ts)
+	assert.Len(t, private.(*ldapConnectionData).pending, 2)
+
+	before := unmatchedRequests.Get()
+	ts = ts.Add(ldap.transactionTimeout + time.Second)
+	private = parseAt(t, ldap, private, tcp.TCPDirectionOriginal,
+		searchRequest(4, "dc=example,dc=org", equality("uid", "guest")), ts)
+	assert.Equal(t, before+2, unmatchedRequests.Get())
+	assert.Len(t, private.(*ldapConnectionData).pending, 1)
+
+	// the expired operations are published without response
+	assert.Len(t, results.events, 2)
+	for _, event := range results.events {
+		_, err := event.GetValue("ldap.result_code")
+		assert.Error(t, err)
+	}
+}
+
+func TestSendRequestResponse(t *testing.T) {
+	config := defaultConfig
+	config.SendRequest = true
+	config.SendResponse = true
+	results, ldap := ldapModForTests(&config)
+
+	requ := searchRequest(2, "dc=example,dc=org", equality("uid", "jdoe"))
+	resp := message(2, result(opSearchResultDone, 50, "no read access"))
+	private := parse(t, ldap, nil, tcp.TCPDirectionOriginal, requ)
+	parse(t, ldap, private, tcp.TCPDirectionReverse, resp)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, trans["query"], trans["request"])
+	assert.Equal(t, "insufficientAccessRights: no read access", trans["response"])
+}
+
+func TestInvalidMessage(t *testing.T) {
+	_, ldap := ldapModForTests(nil)
+
+	pkt := &protos.Packet{Ts: time.Now(), Payload: []byte("GET / HTTP/1.1\r\n\r\n")}
+	private := ldap.Parse(pkt, testTCPTuple(), tcp.TCPDirectionOriginal, nil)
+	conn := private.(*ldapConnectionData)
+	assert.Nil(t, conn.streams[tcp.TCPDirectionOriginal])
+}
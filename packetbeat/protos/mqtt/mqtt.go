@@ -0,0 +1,589 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/applayer"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+type stream struct {
+	applayer.Stream
+	tcptuple *common.TCPTuple
+}
+
+type mqttConnectionData struct {
+	streams [2]*stream
+
+	// protocol level and client ID from CONNECT, if seen
+	level    byte
+	clientID string
+
+	connect *transaction
+	pending map[pendingKey]*transaction
+}
+
+// pendingKey identifies a transaction waiting for acknowledgements. Packet IDs
+// are assigned independently by client and server, such that the direction
+// of the request is part of the key.
+type pendingKey struct {
+	dir      uint8
+	packetID uint16
+}
+
+type transaction struct {
+	ts           time.Time
+	endTime      time.Time
+	tuple        common.TCPTuple
+	cmdlineTuple *common.ProcessTuple
+	dir          uint8
+
+	request  *mqttMessage
+	pubrec   *mqttMessage // QoS 2 only
+	response *mqttMessage
+
+	bytesOut int
+	bytesIn  int
+}
+
+// MQTT protocol plugin
+type mqttPlugin struct {
+	// config
+	ports            []int
+	sendRequest      bool
+	sendResponse     bool
+	sendPayload      bool
+	maxPayloadLength int
+
+	transactionTimeout time.Duration
+
+	results protos.Reporter
+}
+
+var (
+	debugf  = logp.MakeDebug("mqtt")
+	isDebug = false
+)
+
+var (
+	unmatchedResponses = monitoring.NewInt(nil, "mqtt.unmatched_responses")
+	unmatchedRequests  = monitoring.NewInt(nil, "mqtt.unmatched_requests")
+)
+
+func init() {
+	protos.Register("mqtt", New)
+}
+
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &mqttPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (mqtt *mqttPlugin) init(results protos.Reporter, config *mqttConfig) error {
+	mqtt.setFromConfig(config)
+
+	mqtt.results = results
+	isDebug = logp.IsDebug("mqtt")
+
+	return nil
+}
+
+func (mqtt *mqttPlugin) setFromConfig(config *mqttConfig) {
+	mqtt.ports = config.Ports
+	mqtt.sendRequest = config.SendRequest
+	mqtt.sendResponse = config.SendResponse
+	mqtt.sendPayload = config.SendPayload
+	mqtt.maxPayloadLength = config.MaxPayloadLength
+	mqtt.transactionTimeout = config.TransactionTimeout
+}
+
+func (mqtt *mqttPlugin) GetPorts() []int {
+	return mqtt.ports
+}
+
+func (mqtt *mqttPlugin) ConnectionTimeout() time.Duration {
+	return mqtt.transactionTimeout
+}
+
+func (mqtt *mqttPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("ParseMQTT exception")
+
+	conn := ensureMQTTConnection(private)
+	conn = mqtt.doParse(conn, pkt, tcptuple, dir)
+	if conn == nil {
+		return nil
+	}
+	return conn
+}
+
+func ensureMQTTConnection(private protos.ProtocolData) *mqttConnectionData {
+	if private == nil {
+		return newConnection()
+	}
+
+	priv, ok := private.(*mqttConnectionData)
+	if !ok {
+		logp.Warn("mqtt connection data type error, create new one")
+		return newConnection()
+	}
+	if priv == nil {
+		logp.Warn("Unexpected: mqtt connection data not set, create new one")
+		return newConnection()
+	}
+
+	return priv
+}
+
+func newConnection() *mqttConnectionData {
+	return &mqttConnectionData{pending: map[pendingKey]*transaction{}}
+}
+
+// parseLevel returns the protocol level used to parse the packets of the
+// connection. MQTT 3.1.1 is assumed if the CONNECT packet has not been seen.
+func (conn *mqttConnectionData) parseLevel() byte {
+	if conn.level == 0 {
+		return level311
+	}
+	return conn.level
+}
+
+func (mqtt *mqttPlugin) doParse(
+	conn *mqttConnectionData,
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) *mqttConnectionData {
+
+	st := conn.streams[dir]
+	if st == nil {
+		st = newStream(tcptuple)
+		conn.streams[dir] = st
+		if isDebug {
+			debugf("new stream: %p (dir=%v, len=%v)", st, dir, len(pkt.Payload))
+		}
+	}
+
+	if err := st.Append(pkt.Payload); err != nil {
+		if isDebug {
+			debugf("%v, dropping TCP stream: ", err)
+		}
+		return nil
+	}
+
+	for st.Buf.Len() > 0 {
+		msg, err := parseMessage(st.Buf.Bytes(), conn.parseLevel())
+		if err != nil {
+			// drop this tcp stream. Will retry parsing with the next
+			// segment in it
+			conn.streams[dir] = nil
+			if isDebug {
+				debugf("Ignore MQTT message (%v). Drop tcp stream. Try parsing with the next segment", err)
+			}
+			return conn
+		}
+		if msg == nil {
+			// wait for more data
+			break
+		}
+
+		if isDebug {
+			debugf("MQTT (%p) %s message, dir=%v", conn, msg.typeName(), dir)
+		}
+		mqtt.handleMQTT(conn, msg, tcptuple, dir, pkt.Ts)
+
+		st.Buf.Advance(msg.size)
+		st.Buf.Reset()
+	}
+
+	return conn
+}
+
+func newStream(tcptuple *common.TCPTuple) *stream {
+	s := &stream{
+		tcptuple: tcptuple,
+	}
+	s.Stream.Init(tcp.TCPMaxDataInStream)
+	return s
+}
+
+func (mqtt *mqttPlugin) handleMQTT(
+	conn *mqttConnectionData,
+	m *mqttMessage,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) {
+	// acknowledgements are sent in the reverse direction of the request
+	ackKey := pendingKey{dir: 1 - dir, packetID: m.packetID}
+
+	switch m.packetType {
+	case typeConnect:
+		conn.level = m.protocolLevel
+		conn.clientID = m.clientID
+		conn.connect = mqtt.newTransaction(m, tcptuple, dir, ts)
+
+	case typeConnack:
+		t := conn.connect
+		if t == nil || t.dir == dir {
+			mqtt.unmatchedResponse(m)
+			return
+		}
+		conn.connect = nil
+		mqtt.complete(conn, t, m, ts)
+
+	case typePublish:
+		t := mqtt.newTransaction(m, tcptuple, dir, ts)
+		if m.qos == 0 {
+			mqtt.publishTransaction(conn, t)
+			return
+		}
+		key := pendingKey{dir: dir, packetID: m.packetID}
+		if prev, found := conn.pending[key]; found && m.dup {
+			// retransmission of a message not acknowledged yet
+			prev.bytesOut += m.size
+			return
+		}
+		mqtt.addPending(conn, key, t)
+
+	case typeSubscribe, typeUnsubscribe:
+		mqtt.addPending(conn, pendingKey{dir: dir, packetID: m.packetID},
+			mqtt.newTransaction(m, tcptuple, dir, ts))
+
+	case typePuback, typePubcomp:
+		t := conn.pending[ackKey]
+		if t == nil || t.request.packetType != typePublish {
+			mqtt.unmatchedResponse(m)
+			return
+		}
+		delete(conn.pending, ackKey)
+		mqtt.complete(conn, t, m, ts)
+
+	case typePubrec:
+		t := conn.pending[ackKey]
+		if t == nil || t.request.packetType != typePublish {
+			mqtt.unmatchedResponse(m)
+			return
+		}
+		t.pubrec = m
+		t.bytesIn += m.size
+		if isFailure(m.reasonCode, conn.parseLevel(), m.packetType) {
+			// no PUBREL follows a failed PUBREC
+			delete(conn.pending, ackKey)
+			mqtt.complete(conn, t, m, ts)
+		}
+
+	case typePubrel:
+		// PUBREL is sent by the publisher, like the PUBLISH
+		if t := conn.pending[pendingKey{dir: dir, packetID: m.packetID}]; t != nil {
+			t.bytesOut += m.size
+		}
+
+	case typeSuback, typeUnsuback:
+		// SUBACK and UNSUBACK directly follow their request type
+		t := conn.pending[ackKey]
+		if t == nil || t.request.packetType != m.packetType-1 {
+			mqtt.unmatchedResponse(m)
+			return
+		}
+		delete(conn.pending, ackKey)
+		mqtt.complete(conn, t, m, ts)
+	}
+}
+
+func (mqtt *mqttPlugin) newTransaction(
+	m *mqttMessage,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	ts time.Time,
+) *transaction {
+	if mqtt.sendPayload && m.payload != nil {
+		m.payload = append([]byte(nil), m.payload...)
+	} else {
+		m.payload = nil
+	}
+
+	return &transaction{
+		ts:           ts,
+		tuple:        *tcptuple,
+		cmdlineTuple: procs.ProcWatcher.FindProcessesTupleTCP(tcptuple.IPPort()),
+		dir:          dir,
+		request:      m,
+		bytesOut:     m.size,
+	}
+}
+
+func (mqtt *mqttPlugin) addPending(conn *mqttConnectionData, key pendingKey, t *transaction) {
+	mqtt.expirePending(conn, t.ts)
+	if _, found := conn.pending[key]; found {
+		debugf("Packet ID %v reused before acknowledgement. Ignoring previous request", key.packetID)
+		unmatchedRequests.Add(1)
+	}
+	conn.pending[key] = t
+}
+
+// expirePending publishes the requests not acknowledged for
+// transaction_timeout, without waiting for their acknowledgement anymore.
+func (mqtt *mqttPlugin) expirePending(conn *mqttConnectionData, ts time.Time) {
+	for key, t := range conn.pending {
+		if ts.Sub(t.ts) > mqtt.transactionTimeout {
+			debugf("Packet ID %v not acknowledged in time", key.packetID)
+			delete(conn.pending, key)
+			unmatchedRequests.Add(1)
+			mqtt.publishTransaction(conn, t)
+		}
+	}
+}
+
+func (mqtt *mqttPlugin) unmatchedResponse(m *mqttMessage) {
+	debugf("%s from unknown transaction. Ignoring", m.typeName())
+	unmatchedResponses.Add(1)
+}
+
+func (mqtt *mqttPlugin) complete(conn *mqttConnectionData, t *transaction, resp *mqttMessage, ts time.Time) {
+	t.response = resp
+	t.endTime = ts
+	if resp != t.pubrec {
+		t.bytesIn += resp.size
+	}
+	mqtt.publishTransaction(conn, t)
+}
+
+func (mqtt *mqttPlugin) publishTransaction(conn *mqttConnectionData, t *transaction) {
+	if mqtt.results == nil {
+		return
+	}
+	mqtt.results(mqtt.newEvent(conn, t))
+}
+
+func (mqtt *mqttPlugin) newEvent(conn *mqttConnectionData, t *transaction) beat.Event {
+	requ, resp := t.request, t.response
+	level := conn.parseLevel()
+
+	source, destination := common.MakeEndpointPair(t.tuple.BaseTuple, t.cmdlineTuple)
+	src, dst := &source, &destination
+	if t.dir == tcp.TCPDirectionReverse {
+		src, dst = dst, src
+	}
+
+	evt, pbf := pb.NewBeatEvent(t.ts)
+	pbf.SetSource(src)
+	pbf.SetDestination(dst)
+	pbf.Source.Bytes = int64(t.bytesOut)
+	pbf.Destination.Bytes = int64(t.bytesIn)
+	pbf.Event.Dataset = "mqtt"
+	pbf.Event.Start = t.ts
+	if resp != nil {
+		pbf.Event.End = t.endTime
+	}
+	pbf.Network.Transport = "tcp"
+	pbf.Network.Protocol = pbf.Event.Dataset
+
+	info := common.MapStr{}
+	if conn.level != 0 {
+		info["version"] = versionString(conn.level)
+	}
+	if conn.clientID != "" {
+		info["client_id"] = conn.clientID
+	}
+	if requ.packetID != 0 {
+		info["packet_id"] = requ.packetID
+	}
+
+	status := common.OK_STATUS
+	var path string
+	switch requ.packetType {
+	case typeConnect:
+		path = requ.clientID
+		info["clean_session"] = requ.cleanSession
+		info["keep_alive"] = requ.keepAlive
+		if requ.username != "" {
+			info["username"] = requ.username
+		}
+
+	case typePublish:
+		path = requ.topic
+		info["topic"] = requ.topic
+		info["qos"] = requ.qos
+		info["retain"] = requ.retain
+		info["dup"] = requ.dup
+		if requ.payload != nil {
+			mqtt.addPayload(info, requ.payload)
+		}
+
+	case typeSubscribe, typeUnsubscribe:
+		path = strings.Join(requ.topics, ",")
+		info["topics"] = requ.topics
+		if requ.packetType == typeSubscribe {
+			info["qos"] = toInts(requ.qosList)
+		}
+	}
+
+	if resp != nil {
+		switch resp.packetType {
+		case typeConnack:
+			info["session_present"] = resp.sessionPresent
+			info["reason_code"] = resp.reasonCode
+			if isFailure(resp.reasonCode, level, resp.packetType) {
+				status = common.ERROR_STATUS
+			}
+
+		case typePuback, typePubrec, typePubcomp:
+			if t.pubrec != nil && isFailure(t.pubrec.reasonCode, level, typePubrec) {
+				resp = t.pubrec
+			}
+			if level == level5 {
+				info["reason_code"] = resp.reasonCode
+			}
+			if isFailure(resp.reasonCode, level, resp.packetType) {
+				status = common.ERROR_STATUS
+			}
+
+		case typeSuback, typeUnsuback:
+			if len(resp.reasonCodes) > 0 {
+				info["reason_codes"] = toInts(resp.reasonCodes)
+			}
+			for _, code := range resp.reasonCodes {
+				if isFailure(code, level, resp.packetType) {
+					status = common.ERROR_STATUS
+				}
+			}
+		}
+	}
+
+	fields := evt.Fields
+	fields["type"] = pbf.Event.Dataset
+	fields["status"] = status
+	fields["method"] = requ.typeName()
+	fields["path"] = path
+	fields["query"] = strings.TrimSpace(requ.typeName() + " " + path)
+	fields["mqtt"] = info
+
+	if mqtt.sendRequest {
+		fields["request"] = fields["query"]
+	}
+	if mqtt.sendResponse && resp != nil {
+		fields["response"] = resp.typeName()
+	}
+
+	return evt
+}
+
+// addPayload adds the PUBLISH payload, truncated to the configured length.
+// Payloads that are not valid UTF-8 are base64 encoded.
+func (mqtt *mqttPlugin) addPayload(info common.MapStr, payload []byte) {
+	if mqtt.maxPayloadLength > 0 && len(payload) > mqtt.maxPayloadLength {
+		payload = payload[:mqtt.maxPayloadLength]
+		info["payload_truncated"] = true
+	}
+	if utf8.Valid(payload) {
+		info["payload"] = string(payload)
+	} else {
+		info["payload"] = base64.StdEncoding.EncodeToString(payload)
+		info["payload_encoding"] = "base64"
+	}
+}
+
+// toInts converts a list of codes, such that they are not encoded as binary
+// data.
+func toInts(codes []byte) []int {
+	ints := make([]int, len(codes))
+	for i, code := range codes {
+		ints[i] = int(code)
+	}
+	return ints
+}
+
+func versionString(level byte) string {
+	switch level {
+	case level31:
+		return "3.1"
+	case level311:
+		return "3.1.1"
+	case level5:
+		return "5.0"
+	default:
+		return "unknown"
+	}
+}
+
+func (mqtt *mqttPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
+	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool) {
+
+	// MQTT packets can not be resynchronized after a gap.
+	mqtt.flushPending(private)
+	return private, true
+}
+
+func (mqtt *mqttPlugin) ReceivedFin(tcptuple *common.TCPTuple, dir uint8,
+	private protos.ProtocolData) protos.ProtocolData {
+
+	mqtt.flushPending(private)
+	return private
+}
+
+func (mqtt *mqttPlugin) Expired(tuple *common.TCPTuple, private protos.ProtocolData) {
+	mqtt.flushPending(private)
+}
+
+// flushPending publishes the requests not acknowledged yet once the
+// connection ends or can not be parsed anymore.
+func (mqtt *mqttPlugin) flushPending(private protos.ProtocolData) {
+	conn, ok := private.(*mqttConnectionData)
+	if !ok || conn == nil {
+		return
+	}
+
+	for key, t := range conn.pending {
+		debugf("Packet ID %v not acknowledged before the end of the stream", key.packetID)
+		delete(conn.pending, key)
+		unmatchedRequests.Add(1)
+		mqtt.publishTransaction(conn, t)
+	}
+}
@@ -0,0 +1,364 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Control packet types.
+const (
+	typeConnect     = 1
+	typeConnack     = 2
+	typePublish     = 3
+	typePuback      = 4
+	typePubrec      = 5
+	typePubrel      = 6
+	typePubcomp     = 7
+	typeSubscribe   = 8
+	typeSuback      = 9
+	typeUnsubscribe = 10
+	typeUnsuback    = 11
+	typePingreq     = 12
+	typePingresp    = 13
+	typeDisconnect  = 14
+	typeAuth        = 15
+)
+
+var packetTypeNames = []string{
+	"RESERVED",
+	"CONNECT",
+	"CONNACK",
+	"PUBLISH",
+	"PUBACK",
+	"PUBREC",
+	"PUBREL",
+	"PUBCOMP",
+	"SUBSCRIBE",
+	"SUBACK",
+	"UNSUBSCRIBE",
+	"UNSUBACK",
+	"PINGREQ",
+	"PINGRESP",
+	"DISCONNECT",
+	"AUTH",
+}
+
+// Protocol levels as sent in CONNECT.
+const (
+	level31  = 3
+	level311 = 4
+	level5   = 5
+)
+
+// maxRemainingLength is the largest remaining length that can be encoded in
+// the fixed header.
+const maxRemainingLength = 268435455
+
+var (
+	errInvalidLength   = errors.New("invalid remaining length")
+	errInvalidType     = errors.New("invalid packet type")
+	errInvalidProtocol = errors.New("invalid protocol name")
+	errMalformed       = errors.New("malformed packet")
+)
+
+type mqttMessage struct {
+	packetType byte
+	flags      byte
+	size       int // size of the complete control packet
+	packetID   uint16
+
+	// CONNECT
+	protocolLevel byte
+	clientID      string
+	username      string
+	cleanSession  bool
+	keepAlive     uint16
+
+	// CONNACK
+	sessionPresent bool
+
+	// reason code of CONNACK and of the PUBLISH acknowledgements. Return code
+	// of CONNACK before MQTT 5.
+	reasonCode byte
+
+	// PUBLISH
+	topic   string
+	qos     byte
+	retain  bool
+	dup     bool
+	payload []byte
+
+	// SUBSCRIBE and UNSUBSCRIBE topic filters and requested QoS
+	topics  []string
+	qosList []byte
+
+	// SUBACK and UNSUBACK reason codes
+	reasonCodes []byte
+}
+
+func (m *mqttMessage) typeName() string {
+	return packetTypeNames[m.packetType]
+}
+
+// isFailure returns true if the reason code reports an error. Before MQTT 5
+// only CONNACK reports errors with return codes other than 0, the other
+// acknowledgements use 0x80 for failures.
+func isFailure(code byte, level byte, packetType byte) bool {
+	if packetType == typeConnack && level != level5 {
+		return code != 0
+	}
+	return code >= 0x80
+}
+
+// parseMessage parses the control packet at the beginning of data. It returns
+// a nil message with no error if data does not contain the complete packet
+// yet. The protocol level selects between the MQTT 3 and MQTT 5 encodings.
+func parseMessage(data []byte, level byte) (*mqttMessage, error) {
+	if len(data) < 2 {
+		return nil, nil
+	}
+
+	remaining, n, err := decodeVarInt(data[1:])
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	if remaining > maxRemainingLength {
+		return nil, errInvalidLength
+	}
+	headerSize := 1 + n
+	if len(data) < headerSize+remaining {
+		return nil, nil
+	}
+
+	m := &mqttMessage{
+		packetType: data[0] >> 4,
+		flags:      data[0] & 0x0f,
+		size:       headerSize + remaining,
+	}
+	d := &decoder{buf: data[headerSize:m.size]}
+
+	switch m.packetType {
+	case typeConnect:
+		err = m.parseConnect(d)
+	case typeConnack:
+		err = m.parseConnack(d)
+	case typePublish:
+		err = m.parsePublish(d, level)
+	case typePuback, typePubrec, typePubrel, typePubcomp:
+		err = m.parseAck(d, level)
+	case typeSubscribe:
+		err = m.parseSubscribe(d, level)
+	case typeUnsubscribe:
+		err = m.parseUnsubscribe(d, level)
+	case typeSuback, typeUnsuback:
+		err = m.parseSuback(d, level)
+	case typePingreq, typePingresp, typeDisconnect, typeAuth:
+		// not correlated, nothing to parse
+	default:
+		err = errInvalidType
+	}
+	if err == nil && d.err {
+		err = errMalformed
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *mqttMessage) parseConnect(d *decoder) error {
+	switch d.string() {
+	case "MQTT", "MQIsdp":
+	default:
+		return errInvalidProtocol
+	}
+	m.protocolLevel = d.byte()
+	flags := d.byte()
+	m.keepAlive = d.uint16()
+	if m.protocolLevel == level5 {
+		d.properties()
+	}
+
+	m.cleanSession = flags&0x02 != 0
+	m.clientID = d.string()
+	if flags&0x04 != 0 { // will
+		if m.protocolLevel == level5 {
+			d.properties()
+		}
+		d.string()
+		d.binary()
+	}
+	if flags&0x80 != 0 {
+		m.username = d.string()
+	}
+	// the password is never reported
+	return nil
+}
+
+func (m *mqttMessage) parseConnack(d *decoder) error {
+	m.sessionPresent = d.byte()&0x01 != 0
+	m.reasonCode = d.byte()
+	return nil
+}
+
+func (m *mqttMessage) parsePublish(d *decoder, level byte) error {
+	m.dup = m.flags&0x08 != 0
+	m.qos = (m.flags >> 1) & 0x03
+	m.retain = m.flags&0x01 != 0
+	if m.qos > 2 {
+		return errMalformed
+	}
+
+	m.topic = d.string()
+	if m.qos > 0 {
+		m.packetID = d.uint16()
+	}
+	if level == level5 {
+		d.properties()
+	}
+	m.payload = d.rest()
+	return nil
+}
+
+func (m *mqttMessage) parseAck(d *decoder, level byte) error {
+	m.packetID = d.uint16()
+	// the reason code is omitted on success
+	if level == level5 && d.len() > 0 {
+		m.reasonCode = d.byte()
+	}
+	return nil
+}
+
+func (m *mqttMessage) parseSubscribe(d *decoder, level byte) error {
+	m.packetID = d.uint16()
+	if level == level5 {
+		d.properties()
+	}
+	for d.len() > 0 && !d.err {
+		m.topics = append(m.topics, d.string())
+		m.qosList = append(m.qosList, d.byte()&0x03)
+	}
+	return nil
+}
+
+func (m *mqttMessage) parseUnsubscribe(d *decoder, level byte) error {
+	m.packetID = d.uint16()
+	if level == level5 {
+		d.properties()
+	}
+	for d.len() > 0 && !d.err {
+		m.topics = append(m.topics, d.string())
+	}
+	return nil
+}
+
+func (m *mqttMessage) parseSuback(d *decoder, level byte) error {
+	m.packetID = d.uint16()
+	if level == level5 {
+		d.properties()
+	}
+	m.reasonCodes = append([]byte(nil), d.rest()...)
+	return nil
+}
+
+// decodeVarInt decodes a variable byte integer. It returns the number of bytes
+// read, or 0 if data is incomplete.
+func decodeVarInt(data []byte) (value int, n int, err error) {
+	multiplier := 1
+	for i, b := range data {
+		if i == 4 {
+			return 0, 0, errInvalidLength
+		}
+		value += int(b&0x7f) * multiplier
+		if b&0x80 == 0 {
+			return value, i + 1, nil
+		}
+		multiplier *= 128
+	}
+	if len(data) >= 4 {
+		return 0, 0, errInvalidLength
+	}
+	return 0, 0, nil
+}
+
+// decoder reads the fields of a control packet. Reading past the end of the
+// packet sets err.
+type decoder struct {
+	buf []byte
+	err bool
+}
+
+func (d *decoder) len() int {
+	return len(d.buf)
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err || len(d.buf) < n {
+		d.err = true
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) byte() byte {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	if b := d.next(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) binary() []byte {
+	return d.next(int(d.uint16()))
+}
+
+func (d *decoder) string() string {
+	return string(d.binary())
+}
+
+// properties skips the MQTT 5 properties.
+func (d *decoder) properties() {
+	if d.err {
+		return
+	}
+	length, n, err := decodeVarInt(d.buf)
+	if err != nil || n == 0 {
+		d.err = true
+		return
+	}
+	d.next(n + length)
+}
+
+func (d *decoder) rest() []byte {
+	if d.err {
+		return nil
+	}
+	b := d.buf
+	d.buf = nil
+	return b
+}
@@ -0,0 +1,417 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package mqtt
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+// Helper function returning a MQTT module that can be used in tests. It
+// publishes the transactions in the event store.
+func mqttModForTests(config *mqttConfig) (*eventStore, *mqttPlugin) {
+	var mqtt mqttPlugin
+	results := &eventStore{}
+	if config == nil {
+		config = &mqttConfig{}
+		*config = defaultConfig
+	}
+	mqtt.init(results.publish, config)
+	return results, &mqtt
+}
+
+// Helper function that returns an example TcpTuple
+func testTCPTuple() *common.TCPTuple {
+	t := &common.TCPTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 6512, DstPort: 1883,
+		},
+	}
+	t.ComputeHashables()
+	return t
+}
+
+// packet builds a control packet from the fixed header byte and the
+// remaining fields.
+func packet(header byte, fields ...[]byte) []byte {
+	var body []byte
+	for _, f := range fields {
+		body = append(body, f...)
+	}
+
+	data := []byte{header}
+	n := len(body)
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		data = append(data, b)
+		if n == 0 {
+			break
+		}
+	}
+	return append(data, body...)
+}
+
+func str(s string) []byte {
+	b := make([]byte, 2, 2+len(s))
+	binary.BigEndian.PutUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+func u16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+func connect(level byte, clientID string) []byte {
+	// clean session, username
+	fields := [][]byte{str("MQTT"), {level, 0x82}, u16(60)}
+	if level == level5 {
+		fields = append(fields, []byte{0})
+	}
+	fields = append(fields, str(clientID), str("sensor"))
+	return packet(0x10, fields...)
+}
+
+func parse(t *testing.T, mqtt *mqttPlugin, private protos.ProtocolData, dir uint8, data []byte) protos.ProtocolData {
+	return parseAt(t, mqtt, private, dir, data, time.Now())
+}
+
+func parseAt(t *testing.T, mqtt *mqttPlugin, private protos.ProtocolData, dir uint8, data []byte, ts time.Time) protos.ProtocolData {
+	pkt := &protos.Packet{Ts: ts, Payload: data}
+	private = mqtt.Parse(pkt, testTCPTuple(), dir, private)
+	if !assert.NotNil(t, private, "connection dropped") {
+		t.FailNow()
+	}
+	return private
+}
+
+// Helper function to read from the results Queue. Raises
+// an error if nothing is found in the queue.
+func expectTransaction(t *testing.T, e *eventStore) common.MapStr {
+	if len(e.events) == 0 {
+		t.Fatal("No transaction")
+	}
+
+	event := e.events[0]
+	e.events = e.events[1:]
+	return event.Fields
+}
+
+func TestDecodeVarInt(t *testing.T) {
+	tests := []struct {
+		data  []byte
+		value int
+		n     int
+		err   error
+	}{
+		{[]byte{0x00}, 0, 1, nil},
+		{[]byte{0x7f}, 127, 1, nil},
+		{[]byte{0x80, 0x01}, 128, 2, nil},
+		{[]byte{0xff, 0xff, 0xff, 0x7f}, maxRemainingLength, 4, nil},
+		{[]byte{0x80}, 0, 0, nil},
+		{[]byte{0xff, 0xff, 0xff, 0xff}, 0, 0, errInvalidLength},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0x01}, 0, 0, errInvalidLength},
+	}
+
+	for _, test := range tests {
+		value, n, err := decodeVarInt(test.data)
+		assert.Equal(t, test.value, value, "%x", test.data)
+		assert.Equal(t, test.n, n, "%x", test.data)
+		assert.Equal(t, test.err, err, "%x", test.data)
+	}
+}
+
+func TestParseConnect(t *testing.T) {
+	data := connect(level311, "client-1")
+	m, err := parseMessage(data, 0)
+
+	if assert.NoError(t, err) && assert.NotNil(t, m) {
+		assert.Equal(t, "CONNECT", m.typeName())
+		assert.Equal(t, byte(level311), m.protocolLevel)
+		assert.Equal(t, "client-1", m.clientID)
+		assert.Equal(t, "sensor", m.username)
+		assert.True(t, m.cleanSession)
+		assert.Equal(t, uint16(60), m.keepAlive)
+		assert.Equal(t, len(data), m.size)
+	}
+}
+
+func TestParsePublishProperties(t *testing.T) {
+	// QoS 1, retain, with a content type property
+	data := packet(0x33, str("a/b"), u16(7), []byte{4, 0x03}, str("c"), []byte("hello"))
+
+	m, err := parseMessage(data, level5)
+	if assert.NoError(t, err) && assert.NotNil(t, m) {
+		assert.Equal(t, "a/b", m.topic)
+		assert.Equal(t, byte(1), m.qos)
+		assert.True(t, m.retain)
+		assert.Equal(t, uint16(7), m.packetID)
+		assert.Equal(t, "hello", string(m.payload))
+	}
+}
+
+func TestParseIncomplete(t *testing.T) {
+	data := packet(0x30, str("a/b"), []byte("hello"))
+
+	for i := 0; i < len(data); i++ {
+		m, err := parseMessage(data[:i], level311)
+		assert.NoError(t, err)
+		assert.Nil(t, m)
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	_, err := parseMessage(packet(0x30, []byte{0x00, 0x05, 'a'}), level311)
+	assert.Error(t, err)
+
+	_, err = parseMessage(packet(0x10, str("HTTP")), level311)
+	assert.Equal(t, errInvalidProtocol, err)
+
+	_, err = parseMessage(packet(0x00), level311)
+	assert.Equal(t, errInvalidType, err)
+}
+
+func TestConnect(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	var private protos.ProtocolData
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, connect(level311, "client-1"))
+	parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x20, []byte{0x00, 0x05}))
+
+	event := expectTransaction(t, results)
+	assert.Equal(t, "mqtt", event["type"])
+	assert.Equal(t, "CONNECT", event["method"])
+	assert.Equal(t, "client-1", event["path"])
+	assert.Equal(t, common.ERROR_STATUS, event["status"])
+
+	info := event["mqtt"].(common.MapStr)
+	assert.Equal(t, "3.1.1", info["version"])
+	assert.Equal(t, "client-1", info["client_id"])
+	assert.Equal(t, "sensor", info["username"])
+	assert.Equal(t, byte(5), info["reason_code"])
+	assert.Equal(t, false, info["session_present"])
+}
+
+func TestPublishQoS0(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	parse(t, mqtt, nil, tcp.TCPDirectionOriginal, packet(0x30, str("a/b"), []byte("hello")))
+
+	event := expectTransaction(t, results)
+	assert.Equal(t, "PUBLISH", event["method"])
+	assert.Equal(t, "a/b", event["path"])
+	assert.Equal(t, "PUBLISH a/b", event["query"])
+	assert.Equal(t, common.OK_STATUS, event["status"])
+
+	info := event["mqtt"].(common.MapStr)
+	assert.Equal(t, "a/b", info["topic"])
+	assert.Equal(t, byte(0), info["qos"])
+	assert.NotContains(t, info, "payload")
+	assert.Empty(t, results.events)
+}
+
+// Test that PUBLISH and PUBACK sent by the server are correlated, also if
+// the client uses the same packet ID concurrently.
+func TestPublishQoS1FromServer(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	var private protos.ProtocolData
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, packet(0x32, str("client"), u16(1), []byte("x")))
+	private = parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x32, str("server"), u16(1), []byte("y")))
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, packet(0x40, u16(1)))
+
+	server := expectTransaction(t, results)
+	assert.Equal(t, "server", server["path"])
+	assert.Empty(t, results.events)
+
+	parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x40, u16(1)))
+
+	client := expectTransaction(t, results)
+	assert.Equal(t, "client", client["path"])
+	assert.Equal(t, uint16(1), client["mqtt"].(common.MapStr)["packet_id"])
+}
+
+func TestPublishQoS2(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	publish := packet(0x34, str("a/b"), u16(9), []byte("hello"))
+	var private protos.ProtocolData
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, publish)
+	private = parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x50, u16(9)))
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, packet(0x62, u16(9)))
+	assert.Empty(t, results.events)
+	parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x70, u16(9)))
+
+	event := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, event["status"])
+	assert.Equal(t, byte(2), event["mqtt"].(common.MapStr)["qos"])
+
+	fields := event[pb.FieldsKey].(*pb.Fields)
+	assert.EqualValues(t, len(publish)+4, fields.Source.Bytes)
+	assert.EqualValues(t, 8, fields.Destination.Bytes)
+}
+
+func TestExpirePending(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+	before := unmatchedRequests.Get()
+
+	ts := time.Now()
+	var private protos.ProtocolData
+	private = parseAt(t, mqtt, private, tcp.TCPDirectionOriginal,
+		packet(0x32, str("a/b"), u16(1), []byte("x")), ts)
+	private = parseAt(t, mqtt, private, tcp.TCPDirectionOriginal,
+		packet(0x82, u16(2), str("a/#"), []byte{1}), ts.Add(mqtt.transactionTimeout))
+	assert.Empty(t, results.events)
+
+	// the PUBLISH is not acknowledged in time, the SUBSCRIBE still is pending
+	private = parseAt(t, mqtt, private, tcp.TCPDirectionOriginal,
+		packet(0x82, u16(3), str("b/#"), []byte{1}), ts.Add(mqtt.transactionTimeout+time.Second))
+	assert.Equal(t, before+1, unmatchedRequests.Get())
+	assert.Len(t, private.(*mqttConnectionData).pending, 2)
+
+	event := expectTransaction(t, results)
+	assert.Equal(t, "PUBLISH", event["method"])
+	assert.True(t, event[pb.FieldsKey].(*pb.Fields).Event.End.IsZero())
+	assert.Empty(t, results.events)
+
+	parseAt(t, mqtt, private, tcp.TCPDirectionReverse,
+		packet(0x90, u16(2), []byte{1}), ts.Add(mqtt.transactionTimeout+2*time.Second))
+	event = expectTransaction(t, results)
+	assert.Equal(t, "a/#", event["path"])
+}
+
+func TestFlushPendingAtEndOfStream(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+	tuple := testTCPTuple()
+
+	newPending := func() protos.ProtocolData {
+		var private protos.ProtocolData
+		private = parse(t, mqtt, private, tcp.TCPDirectionOriginal,
+			packet(0x32, str("a/b"), u16(1), []byte("x")))
+		return parse(t, mqtt, private, tcp.TCPDirectionOriginal,
+			packet(0x82, u16(2), str("a/#"), []byte{1}))
+	}
+
+	before := unmatchedRequests.Get()
+	mqtt.ReceivedFin(tuple, tcp.TCPDirectionOriginal, newPending())
+	_, drop := mqtt.GapInStream(tuple, tcp.TCPDirectionOriginal, 10, newPending())
+	assert.True(t, drop)
+	mqtt.Expired(tuple, newPending())
+
+	assert.Equal(t, before+6, unmatchedRequests.Get())
+	assert.Len(t, results.events, 6)
+}
+
+func TestSubscribe(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	var private protos.ProtocolData
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal,
+		packet(0x82, u16(3), str("a/#"), []byte{1}, str("b/+"), []byte{2}))
+	parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x90, u16(3), []byte{1, 0x80}))
+
+	event := expectTransaction(t, results)
+	assert.Equal(t, "SUBSCRIBE", event["method"])
+	assert.Equal(t, "a/#,b/+", event["path"])
+	assert.Equal(t, common.ERROR_STATUS, event["status"])
+
+	info := event["mqtt"].(common.MapStr)
+	assert.Equal(t, []string{"a/#", "b/+"}, info["topics"])
+	assert.Equal(t, []int{1, 2}, info["qos"])
+	assert.Equal(t, []int{1, 0x80}, info["reason_codes"])
+}
+
+func TestMQTT5PublishFailure(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	var private protos.ProtocolData
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, connect(level5, "client-5"))
+	private = parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x20, []byte{0x01, 0x00, 0x00}))
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal,
+		packet(0x32, str("a/b"), u16(1), []byte{0}, []byte("hello")))
+	parse(t, mqtt, private, tcp.TCPDirectionReverse, packet(0x40, u16(1), []byte{0x87}))
+
+	connect := expectTransaction(t, results)
+	assert.Equal(t, common.OK_STATUS, connect["status"])
+	assert.Equal(t, true, connect["mqtt"].(common.MapStr)["session_present"])
+
+	event := expectTransaction(t, results)
+	assert.Equal(t, common.ERROR_STATUS, event["status"])
+	info := event["mqtt"].(common.MapStr)
+	assert.Equal(t, "5.0", info["version"])
+	assert.Equal(t, "client-5", info["client_id"])
+	assert.Equal(t, byte(0x87), info["reason_code"])
+}
+
+func TestSplitSegments(t *testing.T) {
+	results, mqtt := mqttModForTests(nil)
+
+	data := append(packet(0x30, str("a/b"), []byte("hello")), packet(0x30, str("c"))...)
+	var private protos.ProtocolData
+	private = parse(t, mqtt, private, tcp.TCPDirectionOriginal, data[:3])
+	assert.Empty(t, results.events)
+	parse(t, mqtt, private, tcp.TCPDirectionOriginal, data[3:])
+
+	assert.Equal(t, "a/b", expectTransaction(t, results)["path"])
+	assert.Equal(t, "c", expectTransaction(t, results)["path"])
+}
+
+func TestSendPayload(t *testing.T) {
+	config := defaultConfig
+	config.SendPayload = true
+	config.MaxPayloadLength = 4
+	results, mqtt := mqttModForTests(&config)
+
+	parse(t, mqtt, nil, tcp.TCPDirectionOriginal, packet(0x30, str("a"), []byte("hello")))
+	parse(t, mqtt, nil, tcp.TCPDirectionOriginal, packet(0x30, str("b"), []byte{0xff, 0xfe}))
+
+	info := expectTransaction(t, results)["mqtt"].(common.MapStr)
+	assert.Equal(t, "hell", info["payload"])
+	assert.Equal(t, true, info["payload_truncated"])
+
+	info = expectTransaction(t, results)["mqtt"].(common.MapStr)
+	assert.Equal(t, "//4=", info["payload"])
+	assert.Equal(t, "base64", info["payload_encoding"])
+}
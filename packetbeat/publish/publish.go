@@ -27,6 +27,7 @@ import (
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/processors"
 	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/tunnel"
 )
 
 type TransactionPublisher struct {
@@ -40,6 +41,7 @@ type transProcessor struct {
 	ignoreOutgoing bool
 	localIPs       []net.IP // TODO: Periodically update this list.
 	name           string
+	tunnels        *tunnel.Registry
 }
 
 var debugf = logp.MakeDebug("publish")
@@ -49,6 +51,7 @@ func NewTransactionPublisher(
 	pipeline beat.Pipeline,
 	ignoreOutgoing bool,
 	canDrop bool,
+	tunnels *tunnel.Registry,
 ) (*TransactionPublisher, error) {
 	addrs, err := common.LocalIPAddrs()
 	if err != nil {
@@ -69,6 +72,7 @@ func NewTransactionPublisher(
 			localIPs:       localIPs,
 			name:           name,
 			ignoreOutgoing: ignoreOutgoing,
+			tunnels:        tunnels,
 		},
 	}
 	return p, nil
@@ -155,11 +159,83 @@ func (p *transProcessor) Run(event *beat.Event) (*beat.Event, error) {
 				fields.Source.IP, fields.Destination.IP)
 			return nil, nil
 		}
+
+		p.addTunnelFields(event, fields)
 	}
 
 	return event, nil
 }
 
+// addTunnelFields adds the outer endpoints of the tunnel the transaction has
+// been decapsulated from.
+func (p *transProcessor) addTunnelFields(event *beat.Event, fields *pb.Fields) {
+	if p.tunnels == nil || fields.Source == nil || fields.Destination == nil {
+		return
+	}
+
+	info, found := p.tunnels.Lookup(
+		net.ParseIP(fields.Source.IP), uint16(fields.Source.Port),
+		net.ParseIP(fields.Destination.IP), uint16(fields.Destination.Port))
+	if !found {
+		return
+	}
+	event.PutValue("network.tunnel", info.Fields())
+}
+
+// NewFlowsReporter returns a reporter for flow events, that adds the tunnel
+// fields to the events of decapsulated flows before passing them to publish.
+func NewFlowsReporter(publish func([]beat.Event), tunnels *tunnel.Registry) func([]beat.Event) {
+	if tunnels == nil {
+		return publish
+	}
+	return func(events []beat.Event) {
+		for i := range events {
+			addFlowTunnelFields(&events[i], tunnels)
+		}
+		publish(events)
+	}
+}
+
+// addFlowTunnelFields adds the tunnel fields to a flow event. The addresses of
+// decapsulated flows contain the outer address first, such that the tunnel
+// is looked up by the inner addresses.
+func addFlowTunnelFields(event *beat.Event, tunnels *tunnel.Registry) {
+	srcIP, srcPort, ok := flowEndpoint(event, "source")
+	if !ok {
+		return
+	}
+	dstIP, dstPort, ok := flowEndpoint(event, "destination")
+	if !ok {
+		return
+	}
+
+	info, found := tunnels.Lookup(srcIP, srcPort, dstIP, dstPort)
+	if !found {
+		return
+	}
+	event.PutValue("network.tunnel", info.Fields())
+}
+
+func flowEndpoint(event *beat.Event, key string) (net.IP, uint16, bool) {
+	var ip string
+	switch v, _ := event.GetValue(key + ".ip"); addr := v.(type) {
+	case string:
+		ip = addr
+	case []string:
+		if len(addr) == 0 {
+			return nil, 0, false
+		}
+		ip = addr[len(addr)-1]
+	default:
+		return nil, 0, false
+	}
+
+	// flows without transport layer, like ICMP, have no ports
+	port, _ := event.GetValue(key + ".port")
+	p, _ := port.(uint16)
+	return net.ParseIP(ip), p, true
+}
+
 // filterEvent validates an event for common required fields with types.
 // If event is to be filtered out the reason is returned as error.
 func validateEvent(event *beat.Event) error {
@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/packetbeat/pb"
+	"github.com/elastic/beats/packetbeat/tunnel"
 	"github.com/elastic/ecs/code/go/ecs"
 )
 
@@ -185,4 +186,73 @@ func TestPublish(t *testing.T) {
 			assert.Nil(t, res)
 		}
 	})
+
+	t.Run("tunnel", func(t *testing.T) {
+		processor := transProcessor{name: "test", tunnels: tunnel.NewRegistry()}
+
+		res, _ := processor.Run(event())
+		if res == nil {
+			t.Fatalf("event has been filtered out")
+		}
+		_, err := res.GetValue("network.tunnel")
+		assert.Error(t, err, "event not in a tunnel has tunnel fields")
+
+		tuple := common.NewIPPortTuple(4,
+			net.ParseIP(dstIP).To4(), 32232, net.ParseIP(srcIP).To4(), 3267)
+		processor.tunnels.Add(&tuple, tunnel.Info{
+			Type:          tunnel.TypeGRE,
+			SourceIP:      net.ParseIP("10.0.0.1"),
+			DestinationIP: net.ParseIP("10.0.0.2"),
+		}, time.Now())
+
+		res, _ = processor.Run(event())
+		if res == nil {
+			t.Fatalf("event has been filtered out")
+		}
+		typ, _ := res.GetValue("network.tunnel.type")
+		assert.Equal(t, tunnel.TypeGRE, typ)
+		ip, _ := res.GetValue("network.tunnel.destination.ip")
+		assert.Equal(t, "10.0.0.2", ip)
+	})
+}
+
+func TestFlowsReporterTunnelFields(t *testing.T) {
+	tunnels := tunnel.NewRegistry()
+	tuple := common.NewIPPortTuple(4,
+		net.ParseIP("192.168.0.1").To4(), 34567, net.ParseIP("192.168.0.2").To4(), 80)
+	tunnels.Add(&tuple, tunnel.Info{
+		Type:          tunnel.TypeVXLAN,
+		ID:            42,
+		HasID:         true,
+		SourceIP:      net.ParseIP("10.0.0.1"),
+		DestinationIP: net.ParseIP("10.0.0.2"),
+	}, time.Now())
+
+	var published []beat.Event
+	report := NewFlowsReporter(func(events []beat.Event) {
+		published = append(published, events...)
+	}, tunnels)
+
+	report([]beat.Event{
+		{Fields: common.MapStr{
+			// the outer addresses come first in decapsulated flows
+			"source":      common.MapStr{"ip": []string{"10.0.0.1", "192.168.0.1"}, "port": uint16(34567)},
+			"destination": common.MapStr{"ip": []string{"10.0.0.2", "192.168.0.2"}, "port": uint16(80)},
+		}},
+		{Fields: common.MapStr{
+			"source":      common.MapStr{"ip": "192.168.0.3", "port": uint16(34567)},
+			"destination": common.MapStr{"ip": "192.168.0.2", "port": uint16(80)},
+		}},
+	})
+
+	if !assert.Len(t, published, 2) {
+		return
+	}
+	typ, _ := published[0].GetValue("network.tunnel.type")
+	assert.Equal(t, tunnel.TypeVXLAN, typ)
+	id, _ := published[0].GetValue("network.tunnel.id")
+	assert.Equal(t, uint32(42), id)
+
+	_, err := published[1].GetValue("network.tunnel")
+	assert.Error(t, err, "flow not in a tunnel has tunnel fields")
 }
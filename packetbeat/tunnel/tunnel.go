@@ -0,0 +1,197 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package tunnel remembers the tunnels the decoder has seen traffic in, such
+// that the outer tunnel endpoints can be added to the events of the
+// decapsulated traffic. Flows seen in multiple tunnels at the same time, for
+// example in overlapping VXLAN networks, can not be matched to a tunnel and
+// are not annotated.
+package tunnel
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Tunnel types.
+const (
+	TypeVXLAN  = "vxlan"
+	TypeGRE    = "gre"
+	TypeERSPAN = "erspan"
+)
+
+const (
+	// entryTTL is the time since the last packet after which the tunnel of
+	// an inner flow is forgotten.
+	entryTTL = 10 * time.Minute
+
+	// gcInterval is the minimum time between removing expired entries.
+	gcInterval = time.Minute
+)
+
+// Info describes the tunnel packets have been decapsulated from.
+type Info struct {
+	Type          string
+	ID            uint32 // VXLAN network identifier, GRE key or ERSPAN session ID
+	HasID         bool
+	SourceIP      net.IP // outer source address
+	DestinationIP net.IP // outer destination address
+}
+
+// Fields returns the event fields describing the tunnel.
+func (i *Info) Fields() common.MapStr {
+	fields := common.MapStr{
+		"type":        i.Type,
+		"source":      common.MapStr{"ip": i.SourceIP.String()},
+		"destination": common.MapStr{"ip": i.DestinationIP.String()},
+	}
+	if i.HasID {
+		fields["id"] = i.ID
+	}
+	return fields
+}
+
+// tunnelKey identifies a tunnel independent of the direction of the packets.
+type tunnelKey struct {
+	typ       string
+	id        uint32
+	hasID     bool
+	endpoints [2]string // outer addresses, ordered
+}
+
+func (i *Info) key() tunnelKey {
+	k := tunnelKey{typ: i.Type, id: i.ID, hasID: i.HasID}
+	a, b := string(i.SourceIP.To16()), string(i.DestinationIP.To16())
+	if a > b {
+		a, b = b, a
+	}
+	k.endpoints[0], k.endpoints[1] = a, b
+	return k
+}
+
+type entry struct {
+	info     Info
+	lastSeen time.Time
+}
+
+// Registry maps the addresses of inner flows to the tunnels they have been
+// seen in. Entries are expired based on the packet timestamps, such that
+// reading pcap files works as capturing live traffic.
+type Registry struct {
+	mu      sync.Mutex
+	entries map[common.HashableIPPortTuple]map[tunnelKey]*entry
+	now     time.Time
+	lastGC  time.Time
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{entries: map[common.HashableIPPortTuple]map[tunnelKey]*entry{}}
+}
+
+// Add records the tunnel of a decapsulated packet. The info is copied.
+func (r *Registry) Add(tuple *common.IPPortTuple, info Info, ts time.Time) {
+	info.SourceIP = append(net.IP(nil), info.SourceIP...)
+	info.DestinationIP = append(net.IP(nil), info.DestinationIP...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ts.After(r.now) {
+		r.now = ts
+	}
+	r.gc()
+
+	tunnels, found := r.entries[tuple.Hashable()]
+	if !found {
+		tunnels, found = r.entries[tuple.RevHashable()]
+	}
+	if !found {
+		tunnels = map[tunnelKey]*entry{}
+		r.entries[tuple.Hashable()] = tunnels
+	}
+
+	key := info.key()
+	if e, found := tunnels[key]; found {
+		e.info, e.lastSeen = info, ts
+		return
+	}
+	tunnels[key] = &entry{info: info, lastSeen: ts}
+}
+
+// Lookup returns the tunnel traffic between the two endpoints has been seen
+// in, in either direction. No tunnel is returned if the traffic has been seen
+// in multiple tunnels.
+func (r *Registry) Lookup(srcIP net.IP, srcPort uint16, dstIP net.IP, dstPort uint16) (Info, bool) {
+	tuple, ok := makeTuple(srcIP, srcPort, dstIP, dstPort)
+	if !ok {
+		return Info{}, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.entries) == 0 {
+		return Info{}, false
+	}
+	tunnels, found := r.entries[tuple.Hashable()]
+	if !found {
+		tunnels = r.entries[tuple.RevHashable()]
+	}
+	if len(tunnels) != 1 {
+		return Info{}, false
+	}
+	for _, e := range tunnels {
+		return e.info, true
+	}
+	return Info{}, false
+}
+
+func (r *Registry) gc() {
+	if r.now.Sub(r.lastGC) < gcInterval {
+		return
+	}
+	r.lastGC = r.now
+
+	for tuple, tunnels := range r.entries {
+		for key, e := range tunnels {
+			if r.now.Sub(e.lastSeen) > entryTTL {
+				delete(tunnels, key)
+			}
+		}
+		if len(tunnels) == 0 {
+			delete(r.entries, tuple)
+		}
+	}
+}
+
+// makeTuple creates a tuple matching the tuples created by the decoder, that
+// use 4 byte IPv4 addresses.
+func makeTuple(srcIP net.IP, srcPort uint16, dstIP net.IP, dstPort uint16) (common.IPPortTuple, bool) {
+	src4, dst4 := srcIP.To4(), dstIP.To4()
+	switch {
+	case src4 != nil && dst4 != nil:
+		return common.NewIPPortTuple(4, src4, srcPort, dst4, dstPort), true
+	case src4 == nil && dst4 == nil && len(srcIP) == net.IPv6len && len(dstIP) == net.IPv6len:
+		return common.NewIPPortTuple(16, srcIP, srcPort, dstIP, dstPort), true
+	default:
+		return common.IPPortTuple{}, false
+	}
+}
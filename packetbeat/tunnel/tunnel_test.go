@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package tunnel
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+var vxlanInfo = Info{
+	Type:          TypeVXLAN,
+	ID:            42,
+	HasID:         true,
+	SourceIP:      net.ParseIP("10.0.0.1"),
+	DestinationIP: net.ParseIP("10.0.0.2"),
+}
+
+func newTuple(srcIP string, srcPort uint16, dstIP string, dstPort uint16) *common.IPPortTuple {
+	tuple, _ := makeTuple(net.ParseIP(srcIP), srcPort, net.ParseIP(dstIP), dstPort)
+	return &tuple
+}
+
+func TestLookup(t *testing.T) {
+	r := NewRegistry()
+	r.Add(newTuple("192.168.0.1", 1234, "192.168.0.2", 80), vxlanInfo, time.Now())
+
+	info, found := r.Lookup(net.ParseIP("192.168.0.1"), 1234, net.ParseIP("192.168.0.2"), 80)
+	assert.True(t, found)
+	assert.Equal(t, vxlanInfo.Fields(), info.Fields())
+
+	_, found = r.Lookup(net.ParseIP("192.168.0.2"), 80, net.ParseIP("192.168.0.1"), 1234)
+	assert.True(t, found, "reverse direction not found")
+
+	_, found = r.Lookup(net.ParseIP("192.168.0.1"), 1235, net.ParseIP("192.168.0.2"), 80)
+	assert.False(t, found)
+}
+
+func TestAddUpdatesReverseDirection(t *testing.T) {
+	r := NewRegistry()
+	ts := time.Now()
+	r.Add(newTuple("192.168.0.1", 1234, "192.168.0.2", 80), vxlanInfo, ts)
+
+	reply := vxlanInfo
+	reply.SourceIP, reply.DestinationIP = vxlanInfo.DestinationIP, vxlanInfo.SourceIP
+	r.Add(newTuple("192.168.0.2", 80, "192.168.0.1", 1234), reply, ts)
+
+	assert.Len(t, r.entries, 1)
+	info, found := r.Lookup(net.ParseIP("192.168.0.1"), 1234, net.ParseIP("192.168.0.2"), 80)
+	assert.True(t, found)
+	assert.Equal(t, "10.0.0.2", info.SourceIP.String())
+}
+
+func TestOverlappingTunnels(t *testing.T) {
+	r := NewRegistry()
+	start := time.Now()
+	r.Add(newTuple("192.168.0.1", 1234, "192.168.0.2", 80), vxlanInfo, start)
+
+	other := vxlanInfo
+	other.ID = 43
+	r.Add(newTuple("192.168.0.1", 1234, "192.168.0.2", 80), other, start.Add(entryTTL))
+
+	_, found := r.Lookup(net.ParseIP("192.168.0.1"), 1234, net.ParseIP("192.168.0.2"), 80)
+	assert.False(t, found, "flow in multiple tunnels matched to a tunnel")
+
+	// the first tunnel expires
+	r.Add(newTuple("192.168.0.3", 1234, "192.168.0.2", 80), vxlanInfo, start.Add(entryTTL+gcInterval))
+	info, found := r.Lookup(net.ParseIP("192.168.0.1"), 1234, net.ParseIP("192.168.0.2"), 80)
+	assert.True(t, found)
+	assert.Equal(t, uint32(43), info.ID)
+}
+
+func TestExpire(t *testing.T) {
+	r := NewRegistry()
+	start := time.Now()
+	r.Add(newTuple("192.168.0.1", 1234, "192.168.0.2", 80), vxlanInfo, start)
+	r.Add(newTuple("192.168.0.3", 1234, "192.168.0.2", 80), vxlanInfo, start.Add(entryTTL))
+	r.Add(newTuple("192.168.0.4", 1234, "192.168.0.2", 80), vxlanInfo, start.Add(entryTTL+gcInterval))
+
+	_, found := r.Lookup(net.ParseIP("192.168.0.1"), 1234, net.ParseIP("192.168.0.2"), 80)
+	assert.False(t, found, "expired entry found")
+	_, found = r.Lookup(net.ParseIP("192.168.0.3"), 1234, net.ParseIP("192.168.0.2"), 80)
+	assert.True(t, found)
+}
+
+func TestFields(t *testing.T) {
+	assert.Equal(t, common.MapStr{
+		"type":        "vxlan",
+		"id":          uint32(42),
+		"source":      common.MapStr{"ip": "10.0.0.1"},
+		"destination": common.MapStr{"ip": "10.0.0.2"},
+	}, vxlanInfo.Fields())
+
+	gre := Info{Type: TypeGRE, SourceIP: net.ParseIP("10.0.0.1"), DestinationIP: net.ParseIP("10.0.0.2")}
+	assert.NotContains(t, gre.Fields(), "id")
+}